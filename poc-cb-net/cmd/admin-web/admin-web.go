@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -13,7 +12,6 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -21,18 +19,19 @@ import (
 	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
 	cbnet "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network"
 	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
-	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	etcdclient "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-client"
 	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/logger"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/validator"
 	cblog "github.com/cloud-barista/cb-log"
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo"
 	"github.com/rs/xid"
 	"github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // CBLogger represents a logger to show execution processes according to the logging level.
@@ -41,6 +40,9 @@ var config model.Config
 var loggerNamePrefix = "admin-web"
 var adminWebID string
 
+// Store of the cb-network system
+var cbnetStore store.Store
+
 // gRPC client
 var cladnetClient pb.CloudAdaptiveNetworkServiceClient
 var systemManagementClient pb.SystemManagementServiceClient
@@ -162,27 +164,8 @@ func WebsocketHandler(c echo.Context) error {
 
 	connectionPool.Unlock()
 
-	// etcd Section
-	etcdClient, err := clientv3.New(clientv3.Config{
-		Endpoints:   config.ETCD.Endpoints,
-		DialTimeout: 5 * time.Second,
-	})
-
-	if err != nil {
-		CBLogger.Fatal(err)
-	}
-
-	defer func() {
-		errClose := etcdClient.Close()
-		if errClose != nil {
-			CBLogger.Fatal("Can't close the etcd client", errClose)
-		}
-	}()
-
-	CBLogger.Infoln("The etcdClient is connected.")
-
 	// Get the existing both the networking rule and the specification of the CLADNet
-	errInitData := getExistingNetworkInfo(etcdClient)
+	errInitData := getExistingNetworkInfo(cbnetStore)
 	if errInitData != nil {
 		CBLogger.Errorf("getExistingNetworkInfo() error: %v", errInitData)
 	}
@@ -204,13 +187,13 @@ func WebsocketHandler(c echo.Context) error {
 
 		switch message.Type {
 		case "create-cladnet":
-			handleCreateCLADNet(etcdClient, []byte(message.Text))
+			handleCreateCLADNet([]byte(message.Text))
 
 		case "test-cladnet":
-			handleTestCLADNet(etcdClient, message.Text)
+			handleTestCLADNet(message.Text)
 
 		case "control-cladnet":
-			handleControlCLADNet(etcdClient, message.Text)
+			handleControlCLADNet(message.Text)
 
 		default:
 
@@ -219,7 +202,7 @@ func WebsocketHandler(c echo.Context) error {
 	}
 }
 
-func handleCreateCLADNet(etcdClient *clientv3.Client, responseText []byte) {
+func handleCreateCLADNet(responseText []byte) {
 	CBLogger.Debug("Start.........")
 
 	// Unmarshal the specification of Cloud Adaptive Network (CLADNet)
@@ -240,6 +223,13 @@ func handleCreateCLADNet(etcdClient *clientv3.Client, responseText []byte) {
 
 	CBLogger.Tracef("The requested CLADNet specification: %v", cladnetSpec.String())
 
+	// Validate the specification before the request
+	if err := validator.CheckSpecification(tempSpec); err != nil {
+		CBLogger.Error(err)
+		sendValidationErrors(err)
+		return
+	}
+
 	// Request to create CLADNet
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -247,7 +237,9 @@ func handleCreateCLADNet(etcdClient *clientv3.Client, responseText []byte) {
 	r, err := cladnetClient.CreateCLADNet(ctx, cladnetSpec)
 
 	if err != nil {
-		log.Fatalf("could not request: %v", err)
+		CBLogger.Errorf("could not request: %v", err)
+		sendValidationErrors(err)
+		return
 	}
 
 	// Response for the request
@@ -256,7 +248,24 @@ func handleCreateCLADNet(etcdClient *clientv3.Client, responseText []byte) {
 	CBLogger.Debug("End.........")
 }
 
-func handleTestCLADNet(etcdClient *clientv3.Client, responseText string) {
+// sendValidationErrors sends the invalid fields of a request to the front-end
+func sendValidationErrors(err error) {
+	fieldErrors := validator.FieldErrors(err)
+	if len(fieldErrors) == 0 {
+		fieldErrors = validator.Errors{{Field: "", Description: err.Error()}}
+	}
+
+	fieldErrorsBytes, _ := json.Marshal(fieldErrors)
+	responseBytes := buildResponseBytes("ValidationError", string(fieldErrorsBytes))
+
+	CBLogger.Debug("Send the validation errors to admin-web frontend")
+	sendErr := sendMessageToAllPool(responseBytes)
+	if sendErr != nil {
+		CBLogger.Error(sendErr)
+	}
+}
+
+func handleTestCLADNet(responseText string) {
 	CBLogger.Debug("Start.........")
 
 	cladnetID := gjson.Get(responseText, "cladnetId").String()
@@ -287,7 +296,7 @@ func handleTestCLADNet(etcdClient *clientv3.Client, responseText string) {
 	CBLogger.Debug("End.........")
 }
 
-func handleControlCLADNet(etcdClient *clientv3.Client, responseText string) {
+func handleControlCLADNet(responseText string) {
 	CBLogger.Debug("Start.........")
 
 	cladnetID := gjson.Get(responseText, "cladnetId").String()
@@ -315,26 +324,24 @@ func handleControlCLADNet(etcdClient *clientv3.Client, responseText string) {
 	CBLogger.Debug("End.........")
 }
 
-func getExistingNetworkInfo(etcdClient *clientv3.Client) error {
+func getExistingNetworkInfo(cbnetStore store.Store) error {
 
 	// Get all peers
-	CBLogger.Debugf("Get with prefix - %v", etcdkey.Peer)
-	getResp, etcdErr := etcdClient.Get(context.Background(), etcdkey.Peer, clientv3.WithPrefix())
-	if etcdErr != nil {
-		CBLogger.Error(etcdErr)
+	CBLogger.Debug("Get peers")
+	peers, errStore := cbnetStore.ListPeers(context.Background(), "")
+	if errStore != nil {
+		CBLogger.Error(errStore)
 	}
-	CBLogger.Tracef("GetResponse: %#v", getResp)
-
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*getResp)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
 
-	for _, kv := range getResp.Kvs {
-		CBLogger.Tracef("CLADNet ID: %v", kv.Key)
-		CBLogger.Tracef("A peer of the CLADNet: %v", kv.Value)
+	for _, peer := range peers {
+		CBLogger.Tracef("CLADNet ID: %v", peer.CladnetID)
+		CBLogger.Tracef("A peer of the CLADNet: %v", peer)
 		CBLogger.Debug("Send a peer of CLADNet to admin-web frontend")
 
+		peerBytes, _ := json.Marshal(peer)
+
 		// Build the response bytes of a networking rule
-		responseBytes := buildResponseBytes("peer", string(kv.Value))
+		responseBytes := buildResponseBytes("peer", string(peerBytes))
 
 		// Send the networking rule to the front-end
 		CBLogger.Debug("Send the networking rule to admin-web frontend")
@@ -345,39 +352,33 @@ func getExistingNetworkInfo(etcdClient *clientv3.Client) error {
 
 	}
 
-	if getResp.Count == 0 {
+	if len(peers) == 0 {
 		CBLogger.Debug("no networking rule of CLADNet exists")
 	}
 
+	// Get and send the specifications of the CLADNets
+	return sendCLADNetList(cbnetStore)
+}
+
+// sendCLADNetList sends the specifications of all CLADNets to the front-end
+func sendCLADNetList(cbnetStore store.Store) error {
+
 	// Get the specification of the CLADNet
-	CBLogger.Debugf("Get with prefix - %v", etcdkey.CLADNetSpecification)
-	respMultiSpec, err := etcdClient.Get(context.Background(), etcdkey.CLADNetSpecification, clientv3.WithPrefix())
+	CBLogger.Debug("Get CLADNet specifications")
+	specs, err := cbnetStore.ListSpecs(context.Background())
 	if err != nil {
 		CBLogger.Error(err)
 		return err
 	}
-	CBLogger.Tracef("GetResponse: %#v", respMultiSpec)
-
-	totalSize, headerSize, kvsSize, kvsCount = extractSizes(*respMultiSpec)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
 
-	if len(respMultiSpec.Kvs) != 0 {
-		var cladnetSpecificationList []string
-		for _, Spec := range respMultiSpec.Kvs {
-			cladnetSpecificationList = append(cladnetSpecificationList, string(Spec.Value))
-		}
-
-		CBLogger.Tracef("CladnetSpecificationList: %v", cladnetSpecificationList)
+	if len(specs) != 0 {
+		CBLogger.Tracef("CladnetSpecificationList: %v", specs)
 
 		// Build response JSON
-		var buf bytes.Buffer
-		text := strings.Join(cladnetSpecificationList, ",")
-		buf.WriteString("[")
-		buf.WriteString(text)
-		buf.WriteString("]")
+		specsBytes, _ := json.Marshal(specs)
 
 		// Build the response bytes of a CLADNet list
-		responseBytes := buildResponseBytes("CLADNetList", buf.String())
+		responseBytes := buildResponseBytes("CLADNetList", string(specsBytes))
 
 		// Send the CLADNet list to the front-end
 		CBLogger.Debug("Send the CLADNet list to admin-web frontend")
@@ -474,7 +475,7 @@ func RunEchoServer(wg *sync.WaitGroup, config model.Config) {
 	// Render
 	e.GET("/ws", WebsocketHandler)
 
-	CBNet := cbnet.New("temp", "")
+	CBNet := cbnet.New("temp", "", cbnet.WithLogger(logger.FromLogrus(CBLogger)))
 
 	adminWebURL := fmt.Sprintf("http://%s:%s", config.AdminWeb.Host, config.AdminWeb.Port)
 	localhostURL := fmt.Sprintf("http://%s:%s", "localhost", config.AdminWeb.Port)
@@ -494,20 +495,20 @@ func RunEchoServer(wg *sync.WaitGroup, config model.Config) {
 	CBLogger.Debug("End.........")
 }
 
-func watchPeer(wg *sync.WaitGroup, etcdClient *clientv3.Client) {
+func watchPeer(wg *sync.WaitGroup, cbnetStore store.Store) {
 	CBLogger.Debug("Start.........")
 	defer wg.Done()
 
-	// Watch "/registry/cloud-adaptive-network/peer"
-	CBLogger.Debugf("Watch with prefix - %v ", etcdkey.Peer)
-	watchChan1 := etcdClient.Watch(context.Background(), etcdkey.Peer, clientv3.WithPrefix())
+	// Watch peers of all CLADNets
+	CBLogger.Debug("Watch peers")
+	watchChan1 := cbnetStore.WatchPeers(context.Background(), "", 0)
 	for watchResponse := range watchChan1 {
 		for _, event := range watchResponse.Events {
 			switch event.Type {
-			case mvccpb.PUT: // The watched value has changed.
-				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
+			case store.EventPut: // The watched value has changed.
+				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
 
-				peer := event.Kv.Value
+				peer := event.Value
 				CBLogger.Tracef("A peer of CLADNet: %v", string(peer))
 
 				// Build the response bytes of the networking rule
@@ -520,80 +521,49 @@ func watchPeer(wg *sync.WaitGroup, etcdClient *clientv3.Client) {
 					CBLogger.Error(sendErr)
 				}
 
-			case mvccpb.DELETE: // The watched key has been deleted.
-				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
+			case store.EventDelete: // The watched key has been deleted.
+				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
 			default:
-				CBLogger.Errorf("Known event (%s), Key(%q), Value(%q)", event.Type, event.Kv.Key, event.Kv.Value)
+				CBLogger.Errorf("Known event (%s), Key(%q), Value(%q)", event.Type, event.Key, event.Value)
 			}
 		}
 	}
 	CBLogger.Debug("End.........")
 }
 
-func watchCLADNetSpecification(wg *sync.WaitGroup, etcdClient *clientv3.Client) {
+func watchCLADNetSpecification(wg *sync.WaitGroup, cbnetStore store.Store) {
 	CBLogger.Debug("Start.........")
 	defer wg.Done()
 
-	// It doesn't work for the time being
-	// Watch "/registry/cloud-adaptive-network/cladnet-specification"
-	CBLogger.Debugf("Watch with prefix - %v ", etcdkey.CLADNetSpecification)
-	watchChan1 := etcdClient.Watch(context.Background(), etcdkey.CLADNetSpecification, clientv3.WithPrefix())
+	// Watch the specifications of CLADNets
+	CBLogger.Debug("Watch CLADNet specifications")
+	watchChan1 := cbnetStore.WatchSpecs(context.Background())
 	for watchResponse := range watchChan1 {
 		for _, event := range watchResponse.Events {
-			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
-			CBLogger.Tracef("Updated CLADNet: %v", string(event.Kv.Value))
+			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
+			CBLogger.Tracef("Updated CLADNet: %v", string(event.Value))
 
-			// Get the specification of the CLADNet
-			CBLogger.Debugf("Get with prefix - %v", etcdkey.CLADNetSpecification)
-			respMultiSpec, err := etcdClient.Get(context.Background(), etcdkey.CLADNetSpecification, clientv3.WithPrefix())
-			if err != nil {
+			// Get and send the specifications of the CLADNets
+			if err := sendCLADNetList(cbnetStore); err != nil {
 				CBLogger.Error(err)
 			}
-
-			if len(respMultiSpec.Kvs) != 0 {
-				var cladnetSpecificationList []string
-				for _, Spec := range respMultiSpec.Kvs {
-					cladnetSpecificationList = append(cladnetSpecificationList, string(Spec.Value))
-				}
-
-				CBLogger.Tracef("cladnetSpecificationList: %v", cladnetSpecificationList)
-
-				// Build response JSON
-				var buf bytes.Buffer
-				text := strings.Join(cladnetSpecificationList, ",")
-				buf.WriteString("[")
-				buf.WriteString(text)
-				buf.WriteString("]")
-
-				// Build the response bytes of a CLADNet list
-				responseBytes := buildResponseBytes("CLADNetList", buf.String())
-
-				// Send the CLADNet list to the front-end
-				CBLogger.Debug("Send the CLADNet list to admin-web frontend")
-				sendErr := sendMessageToAllPool(responseBytes)
-				if sendErr != nil {
-					CBLogger.Error(sendErr)
-				}
-			}
 		}
 	}
 	CBLogger.Debug("End.........")
 }
 
-func watchStatusInformation(wg *sync.WaitGroup, etcdClient *clientv3.Client) {
+func watchStatusInformation(wg *sync.WaitGroup, cbnetStore store.Store) {
 	defer wg.Done()
 
-	// Watch "/registry/cloud-adaptive-network/status/information/{cladnet-id}/{host-id}"
-	CBLogger.Debugf("Watch with prefix - %v", etcdkey.StatusInformation)
-	watchChan1 := etcdClient.Watch(context.Background(), etcdkey.StatusInformation, clientv3.WithPrefix())
+	// Watch the network status of all CLADNets
+	CBLogger.Debug("Watch network status")
+	watchChan1 := cbnetStore.WatchNetworkStatus(context.Background())
 	for watchResponse := range watchChan1 {
 		for _, event := range watchResponse.Events {
-			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
-			slicedKeys := strings.Split(string(event.Kv.Key), "/")
-			parsedHostID := slicedKeys[len(slicedKeys)-1]
-			CBLogger.Tracef("ParsedHostID: %v", parsedHostID)
+			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
+			CBLogger.Tracef("ParsedHostID: %v", event.HostID)
 
-			status := string(event.Kv.Value)
+			status := string(event.Value)
 			CBLogger.Tracef("The status: %v", status)
 
 			// Build the response bytes of the networking rule
@@ -610,6 +580,34 @@ func watchStatusInformation(wg *sync.WaitGroup, etcdClient *clientv3.Client) {
 	CBLogger.Debug("End.........")
 }
 
+func watchPeerStatistics(wg *sync.WaitGroup, cbnetStore store.Store) {
+	CBLogger.Debug("Start.........")
+	defer wg.Done()
+
+	// Watch the traffic statistics of all CLADNets
+	CBLogger.Debug("Watch peer statistics")
+	watchChan1 := cbnetStore.WatchPeerStatistics(context.Background())
+	for watchResponse := range watchChan1 {
+		for _, event := range watchResponse.Events {
+			if event.Type != store.EventPut {
+				continue
+			}
+			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
+
+			// Build the response bytes of the peer statistics
+			responseBytes := buildResponseBytes("PeerStatistics", string(event.Value))
+
+			// Send the peer statistics to the front-end
+			CBLogger.Debug("Send the peer statistics to admin-web frontend")
+			sendErr := sendMessageToAllPool(responseBytes)
+			if sendErr != nil {
+				CBLogger.Error(sendErr)
+			}
+		}
+	}
+	CBLogger.Debug("End.........")
+}
+
 func main() {
 	CBLogger.Debug("Start.........")
 
@@ -617,17 +615,15 @@ func main() {
 	var wg sync.WaitGroup
 
 	// etcd section
-	etcdClient, err := clientv3.New(clientv3.Config{
-		Endpoints:   config.ETCD.Endpoints,
-		DialTimeout: 5 * time.Second,
-	})
+	etcdClient, err := etcdclient.New(config.ETCD)
 
 	if err != nil {
 		CBLogger.Fatal(err)
 	}
 
+	cbnetStore = store.NewEtcdStore(etcdClient)
 	defer func() {
-		errClose := etcdClient.Close()
+		errClose := cbnetStore.Close()
 		if errClose != nil {
 			CBLogger.Fatal("Can't close the etcd client", errClose)
 		}
@@ -636,8 +632,9 @@ func main() {
 	CBLogger.Infoln("The etcdClient is connected.")
 
 	// gRPC client section
-	options := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	options, err := grpcauth.DialOptions(config.Service)
+	if err != nil {
+		log.Fatalf("Cannot configure credentials: %v", err)
 	}
 
 	grpcConn, err := grpc.Dial(config.Service.Endpoint, options...)
@@ -658,13 +655,16 @@ func main() {
 
 	// watch section
 	wg.Add(1)
-	go watchPeer(&wg, etcdClient)
+	go watchPeer(&wg, cbnetStore)
 
 	wg.Add(1)
-	go watchCLADNetSpecification(&wg, etcdClient)
+	go watchCLADNetSpecification(&wg, cbnetStore)
 
 	wg.Add(1)
-	go watchStatusInformation(&wg, etcdClient)
+	go watchStatusInformation(&wg, cbnetStore)
+
+	wg.Add(1)
+	go watchPeerStatistics(&wg, cbnetStore)
 
 	wg.Add(1)
 	go RunEchoServer(&wg, config)
@@ -676,43 +676,3 @@ func main() {
 
 	CBLogger.Debug("End.........")
 }
-
-func extractSizes(res clientv3.GetResponse) (totalSize, headerSize, kvsSize, kvsCount int) {
-
-	headerSize = res.Header.Size()
-	kvsCount = int(res.Count)
-	kvsSize = 0
-	for _, kv := range res.Kvs {
-		kvsSize += kv.Size()
-	}
-
-	totalSize = headerSize + kvsSize
-
-	return totalSize, headerSize, kvsSize, kvsCount
-}
-
-func createFieldsForResponseSizes(res clientv3.GetResponse) logrus.Fields {
-
-	// lenKvs := res.Count
-	fields := logrus.Fields{}
-
-	headerSize := res.Header.Size()
-	kvSize := 0
-	for _, kv := range res.Kvs {
-		kvSize += kv.Size()
-	}
-
-	totalSize := headerSize + kvSize
-
-	fields["total size"] = totalSize
-	fields["header size"] = headerSize
-	fields["kvs size"] = kvSize
-
-	for i, kv := range res.Kvs {
-		tempKey := "kv " + strconv.Itoa(i)
-		fields[tempKey] = kv.Size()
-		// kvSize += kv.Size()
-	}
-
-	return fields
-}
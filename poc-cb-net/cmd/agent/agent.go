@@ -9,24 +9,31 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
-	"strings"
+	"strconv"
 	"sync"
 	"syscall"
 	"time"
 
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	capturefilter "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/capture-filter"
 	cbnet "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network"
 	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	cloudinfo "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cloud-info"
 	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
-	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
 	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/logger"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/metrics"
 	netstate "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-state"
+	publicip "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/public-ip"
 	testtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/test-type"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tracing"
 	cblog "github.com/cloud-barista/cb-log"
 	"github.com/go-ping/ping"
 	"github.com/sirupsen/logrus"
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	clientv3 "go.etcd.io/etcd/client/v3"
-	"go.etcd.io/etcd/client/v3/concurrency"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // CBNet represents a network for the multi-cloud.
@@ -37,6 +44,29 @@ var CBLogger *logrus.Logger
 var config model.Config
 var loggerNamePrefix = "agent"
 
+// Client of the cb-network service
+var agentClient pb.AgentServiceClient
+
+// The pending cleanup of the previous IP address of this peer while re-addressing (see readdress)
+var readdressingMutex sync.Mutex
+var cancelReaddressing context.CancelFunc
+var pendingIPv4CIDR string
+
+// tunnelState represents the state of this peer (1 for the current state, 0 for the others)
+var tunnelState = metrics.NewGaugeVec("cbnet_agent_tunnel_state",
+	"State of the tunnel of this peer (1 for the current state).", "state")
+
+const (
+	// agentRPCTimeout is the timeout of a unary call to the cb-network service
+	agentRPCTimeout = 10 * time.Second
+	// watchRetryInterval is the interval to retry watching events after the stream is broken
+	watchRetryInterval = 3 * time.Second
+	// heartbeatInterval is the interval to send a heartbeat to the cb-network service
+	heartbeatInterval = 10 * time.Second
+	// statisticsInterval is the interval to publish the traffic statistics to the cb-network service
+	statisticsInterval = 30 * time.Second
+)
+
 func init() {
 	fmt.Println("\nStart......... init() of agent.go")
 
@@ -70,26 +100,14 @@ func init() {
 	cladnetID := config.CBNetwork.CLADNetID
 	tunnelingPort := config.CBNetwork.Host.TunnelingPort
 	networkInterfaceName := config.CBNetwork.Host.NetworkInterfaceName
-	var hostName string
 
-	// Set host name
-	if config.CBNetwork.Host.Name == "" {
-		name, err := os.Hostname()
-		if err != nil {
-			CBLogger.Error(err)
-		}
-		hostName = name
-	} else {
-		hostName = config.CBNetwork.Host.Name
+	// Load the host ID first, after which the logger is named
+	hostID, err := cbnet.LoadHostID(logger.Discard())
+	if err != nil {
+		panic(err)
 	}
 
-	// Create CBNetwork instance with port, which is a tunneling port
-	CBNet = cbnet.New(networkInterfaceName, tunnelingPort)
-	CBNet.ConfigureHostID()
-	CBNet.CLADNetID = cladnetID
-	CBNet.HostName = hostName
-
-	loggerName := fmt.Sprintf("%s-%s", loggerNamePrefix, CBNet.HostID)
+	loggerName := fmt.Sprintf("%s-%s", loggerNamePrefix, hostID)
 
 	// Set cb-log
 	logConfPath := ""
@@ -130,37 +148,119 @@ func init() {
 	CBLogger.Debugf("Load %v", configPath)
 	CBLogger.Debugf("Load %v", logConfPath)
 
+	// Set host name
+	var hostName string
+	if config.CBNetwork.Host.Name == "" {
+		name, err := os.Hostname()
+		if err != nil {
+			CBLogger.Error(err)
+		}
+		hostName = name
+	} else {
+		hostName = config.CBNetwork.Host.Name
+	}
+
+	// Create CBNetwork instance with port, which is a tunneling port.
+	// The logger is injected at once, whose log entries are tagged with this peer.
+	CBNet = cbnet.New(networkInterfaceName, tunnelingPort, cbnet.WithLogger(logger.FromLogrus(CBLogger).WithFields(logger.Fields{
+		logger.CLADNetID: cladnetID,
+		logger.HostID:    hostID,
+	})))
+	CBNet.HostID = hostID
+	CBNet.CLADNetID = cladnetID
+	CBNet.HostName = hostName
+	CBNet.JoinToken = config.CBNetwork.JoinToken
+	CBNet.UnderlayIPv4InterfaceName = config.CBNetwork.Host.UnderlayIPv4InterfaceName
+	CBNet.UnderlayIPv6InterfaceName = config.CBNetwork.Host.UnderlayIPv6InterfaceName
+	CBNet.PublicIPResolver = publicip.NewDefaultResolver(config.CBNetwork.Host.PublicIP)
+
 	fmt.Println("End......... init() of agent.go")
 	fmt.Println("")
 }
 
-// Control the cb-network agent by commands from remote
-func watchControlCommand(ctx context.Context, etcdClient *clientv3.Client, wg *sync.WaitGroup) {
+// Watch events of an event type through the cb-network service, and resume watching if the stream is broken
+func watchAgentEvents(ctx context.Context, eventType pb.AgentEventType, handleEvent func(event *pb.AgentEvent), resync func()) {
 	CBLogger.Debug("Start.........")
 
-	defer wg.Done()
+	var lastRevision int64
 
-	cladnetID := CBNet.CLADNetID
-	hostID := CBNet.HostID
+	for {
+		watchReq := &pb.AgentWatchRequest{
+			CladnetId: CBNet.CLADNetID,
+			HostId:    CBNet.HostID,
+			EventType: eventType,
+		}
+		// Resume watching from the revision after the last event
+		if lastRevision > 0 {
+			watchReq.StartRevision = lastRevision + 1
+		}
 
-	// Watch "/registry/cloud-adaptive-network/control-command/{cladnet-id}/{host-id}
-	keyControlCommand := fmt.Sprint(etcdkey.ControlCommand + "/" + cladnetID + "/" + hostID)
-	CBLogger.Debugf("Watch - %v", keyControlCommand)
-	watchChan1 := etcdClient.Watch(ctx, keyControlCommand)
-	for watchResponse := range watchChan1 {
-		for _, event := range watchResponse.Events {
-			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
+		CBLogger.Debugf("Watch - %v (start revision: %v)", eventType, watchReq.StartRevision)
+		stream, err := agentClient.WatchAgentEvents(ctx, watchReq)
+		if err == nil {
+			// Wait until the watch is established
+			_, err = stream.Header()
+		}
 
-			controlCommand := cmdtype.ParseCommandMessage(string(event.Kv.Value))
+		for err == nil {
+			var event *pb.AgentEvent
+			event, err = stream.Recv()
+			if err != nil {
+				break
+			}
+			CBLogger.Tracef("Pushed - %v (deleted: %v) %q : %q", event.EventType, event.IsDeleted, event.HostId, event.Value)
 
-			handleCommand(controlCommand, etcdClient)
+			handleEvent(event)
+			lastRevision = event.Revision
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		// The revision has been compacted, so the events in the meantime are lost
+		if status.Code(err) == codes.OutOfRange {
+			lastRevision = 0
+			if resync != nil {
+				resync()
+			}
+		}
+
+		CBLogger.Errorf("the watch (%v) is broken, retry in %v: %v", eventType, watchRetryInterval, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(watchRetryInterval):
+		}
+		if ctx.Err() != nil {
+			break
 		}
 	}
+
 	CBLogger.Debug("End.........")
 }
 
-// Handle commands of the cb-network agent
-func handleCommand(controlCommand string, etcdClient *clientv3.Client) {
+// Control the cb-network agent by commands from remote
+func watchControlCommand(ctx context.Context, wg *sync.WaitGroup) {
+	CBLogger.Debug("Start.........")
+
+	defer wg.Done()
+
+	// Watch "/registry/cloud-adaptive-network/control-command/{cladnet-id}/{host-id} through the cb-network service
+	watchAgentEvents(ctx, pb.AgentEventType_CONTROL_COMMAND, func(event *pb.AgentEvent) {
+		if event.IsDeleted {
+			return
+		}
+
+		controlCommand := cmdtype.ParseCommandMessage(event.Value)
+
+		handleCommand(controlCommand, event.Value)
+	}, nil)
+
+	CBLogger.Debug("End.........")
+}
+
+// Handle commands of the cb-network agent (the message has the parameters of the command, if any)
+func handleCommand(controlCommand string, message string) {
 	CBLogger.Debug("Start.........")
 
 	CBLogger.Debugf("Command: %#v", controlCommand)
@@ -171,9 +271,9 @@ func handleCommand(controlCommand string, etcdClient *clientv3.Client) {
 		state := CBNet.ThisPeerState()
 		CBLogger.Debugf("current state: %+v", state)
 		if state == netstate.Tunneling {
-			updatePeerState(netstate.Closing, etcdClient)
+			updatePeerState(context.TODO(), netstate.Closing)
 			CBNet.CloseCBNetworkInterface()
-			updatePeerState(netstate.Released, etcdClient)
+			updatePeerState(context.TODO(), netstate.Released)
 		}
 
 	case cmdtype.Up:
@@ -187,13 +287,13 @@ func handleCommand(controlCommand string, etcdClient *clientv3.Client) {
 			// Wait until the goroutine is started
 			time.Sleep(200 * time.Millisecond)
 
-			// Try Compare-And-Swap (CAS) an agent's secret (RSA public keys)
+			// Initialize an agent's secret (RSA public keys)
 			if CBNet.IsEncryptionEnabled() {
-				initializeSecret(etcdClient)
+				initializeSecret()
 			}
 
-			// Try Compare-And-Swap (CAS) a host-network-information by cladnetID and hostId
-			initializeAgent(etcdClient)
+			// Register a host-network-information by cladnetID and hostId
+			initializeAgent()
 		}
 
 	case cmdtype.EnableEncryption:
@@ -201,7 +301,7 @@ func handleCommand(controlCommand string, etcdClient *clientv3.Client) {
 
 		CBNet.EnableEncryption(true)
 		if CBNet.IsEncryptionEnabled() {
-			initializeSecret(etcdClient)
+			initializeSecret()
 		}
 
 	case cmdtype.DisableEncryption:
@@ -209,6 +309,43 @@ func handleCommand(controlCommand string, etcdClient *clientv3.Client) {
 
 		CBNet.DisableEncryption()
 
+	case cmdtype.RotateKey:
+		CBLogger.Debug("rotate the RSA key with the grace period")
+
+		rotateKey(keyRotationGracePeriod())
+
+	case cmdtype.RevokeKey:
+		CBLogger.Debug("re-key immediately due to the revoked RSA key")
+
+		rotateKey(0)
+
+	case cmdtype.SetLogLevel:
+		level, err := logrus.ParseLevel(cmdtype.ParseCommandParameter(message, cmdtype.LogLevel))
+		if err != nil {
+			CBLogger.Error(err)
+			break
+		}
+		// NOTE - The logger of CBNetwork shares the level, because it is derived from CBLogger
+		CBLogger.Infof("Change the log level to %v", level)
+		CBLogger.SetLevel(level)
+
+	case cmdtype.TracePackets:
+		seconds, err := strconv.ParseInt(cmdtype.ParseCommandParameter(message, cmdtype.Duration), 10, 64)
+		if err != nil {
+			CBLogger.Error(err)
+			break
+		}
+		peer := cmdtype.ParseCommandParameter(message, cmdtype.Peer)
+		CBLogger.Debugf("trace the packets of the peer (%v) for %v seconds", peer, seconds)
+
+		CBNet.TracePackets(peer, time.Duration(seconds)*time.Second)
+
+	case cmdtype.CapturePackets:
+		CBLogger.Debug("capture the packets into the pcapng files")
+
+		// The capture lasts for the duration, so it doesn't block the other commands
+		go capturePackets(message)
+
 	default:
 		CBLogger.Errorf("unknown control-command => %v\n", controlCommand)
 	}
@@ -216,36 +353,100 @@ func handleCommand(controlCommand string, etcdClient *clientv3.Client) {
 	CBLogger.Debug("End.........")
 }
 
+// capturePackets captures the packets by the parameters of a command message,
+// and uploads the pcapng files (or the failure) to the cb-network service when the capture stops
+func capturePackets(message string) {
+	CBLogger.Debug("Start.........")
+
+	capture := model.PacketCapture{
+		CladnetID: CBNet.CLADNetID,
+		HostID:    CBNet.HostID,
+		CaptureID: cmdtype.ParseCommandParameter(message, cmdtype.CaptureID),
+		Filter:    cmdtype.ParseCommandParameter(message, cmdtype.Filter),
+	}
+	capture.Duration, _ = strconv.ParseInt(cmdtype.ParseCommandParameter(message, cmdtype.Duration), 10, 64)
+	capture.MaxBytes, _ = strconv.Atoi(cmdtype.ParseCommandParameter(message, cmdtype.MaxBytes))
+
+	filter, err := capturefilter.Parse(capture.Filter)
+	if err != nil {
+		CBLogger.Error(err)
+		capture.State = model.CaptureFailed
+		capture.Message = err.Error()
+		uploadPacketCapture(capture, cbnet.PacketCaptureResult{})
+		return
+	}
+
+	resultChannel, err := CBNet.CapturePackets(filter, time.Duration(capture.Duration)*time.Second, capture.MaxBytes)
+	if err != nil {
+		CBLogger.Error(err)
+		capture.State = model.CaptureFailed
+		capture.Message = err.Error()
+		uploadPacketCapture(capture, cbnet.PacketCaptureResult{})
+		return
+	}
+
+	result := <-resultChannel
+	capture.State = model.CaptureCompleted
+	capture.Message = result.Reason
+	capture.InnerPackets, capture.OuterPackets = result.InnerPackets, result.OuterPackets
+	capture.StartedAt, capture.StoppedAt = result.StartedAt, result.StoppedAt
+	uploadPacketCapture(capture, result)
+
+	CBLogger.Debug("End.........")
+}
+
+// uploadPacketCapture uploads a packet capture with the pcapng files to the cb-network service
+func uploadPacketCapture(capture model.PacketCapture, result cbnet.PacketCaptureResult) {
+	capture.InnerBytes, capture.OuterBytes = len(result.Inner), len(result.Outer)
+	packetCapture, err := json.Marshal(capture)
+	if err != nil {
+		CBLogger.Error(err)
+		return
+	}
+
+	CBLogger.Debugf("UploadPacketCapture - %v/%v/%v (%v)", capture.CladnetID, capture.HostID, capture.CaptureID, capture.State)
+	ctx, cancel := context.WithTimeout(context.Background(), agentRPCTimeout)
+	defer cancel()
+
+	_, err = agentClient.UploadPacketCapture(ctx, &pb.AgentPacketCapture{
+		CladnetId:     capture.CladnetID,
+		HostId:        capture.HostID,
+		PacketCapture: string(packetCapture),
+		Inner:         result.Inner,
+		Outer:         result.Outer,
+	})
+	if err != nil {
+		CBLogger.Error(err)
+	}
+}
+
 // Watch test request for a Cloud Adaptive Network
-func watchTestRequest(ctx context.Context, etcdClient *clientv3.Client, wg *sync.WaitGroup) {
+func watchTestRequest(ctx context.Context, wg *sync.WaitGroup) {
 	CBLogger.Debug("Start.........")
 
 	defer wg.Done()
 
-	cladnetID := CBNet.CLADNetID
-	hostID := CBNet.HostID
+	// Watch "/registry/cloud-adaptive-network/test-request/{cladnet-id}/{host-id} through the cb-network service
+	watchAgentEvents(ctx, pb.AgentEventType_TEST_REQUEST, func(event *pb.AgentEvent) {
+		if event.IsDeleted {
+			return
+		}
 
-	// Watch "/registry/cloud-adaptive-network/test-request/{cladnet-id}/{host-id}
-	keyTestRequest := fmt.Sprint(etcdkey.TestRequest + "/" + cladnetID + "/" + hostID)
-	CBLogger.Debugf("Watch - %v", keyTestRequest)
-	watchChan1 := etcdClient.Watch(ctx, keyTestRequest)
-	for watchResponse := range watchChan1 {
-		for _, event := range watchResponse.Events {
-			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
+		testType, testSpec := testtype.ParseTestMessage(event.Value)
+		runID := testtype.ParseTestRunID(event.Value)
 
-			testType, testSpec := testtype.ParseTestMessage(string(event.Kv.Value))
+		handleTest(testType, runID, testSpec)
+	}, nil)
 
-			handleTest(testType, testSpec, etcdClient)
-		}
-	}
 	CBLogger.Debug("End.........")
 }
 
 // Handle testing a Cloud Adaptive Network
-func handleTest(testType string, testSpec string, etcdClient *clientv3.Client) {
+func handleTest(testType string, runID string, testSpec string) {
 	CBLogger.Debug("Start.........")
 
 	CBLogger.Debugf("TestType: %#v", testType)
+	CBLogger.Debugf("RunID: %#v", runID)
 	CBLogger.Debugf("TestSpec: %#v", testSpec)
 
 	switch testType {
@@ -255,7 +456,16 @@ func handleTest(testType string, testSpec string, etcdClient *clientv3.Client) {
 		state := CBNet.ThisPeerState()
 		CBLogger.Debugf("current state: %+v", state)
 		if state == netstate.Tunneling {
-			checkConnectivity(testSpec, etcdClient)
+			checkConnectivity(runID, testSpec)
+		}
+
+	case testtype.Throughput, testtype.PathMTU, testtype.Jitter, testtype.PortReachability, testtype.UnderlayOverlay:
+		CBLogger.Debugf("run %v in 'tunneling' state", testType)
+
+		state := CBNet.ThisPeerState()
+		CBLogger.Debugf("current state: %+v", state)
+		if state == netstate.Tunneling {
+			runNetworkTest(testType, runID, testSpec)
 		}
 
 	default:
@@ -265,12 +475,9 @@ func handleTest(testType string, testSpec string, etcdClient *clientv3.Client) {
 	CBLogger.Debug("End.........")
 }
 
-func checkConnectivity(data string, etcdClient *clientv3.Client) {
+func checkConnectivity(runID string, data string) {
 	CBLogger.Debug("Start.........")
 
-	cladnetID := CBNet.CLADNetID
-	hostID := CBNet.HostID
-
 	// Get the trial count
 	var testSpecification model.TestSpecification
 	errUnmarshalEvalSpec := json.Unmarshal([]byte(data), &testSpecification)
@@ -313,7 +520,7 @@ func checkConnectivity(data string, etcdClient *clientv3.Client) {
 	testwg.Wait()
 
 	// Gather the evaluation results
-	var networkStatus model.NetworkStatus
+	networkStatus := model.NetworkStatus{TestType: testtype.Connectivity, RunID: runID}
 	for i := 0; i < len(out); i++ {
 		networkStatus.InterHostNetworkStatus = append(networkStatus.InterHostNetworkStatus, out[i])
 	}
@@ -322,26 +529,38 @@ func checkConnectivity(data string, etcdClient *clientv3.Client) {
 		networkStatus.InterHostNetworkStatus = make([]model.InterHostNetworkStatus, 0)
 	}
 
-	// Put the network status of the CLADNet to the etcd
-	// Key: /registry/cloud-adaptive-network/status/information/{cladnet-id}/{host-id}
-	keyStatusInformation := fmt.Sprint(etcdkey.StatusInformation + "/" + cladnetID + "/" + hostID)
+	postNetworkStatus(networkStatus)
+
+	CBLogger.Debug("End.........")
+}
 
+// postNetworkStatus posts the network status of the CLADNet (i.e., a test result) to the cb-network service
+func postNetworkStatus(networkStatus model.NetworkStatus) {
+	cladnetID := CBNet.CLADNetID
+	hostID := CBNet.HostID
+
+	networkStatus.HostID = hostID
+	networkStatus.IsEncrypted = CBNet.IsEncryptionEnabled()
 	networkStatusBytes, _ := json.Marshal(networkStatus)
-	networkStatueStr := string(networkStatusBytes)
 
-	CBLogger.Debugf("Put - %v", keyStatusInformation)
+	CBLogger.Debugf("PostTestResult - %v/%v", cladnetID, hostID)
 	CBLogger.Tracef("Value: %#v", networkStatus)
 
 	size := binary.Size(networkStatusBytes)
-	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+	CBLogger.Tracef("PostTestResult size (bytes): total_size: %v", size)
 
-	putResp, err := etcdClient.Put(context.Background(), keyStatusInformation, networkStatueStr)
+	ctx, cancel := context.WithTimeout(context.Background(), agentRPCTimeout)
+	defer cancel()
+
+	resp, err := agentClient.PostTestResult(ctx, &pb.AgentTestResult{
+		CladnetId:     cladnetID,
+		HostId:        hostID,
+		NetworkStatus: string(networkStatusBytes),
+	})
 	if err != nil {
 		CBLogger.Error(err)
 	}
-	CBLogger.Tracef("PutResponse: %#v", putResp)
-
-	CBLogger.Debug("End.........")
+	CBLogger.Tracef("PostTestResult response: %#v", resp)
 }
 
 func pingTest(outVal *model.InterHostNetworkStatus, wg *sync.WaitGroup, trialCount int) {
@@ -379,282 +598,507 @@ func pingTest(outVal *model.InterHostNetworkStatus, wg *sync.WaitGroup, trialCou
 	CBLogger.Debug("End.........")
 }
 
-func initializeAgent(etcdClient *clientv3.Client) {
+// detectCloudInformation detects the cloud information of this host by the instance metadata services,
+// and overrides it by the configured one (e.g., for an unsupported cloud or the fields not provided by the metadata)
+func detectCloudInformation(hostConfig model.HostConfig) model.CloudInformation {
+	CBLogger.Debug("Start.........")
+
+	var detected model.CloudInformation
+	if !hostConfig.DisableCloudDetection {
+		var err error
+		detected, err = cloudinfo.Detect(context.Background(), cloudinfo.DefaultDetectors(), cloudinfo.DefaultTimeout)
+		if err != nil {
+			CBLogger.Warn(err)
+		}
+	}
+
+	cloudInformation := cloudinfo.Override(detected, hostConfig.CloudInformation)
+	CBLogger.Tracef("Cloud information: %+v", cloudInformation)
+
+	CBLogger.Debug("End.........")
+	return cloudInformation
+}
+
+func initializeAgent() {
 	CBLogger.Debug("Start.........")
 
 	cladnetID := CBNet.CLADNetID
 	hostID := CBNet.HostID
 
+	// Start a trace of the agent registration, which is continued by the service, the controller and this agent
+	traceCtx, span := tracing.Start(context.Background(), "agent.register",
+		tracing.CLADNetID.String(cladnetID), tracing.HostID.String(hostID))
+	defer span.End()
+
 	// Get this host's network information
 	CBLogger.Debug("Get the host network information")
-	CBNet.UpdateHostNetworkInformation()
+	if err := CBNet.UpdateHostNetworkInformation(); err != nil {
+		CBLogger.Error(err)
+		if CBNet.HostPublicIP == "" {
+			CBLogger.Error("could not register this agent without the public IP address (set 'public_ip' in config.yaml if needed)")
+			return
+		}
+	}
 	temp := CBNet.GetHostNetworkInformation()
 	currentHostNetworkInformationBytes, _ := json.Marshal(temp)
-	currentHostNetworkInformationStr := string(currentHostNetworkInformationBytes)
 
-	keyHostNetworkInformation := fmt.Sprint(etcdkey.HostNetworkInformation + "/" + cladnetID + "/" + hostID)
-	CBLogger.Debugf("Put - %v", keyHostNetworkInformation)
+	CBLogger.Debugf("RegisterAgent - %v/%v", cladnetID, hostID)
 	CBLogger.Tracef("Value %#v", temp)
 
 	size := binary.Size(currentHostNetworkInformationBytes)
-	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+	CBLogger.Tracef("RegisterAgent size (bytes): total_size: %v", size)
 
-	putResp, err := etcdClient.Put(context.TODO(), keyHostNetworkInformation, currentHostNetworkInformationStr)
-	if err != nil {
+	ctx, cancel := context.WithTimeout(traceCtx, agentRPCTimeout)
+	defer cancel()
+
+	resp, err := agentClient.RegisterAgent(ctx, &pb.AgentRegistration{
+		CladnetId:              cladnetID,
+		HostId:                 hostID,
+		HostNetworkInformation: string(currentHostNetworkInformationBytes),
+	})
+	if status.Code(err) == codes.PermissionDenied {
+		CBLogger.Errorf("this agent has been rejected to join the CLADNet (%v) (check 'join_token' in config.yaml): %v", cladnetID, err)
+		span.RecordError(err)
+	} else if err != nil {
 		CBLogger.Error(err)
+		span.RecordError(err)
 	}
-	CBLogger.Tracef("PutResponse: %#v", putResp)
+	CBLogger.Tracef("RegisterAgent response: %#v", resp)
 
 	CBLogger.Debug("End.........")
 }
 
-func updatePeerState(state string, etcdClient *clientv3.Client) {
+func updatePeerState(ctx context.Context, state string) {
 	CBLogger.Debug("Start.........")
 
-	tempPeer := CBNet.ThisPeer
-	tempPeer.State = state
-
-	keyPeer := fmt.Sprint(etcdkey.Peer + "/" + CBNet.CLADNetID + "/" + CBNet.HostID)
+	CBLogger.Debugf("UpdatePeerState - %v/%v (state: %v)", CBNet.CLADNetID, CBNet.HostID, state)
 
-	peerBytes, _ := json.Marshal(tempPeer)
-	peerStr := string(peerBytes)
-
-	CBLogger.Debugf("Put - %v", keyPeer)
-	CBLogger.Tracef("Value: %#v", tempPeer)
+	for _, s := range []string{netstate.Configuring, netstate.Tunneling, netstate.Closing, netstate.Released, netstate.Failed, netstate.Rejected} {
+		if s == state {
+			tunnelState.WithLabelValues(s).Set(1)
+		} else {
+			tunnelState.WithLabelValues(s).Set(0)
+		}
+	}
 
-	size := binary.Size(peerBytes)
-	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+	ctx, cancel := context.WithTimeout(ctx, agentRPCTimeout)
+	defer cancel()
 
-	putResp, err := etcdClient.Put(context.TODO(), keyPeer, peerStr)
+	resp, err := agentClient.UpdatePeerState(ctx, &pb.AgentPeerState{
+		CladnetId: CBNet.CLADNetID,
+		HostId:    CBNet.HostID,
+		State:     state,
+	})
 	if err != nil {
 		CBLogger.Error(err)
 	}
-	CBLogger.Tracef("PutResponse: %#v", putResp)
+	CBLogger.Tracef("UpdatePeerState response: %#v", resp)
 
 	CBLogger.Debug("End.........")
 }
 
-func watchSecret(ctx context.Context, etcdClient *clientv3.Client, wg *sync.WaitGroup) {
+// readdress adds the new IP address of this peer to the network interface,
+// and removes the previous one after the overlap period
+func readdress(overlap time.Duration) {
 	CBLogger.Debug("Start.........")
 
-	defer wg.Done()
+	readdressingMutex.Lock()
+	defer readdressingMutex.Unlock()
+
+	// Cancel the pending cleanup of the previous re-addressing, if any, and remove its previous IP address now
+	// (a new re-addressing starts after the previous one is completed or timed out)
+	if cancelReaddressing != nil {
+		cancelReaddressing()
+		CBNet.RemoveCBNetworkAddress(pendingIPv4CIDR)
+	}
+
+	previousIPv4CIDR := CBNet.AddCBNetworkAddress()
+	updateReaddressingState(model.ReaddressingOverlapping, fmt.Sprintf("previous: %v", previousIPv4CIDR))
 
-	// Watch "/registry/cloud-adaptive-network/secret/{cladnet-id}"
-	keySecretGroup := fmt.Sprint(etcdkey.Secret + "/" + CBNet.CLADNetID)
-	CBLogger.Debugf("Watch with prefix - %v", keySecretGroup)
-	watchChan1 := etcdClient.Watch(ctx, keySecretGroup, clientv3.WithPrefix())
-	for watchResponse := range watchChan1 {
-		for _, event := range watchResponse.Events {
-			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
-			slicedKeys := strings.Split(string(event.Kv.Key), "/")
-			parsedHostID := slicedKeys[len(slicedKeys)-1]
-			CBLogger.Tracef("ParsedHostID: %v", parsedHostID)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelReaddressing = cancel
+	pendingIPv4CIDR = previousIPv4CIDR
 
-			// Update keyring (including add)
-			CBNet.UpdateKeyring(parsedHostID, string(event.Kv.Value))
+	go func() {
+		timer := time.NewTimer(overlap)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
 		}
-	}
+
+		readdressingMutex.Lock()
+		defer readdressingMutex.Unlock()
+
+		// Canceled while waiting for the lock
+		if ctx.Err() != nil {
+			return
+		}
+		cancel()
+		cancelReaddressing = nil
+		pendingIPv4CIDR = ""
+
+		CBNet.RemoveCBNetworkAddress(previousIPv4CIDR)
+		updateReaddressingState(model.ReaddressingCompleted, "")
+	}()
+
 	CBLogger.Debug("End.........")
 }
 
-func initializeSecret(etcdClient *clientv3.Client) {
+func updateReaddressingState(state string, message string) {
 	CBLogger.Debug("Start.........")
 
-	cladnetID := CBNet.CLADNetID
-	hostID := CBNet.HostID
-
-	// Create a sessions to acquire a lock
-	session, _ := concurrency.NewSession(etcdClient)
-	defer session.Close()
+	CBLogger.Debugf("UpdateReaddressingState - %v/%v (state: %v)", CBNet.CLADNetID, CBNet.HostID, state)
 
-	keyPrefix := fmt.Sprint(etcdkey.LockSecret + "/" + cladnetID)
+	ctx, cancel := context.WithTimeout(context.Background(), agentRPCTimeout)
+	defer cancel()
 
-	lock := concurrency.NewMutex(session, keyPrefix)
-	ctx := context.TODO()
-
-	// Acquire lock (or wait to have it)
-	CBLogger.Debug("Acquire a lock")
-	// Time trying to acquire a lock
-	start := time.Now()
-	if err := lock.Lock(ctx); err != nil {
+	resp, err := agentClient.UpdateReaddressingState(ctx, &pb.AgentReaddressingState{
+		CladnetId: CBNet.CLADNetID,
+		HostId:    CBNet.HostID,
+		State:     state,
+		Message:   message,
+	})
+	if err != nil {
 		CBLogger.Error(err)
 	}
-	CBLogger.Tracef("Lock acquired for '%s'", keyPrefix)
+	CBLogger.Tracef("UpdateReaddressingState response: %#v", resp)
+
+	CBLogger.Debug("End.........")
+}
+
+// Send heartbeats to the cb-network service periodically
+func sendHeartbeats(ctx context.Context, wg *sync.WaitGroup) {
+	CBLogger.Debug("Start.........")
 
-	// Get the secret
-	keySecret := fmt.Sprint(etcdkey.Secret + "/" + cladnetID)
-	CBLogger.Debugf("Get with prefix - %v", keySecret)
-	getResp, etcdErr := etcdClient.Get(context.TODO(), keySecret, clientv3.WithPrefix())
-	if etcdErr != nil {
-		CBLogger.Error(etcdErr)
+	defer wg.Done()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			CBLogger.Debug("End.........")
+			return
+		case <-ticker.C:
+			rpcCtx, cancel := context.WithTimeout(ctx, agentRPCTimeout)
+			_, err := agentClient.Heartbeat(rpcCtx, &pb.AgentHeartbeat{
+				CladnetId: CBNet.CLADNetID,
+				HostId:    CBNet.HostID,
+				State:     CBNet.ThisPeerState(),
+			})
+			cancel()
+			if err != nil {
+				CBLogger.Error(err)
+			}
+		}
 	}
-	CBLogger.Tracef("GetResponse: %#v", getResp)
+}
 
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*getResp)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
+// Publish the traffic statistics of this peer to the cb-network service periodically
+func reportPeerStatistics(ctx context.Context, wg *sync.WaitGroup) {
+	CBLogger.Debug("Start.........")
 
-	// Set the other hosts' secrets
-	for _, kv := range getResp.Kvs {
-		// Key
-		key := string(kv.Key)
-		CBLogger.Tracef("Key: %v", key)
+	defer wg.Done()
 
-		slicedKeys := strings.Split(key, "/")
-		parsedHostID := slicedKeys[len(slicedKeys)-1]
-		CBLogger.Tracef("ParsedHostID: %v", parsedHostID)
+	ticker := time.NewTicker(statisticsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			CBLogger.Debug("End.........")
+			return
+		case <-ticker.C:
+			peerStatistics, err := json.Marshal(CBNet.GetPeerStatistics())
+			if err != nil {
+				CBLogger.Error(err)
+				continue
+			}
 
-		if parsedHostID != hostID {
-			// Update keyring (including add)
-			CBLogger.Debug("Update keyring")
-			CBNet.UpdateKeyring(parsedHostID, string(kv.Value))
+			rpcCtx, cancel := context.WithTimeout(ctx, agentRPCTimeout)
+			_, err = agentClient.ReportPeerStatistics(rpcCtx, &pb.AgentPeerStatistics{
+				CladnetId:      CBNet.CLADNetID,
+				HostId:         CBNet.HostID,
+				PeerStatistics: string(peerStatistics),
+			})
+			cancel()
+			if err != nil {
+				CBLogger.Error(err)
+			}
 		}
 	}
+}
+
+func watchSecret(ctx context.Context, wg *sync.WaitGroup) {
+	CBLogger.Debug("Start.........")
+
+	defer wg.Done()
+
+	// Watch "/registry/cloud-adaptive-network/secret/{cladnet-id}" through the cb-network service
+	watchAgentEvents(ctx, pb.AgentEventType_SECRET, func(event *pb.AgentEvent) {
+		CBLogger.Tracef("ParsedHostID: %v", event.HostId)
+
+		if event.IsDeleted {
+			// Remove the revoked key from keyring
+			CBNet.RemoveKeyring(event.HostId)
+			return
+		}
+
+		// Update keyring (including add)
+		CBNet.UpdateKeyring(event.HostId, event.Value)
+	}, nil)
 
-	base64PublicKey, _ := CBNet.GetPublicKeyBase64()
+	CBLogger.Debug("End.........")
+}
+
+func initializeSecret() {
+	CBLogger.Debug("Start.........")
+
+	cladnetID := CBNet.CLADNetID
+	hostID := CBNet.HostID
+
+	base64PublicKey, err := CBNet.GetPublicKeyBase64()
+	if err != nil {
+		CBLogger.Error(err)
+		return
+	}
 	CBLogger.Tracef("Base64PublicKey: %+v", base64PublicKey)
 
-	// Transaction (compare-and-swap(CAS)) the secret
-	keySecretHost := fmt.Sprint(etcdkey.Secret + "/" + cladnetID + "/" + hostID)
-	CBLogger.Debugf("Transaction (compare-and-swap(CAS)) - %v", keySecretHost)
-	CBLogger.Tracef("Value: %v", base64PublicKey)
+	// Put this host's secret and get the other hosts' secrets
+	CBLogger.Debugf("InitializeSecret - %v/%v", cladnetID, hostID)
 
-	size := binary.Size(base64PublicKey)
-	CBLogger.Tracef("TransactionRequest size (bytes): total_size: %v", size)
+	size := binary.Size([]byte(base64PublicKey))
+	CBLogger.Tracef("InitializeSecret size (bytes): total_size: %v", size)
 
-	// NOTICE: "!=" doesn't work..... It might be a temporal issue.
-	txnResp, err := etcdClient.Txn(context.TODO()).
-		If(clientv3.Compare(clientv3.Value(keySecretHost), "=", base64PublicKey)).
-		Else(clientv3.OpPut(keySecretHost, base64PublicKey)).
-		Commit()
+	ctx, cancel := context.WithTimeout(context.Background(), agentRPCTimeout)
+	defer cancel()
 
+	secrets, err := agentClient.InitializeSecret(ctx, &pb.AgentSecret{
+		CladnetId: cladnetID,
+		HostId:    hostID,
+		PublicKey: base64PublicKey,
+	})
 	if err != nil {
 		CBLogger.Error(err)
+		return
 	}
 
-	CBLogger.Tracef("TransactionResponse: %#v", txnResp)
-	CBLogger.Tracef("ResponseHeader: %#v", txnResp.Header)
+	// Set the other hosts' secrets
+	for _, secret := range secrets.Secrets {
+		CBLogger.Tracef("ParsedHostID: %v", secret.HostId)
+
+		// Update keyring (including add)
+		CBLogger.Debug("Update keyring")
+		CBNet.UpdateKeyring(secret.HostId, secret.PublicKey)
+	}
+
+	CBLogger.Debug("End.........")
+}
+
+// keyRotationGracePeriod returns the grace period of key rotation in the config or the default
+func keyRotationGracePeriod() time.Duration {
+	if config.CBNetwork.Host.KeyRotationGracePeriod > 0 {
+		return config.CBNetwork.Host.KeyRotationGracePeriod
+	}
+	return cbnet.DefaultKeyRotationGracePeriod
+}
+
+// rotateKey replaces this host's RSA key and puts the new public key.
+// The previous key is accepted during the grace period.
+func rotateKey(gracePeriod time.Duration) {
+	CBLogger.Debug("Start.........")
 
-	// Release lock
-	CBLogger.Debug("Release a lock")
-	if err := lock.Unlock(ctx); err != nil {
+	if !CBNet.IsEncryptionEnabled() {
+		CBLogger.Debug("skip key rotation because the encryption is disabled")
+		return
+	}
+
+	if err := CBNet.RotateRSAKey(gracePeriod); err != nil {
 		CBLogger.Error(err)
+		return
 	}
-	CBLogger.Tracef("Lock released for '%s'", keyPrefix)
-	// Elapsed time from the time trying to acquire a lock
-	elapsed := time.Since(start)
-	formatted := fmt.Sprintf("%.3f", elapsed.Seconds())
-	CBLogger.Tracef("Elapsed time for locking (sec): %s", formatted)
+
+	initializeSecret()
 
 	CBLogger.Debug("End.........")
 }
 
+// Rotate this host's RSA key periodically
+func rotateKeyPeriodically(ctx context.Context, wg *sync.WaitGroup, interval time.Duration) {
+	CBLogger.Debug("Start.........")
+	defer wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			CBLogger.Debug("End.........")
+			return
+		case <-ticker.C:
+			rotateKey(keyRotationGracePeriod())
+		}
+	}
+}
+
 // Watch all peers related to the same Cloud Adaptive Network
-func watchPeers(ctx context.Context, etcdClient *clientv3.Client, wg *sync.WaitGroup) {
+func watchPeers(ctx context.Context, wg *sync.WaitGroup) {
 	CBLogger.Debug("Start.........")
 	defer wg.Done()
 
-	// Watch "/registry/cloud-adaptive-network/peer/{cladnet-id}"
-	keyPeersInCLADNet := fmt.Sprint(etcdkey.Peer + "/" + CBNet.CLADNetID)
-	CBLogger.Debugf("Watch with prefix - %v", keyPeersInCLADNet)
+	// Watch "/registry/cloud-adaptive-network/peer/{cladnet-id}" through the cb-network service
+	watchAgentEvents(ctx, pb.AgentEventType_PEER, func(event *pb.AgentEvent) {
+		if event.IsDeleted { // The watched key has been deleted.
+			return
+		}
+
+		var peer model.Peer
+		err := json.Unmarshal([]byte(event.Value), &peer)
+		if err != nil {
+			CBLogger.Error(err)
+		}
 
-	watchChan := etcdClient.Watch(ctx, keyPeersInCLADNet, clientv3.WithPrefix())
-	for watchResponse := range watchChan {
-		for _, event := range watchResponse.Events {
-			switch event.Type {
-			case mvccpb.PUT:
-				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
-				// key := string(event.Kv.Key)
+		// Store peers to synchronize and use it in local
+		// prevThisPeer := CBNet.ThisPeer
+		CBNet.StorePeer(peer)
+
+		// Continue the trace of the workflow which put the peer (e.g., the allocation by the controller)
+		ctx := tracing.Extract(context.Background(), peer.TraceContext)
+		ctx, span := tracing.Start(ctx, "agent.handlePeer",
+			tracing.CLADNetID.String(peer.CladnetID), tracing.HostID.String(peer.HostID))
+		defer span.End()
+
+		// Initialize or update networking rule
+		if peer.HostID == CBNet.HostID { // for this peer
+
+			ruleType, err := getRuleType()
+			if err != nil {
+				CBLogger.Error(err)
+				return
+			}
 
-				var peer model.Peer
-				err := json.Unmarshal(event.Kv.Value, &peer)
+			// Configure a virtual network interface for Cloud Adaptive Network, if it is the configuring state
+			if peer.State == netstate.Configuring {
+				CBLogger.Debug("Configure a virtual network interface (i.e., TUN device)")
+				_, tunSpan := tracing.Start(ctx, "agent.configureTUN")
+				err := CBNet.ConfigureCBNetworkInterface()
+				tracing.End(tunSpan, err)
 				if err != nil {
 					CBLogger.Error(err)
 				}
 
-				// Store peers to synchronize and use it in local
-				// prevThisPeer := CBNet.ThisPeer
-				CBNet.StorePeer(peer)
-
-				// Initialize or update networking rule
-				if peer.HostID == CBNet.HostID { // for this peer
-
-					ruleType, err := getRuleType(etcdClient)
-					if err != nil {
-						CBLogger.Error(err)
-						continue
-					}
-
-					// Configure a virtual network interface for Cloud Adaptive Network, if it is the configuring state
-					if peer.State == netstate.Configuring {
-						CBLogger.Debug("Configure a virtual network interface (i.e., TUN device)")
-						err := CBNet.ConfigureCBNetworkInterface()
-						if err != nil {
-							CBLogger.Error(err)
-						}
-
-						// Set initially the networking rule for this peer
-						CBLogger.Debug("Initially set the networking rule for this peer")
-						updateNetworkingRule(CBNet.ThisPeer, CBNet.OtherPeers, ruleType, etcdClient)
-
-						// Update this peer's state to "tunneling"
-						CBLogger.Debug("Change this peer's state to 'tunneling'")
-						updatePeerState(netstate.Tunneling, etcdClient)
-
-					} else if peer.State == netstate.Tunneling {
-
-						// // Update networking rule if it's not a simple state chanage of this peer
-						// if prevThisPeer.State == peer.State {
-						// Update the networking rule for this peer
-						CBLogger.Debug("Update the networking rule for this peer")
-						updateNetworkingRule(CBNet.ThisPeer, CBNet.OtherPeers, ruleType, etcdClient)
-						// }
-
-					} else {
-						CBLogger.Debugf("Skip to update the networking rule (this peer's state: %v)", peer.State)
-					}
-
-				} else { // for the other peers
-					ruleType, err := getRuleType(etcdClient)
-					if err != nil {
-						CBLogger.Error(err)
-						continue
-					}
-
-					// Keep updating networking rules if it is the tunneling state
-					if CBNet.ThisPeerState() == netstate.Tunneling {
-						updatePeerInNetworkingRule(CBNet.ThisPeer, peer, ruleType, etcdClient)
-					}
+				// Set initially the networking rule for this peer
+				CBLogger.Debug("Initially set the networking rule for this peer")
+				updateNetworkingRule(ctx, CBNet.ThisPeer, CBNet.OtherPeers, ruleType)
+
+				// Update this peer's state to "tunneling"
+				CBLogger.Debug("Change this peer's state to 'tunneling'")
+				updatePeerState(ctx, netstate.Tunneling)
+
+			} else if peer.State == netstate.Tunneling {
+
+				// Roll over to the new IP address if this peer has been re-addressed
+				if CBNet.IsReaddressed() {
+					readdress(time.Duration(peer.PreviousIPv4CIDROverlap) * time.Second)
 				}
 
-			case mvccpb.DELETE: // The watched key has been deleted.
-				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
-			default:
-				CBLogger.Errorf("Known event (%s), Key(%q), Value(%q)", event.Type, event.Kv.Key, event.Kv.Value)
+				// // Update networking rule if it's not a simple state chanage of this peer
+				// if prevThisPeer.State == peer.State {
+				// Update the networking rule for this peer
+				CBLogger.Debug("Update the networking rule for this peer")
+				updateNetworkingRule(ctx, CBNet.ThisPeer, CBNet.OtherPeers, ruleType)
+				// }
+
+			} else if peer.State == netstate.Rejected {
+				// Stop tunneling until this agent joins again with a valid join token
+				CBLogger.Errorf("this peer has been rejected by the CLADNet (%v) (check 'join_token' in config.yaml)", peer.CladnetID)
+				if CBNet.IsInterfaceConfigured() {
+					CBNet.CloseCBNetworkInterface()
+				}
+
+			} else {
+				CBLogger.Debugf("Skip to update the networking rule (this peer's state: %v)", peer.State)
 			}
 
+		} else { // for the other peers
+			ruleType, err := getRuleType()
+			if err != nil {
+				CBLogger.Error(err)
+				return
+			}
+
+			// Keep updating networking rules if it is the tunneling state
+			if CBNet.ThisPeerState() == netstate.Tunneling {
+				updatePeerInNetworkingRule(ctx, CBNet.ThisPeer, peer, ruleType)
+			}
 		}
+	}, synchronizePeers)
+
+	CBLogger.Debug("End.........")
+}
+
+// Synchronize all peers in local
+func synchronizePeers() {
+	CBLogger.Debug("Start.........")
+
+	ctx, cancel := context.WithTimeout(context.Background(), agentRPCTimeout)
+	defer cancel()
+
+	peers, err := agentClient.GetPeerList(ctx, &pb.AgentRequest{CladnetId: CBNet.CLADNetID, HostId: CBNet.HostID})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			CBLogger.Error(err)
+		}
+		return
+	}
+
+	for _, p := range peers.Peers {
+		CBLogger.Tracef("The peer: %v", p)
+
+		peer := model.Peer{
+			CladnetID:           p.CladnetId,
+			HostID:              p.HostId,
+			HostName:            p.HostName,
+			HostPrivateIPv4CIDR: p.HostPrivateIpv4Cidr,
+			HostPrivateIP:       p.HostPrivateIp,
+			HostPublicIP:        p.HostPublicIp,
+			IPv4CIDR:            p.Ipv4Cidr,
+			IP:                  p.Ip,
+			State:               p.State,
+		}
+		if p.Details != nil {
+			peer.Details = model.CloudInformation{
+				ProviderName:       p.Details.ProviderName,
+				RegionID:           p.Details.RegionId,
+				AvailabilityZoneID: p.Details.AvailabilityZoneId,
+				VirtualNetworkID:   p.Details.VirtualNetworkId,
+				SubnetID:           p.Details.SubnetId,
+			}
+		}
+
+		// Store peers to synchronize and use it in local
+		CBNet.StorePeer(peer)
 	}
+
 	CBLogger.Debug("End.........")
 }
 
-func getRuleType(etcdClient *clientv3.Client) (string, error) {
+func getRuleType() (string, error) {
 	CBLogger.Debug("Start.........")
 
 	// Get a ruleType from the specification of Cloud Adaptive Network
-	keyCLADNetSpec := fmt.Sprint(etcdkey.CLADNetSpecification + "/" + CBNet.CLADNetID)
-	CBLogger.Debugf("Get - %v", keyCLADNetSpec)
+	CBLogger.Debugf("GetCLADNet - %v", CBNet.CLADNetID)
 
-	respCLADNetSpec, etcdErr := etcdClient.Get(context.Background(), keyCLADNetSpec)
-	if etcdErr != nil {
-		CBLogger.Error(etcdErr)
-		return "", etcdErr
-	}
-	CBLogger.Tracef("GetResponse: %#v", respCLADNetSpec)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respCLADNetSpec)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
+	ctx, cancel := context.WithTimeout(context.Background(), agentRPCTimeout)
+	defer cancel()
 
-	var cladnetSpec model.CLADNetSpecification
-	if err := json.Unmarshal(respCLADNetSpec.Kvs[0].Value, &cladnetSpec); err != nil {
+	cladnetSpec, err := agentClient.GetCLADNet(ctx, &pb.AgentRequest{CladnetId: CBNet.CLADNetID, HostId: CBNet.HostID})
+	if err != nil {
 		CBLogger.Error(err)
 		return "", err
 	}
@@ -667,9 +1111,36 @@ func getRuleType(etcdClient *clientv3.Client) (string, error) {
 	return ruleType, nil
 }
 
-func updateNetworkingRule(thisPeer model.Peer, otherPeers map[string]model.Peer, ruleType string, etcdClient *clientv3.Client) {
+func putNetworkingRule(ctx context.Context, thisPeer model.Peer, networkingRule model.NetworkingRule) {
+
+	networkingRuleBytes, _ := json.Marshal(networkingRule)
+	CBLogger.Debugf("PutNetworkingRule - %v/%v", thisPeer.CladnetID, thisPeer.HostID)
+	CBLogger.Tracef("Value: %#v", networkingRule)
+
+	size := binary.Size(networkingRuleBytes)
+	networkingRuleCount := len(networkingRule.HostID)
+	CBLogger.Tracef("PutNetworkingRule size (bytes): total_size: %v, networking_rule_count: %v", size, networkingRuleCount)
+
+	ctx, cancel := context.WithTimeout(ctx, agentRPCTimeout)
+	defer cancel()
+
+	resp, err := agentClient.PutNetworkingRule(ctx, &pb.AgentNetworkingRule{
+		CladnetId:      thisPeer.CladnetID,
+		HostId:         thisPeer.HostID,
+		NetworkingRule: string(networkingRuleBytes),
+	})
+	if err != nil {
+		CBLogger.Error(err)
+	}
+	CBLogger.Tracef("PutNetworkingRule response: %#v", resp)
+}
+
+func updateNetworkingRule(ctx context.Context, thisPeer model.Peer, otherPeers map[string]model.Peer, ruleType string) {
 	CBLogger.Debug("Start.........")
 
+	ctx, span := tracing.Start(ctx, "agent.computeNetworkingRule")
+	defer span.End()
+
 	countOtherPeers := len(otherPeers)
 	if countOtherPeers > 0 {
 
@@ -691,43 +1162,26 @@ func updateNetworkingRule(thisPeer model.Peer, otherPeers map[string]model.Peer,
 
 				CBLogger.Tracef("Selected IP: %+v", selectedIP)
 				networkingRule.UpdateRule(peer.HostID, peer.HostName, peer.IP, selectedIP, peerScope, peer.State)
+				networkingRule.SetPreviousPeerIP(peer.HostID, peer.PreviousIP())
 			}
 		}
 
 		// Assign the networking rule
 		CBNet.UpdateNetworkingRule(networkingRule)
 
-		// Transaction (compare-and-swap(CAS)) to put networking rule for a peer
-		keyNetworkingRuleOfThisPeer := fmt.Sprint(etcdkey.NetworkingRule + "/" + thisPeer.CladnetID + "/" + thisPeer.HostID)
-		networkingRuleBytes, _ := json.Marshal(networkingRule)
-		networkingRuleString := string(networkingRuleBytes)
-		CBLogger.Debugf("Transaction (compare-and-swap(CAS)) - %v", keyNetworkingRuleOfThisPeer)
-		CBLogger.Tracef("Value: %#v", networkingRule)
-
-		size := binary.Size(networkingRuleBytes)
-		networkingRuleCount := len(networkingRule.HostID)
-		CBLogger.Tracef("TransactionRequest size (bytes): total_size: %v, networking_rule_count: %v", size, networkingRuleCount)
-
-		// NOTICE: "!=" doesn't work..... It might be a temporal issue.
-		txnResp, err := etcdClient.Txn(context.TODO()).
-			If(clientv3.Compare(clientv3.Value(keyNetworkingRuleOfThisPeer), "=", networkingRuleString)).
-			Else(clientv3.OpPut(keyNetworkingRuleOfThisPeer, networkingRuleString)).
-			Commit()
-
-		if err != nil {
-			CBLogger.Error(err)
-		}
-
-		CBLogger.Tracef("TransactionResponse: %#v", txnResp)
-		CBLogger.Tracef("ResponseHeader: %#v", txnResp.Header)
+		// Put networking rule for a peer
+		putNetworkingRule(ctx, thisPeer, networkingRule)
 	}
 
 	CBLogger.Debug("End.........")
 }
 
-func updatePeerInNetworkingRule(thisPeer model.Peer, otherPeer model.Peer, ruleType string, etcdClient *clientv3.Client) {
+func updatePeerInNetworkingRule(ctx context.Context, thisPeer model.Peer, otherPeer model.Peer, ruleType string) {
 	CBLogger.Debug("Start.........")
 
+	ctx, span := tracing.Start(ctx, "agent.computeNetworkingRule")
+	defer span.End()
+
 	networkingRule := CBNet.NetworkingRule
 
 	// Update networking rule for the peer
@@ -741,33 +1195,14 @@ func updatePeerInNetworkingRule(thisPeer model.Peer, otherPeer model.Peer, ruleT
 	CBLogger.Tracef("Selected IP: %+v", selectedIP)
 
 	networkingRule.UpdateRule(otherPeer.HostID, otherPeer.HostName, otherPeer.IP, selectedIP, peerScope, otherPeer.State)
+	// Keep routing the previous IP address to the peer until its re-addressing is completed
+	networkingRule.SetPreviousPeerIP(otherPeer.HostID, otherPeer.PreviousIP())
 
 	// Assign the networking rule
 	CBNet.UpdateNetworkingRule(networkingRule)
 
-	// Transaction (compare-and-swap(CAS)) to put networking rule for a peer
-	keyNetworkingRuleOfThisPeer := fmt.Sprint(etcdkey.NetworkingRule + "/" + thisPeer.CladnetID + "/" + thisPeer.HostID)
-	networkingRuleBytes, _ := json.Marshal(networkingRule)
-	networkingRuleString := string(networkingRuleBytes)
-	CBLogger.Debugf("Transaction (compare-and-swap(CAS)) - %v", keyNetworkingRuleOfThisPeer)
-	CBLogger.Tracef("Value: %#v", networkingRule)
-
-	size := binary.Size(networkingRuleBytes)
-	networkingRuleCount := len(networkingRule.HostID)
-	CBLogger.Tracef("TransactionRequest size (bytes): total_size: %v, networking_rule_count: %v", size, networkingRuleCount)
-
-	// NOTICE: "!=" doesn't work..... It might be a temporal issue.
-	txnResp, err := etcdClient.Txn(context.TODO()).
-		If(clientv3.Compare(clientv3.Value(keyNetworkingRuleOfThisPeer), "=", networkingRuleString)).
-		Else(clientv3.OpPut(keyNetworkingRuleOfThisPeer, networkingRuleString)).
-		Commit()
-
-	if err != nil {
-		CBLogger.Error(err)
-	}
-
-	CBLogger.Tracef("TransactionResponse: %#v", txnResp)
-	CBLogger.Tracef("ResponseHeader: %#v", txnResp.Header)
+	// Put networking rule for a peer
+	putNetworkingRule(ctx, thisPeer, networkingRule)
 
 	CBLogger.Debug("End.........")
 }
@@ -791,120 +1226,131 @@ func main() {
 		os.Interrupt, syscall.SIGKILL, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGHUP, syscall.SIGABRT)
 	defer stop()
 
-	// etcd Section
-	// Connect to the etcd cluster
-	etcdClient, etcdErr := clientv3.New(clientv3.Config{
-		Endpoints:   config.ETCD.Endpoints,
-		DialTimeout: 5 * time.Second,
-	})
+	// gRPC section
+	// Connect to the cb-network service (the agent never accesses the etcd directly) with TLS (or mTLS).
+	// NOTE - The agent is authenticated by the join token and its credential, not by a token (API key)
+	serviceConfig := config.Service
+	if serviceConfig.Auth.Token != "" {
+		CBLogger.Warn("'service.auth.token' is ignored by the agent, which is authenticated by the join token")
+		serviceConfig.Auth.Token = ""
+	}
+	options, err := grpcauth.DialOptions(serviceConfig)
+	if err != nil {
+		CBLogger.Fatal(err)
+	}
+
+	// Present the credential of this agent, to which the service binds the host ID on joining by the join token
+	agentCredential, err := cbnet.LoadAgentCredential(logger.FromLogrus(CBLogger))
+	if err != nil {
+		CBLogger.Fatal(err)
+	}
+	options = append(options, grpc.WithPerRPCCredentials(
+		grpcauth.NewAgentCredentials(agentCredential, config.CBNetwork.JoinToken, config.Service.TLS.Enabled)))
+
+	// Send the trace context of the workflows (e.g., the agent registration) to the service
+	options = append(options,
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor()),
+	)
 
-	if etcdErr != nil {
-		CBLogger.Fatal(etcdErr)
+	grpcConn, err := grpc.Dial(config.Service.Endpoint, options...)
+	if err != nil {
+		CBLogger.Fatal(err)
 	}
 
 	defer func() {
-		errClose := etcdClient.Close()
+		errClose := grpcConn.Close()
 		if errClose != nil {
-			CBLogger.Fatal("Can't close the etcd client", errClose)
+			CBLogger.Fatal("Can't close the gRPC connection", errClose)
+		}
+	}()
+
+	agentClient = pb.NewAgentServiceClient(grpcConn)
+
+	CBLogger.Infof("The cb-network service (%v) is connected.", config.Service.Endpoint)
+
+	// Export the spans of the workflows (e.g., configuring the TUN device), if the exporter is configured
+	shutdownTracing, err := tracing.Init(gracefulShutdownContext, "cb-network-agent", config.Tracing)
+	if err != nil {
+		CBLogger.Fatal(err)
+	}
+	defer func() {
+		if errShutdown := shutdownTracing(context.Background()); errShutdown != nil {
+			CBLogger.Error(errShutdown)
 		}
 	}()
 
-	CBLogger.Infoln("The etcdClient is connected.")
+	// Serve the metrics (e.g., tx/rx packets per peer and drops), if the listen address is configured
+	if config.Metrics.ListenAddress != "" {
+		go func() {
+			CBLogger.Infof("Serving the metrics on %v/metrics", config.Metrics.ListenAddress)
+			if err := metrics.ListenAndServe(config.Metrics.ListenAddress); err != nil {
+				CBLogger.Error(err)
+			}
+		}()
+	}
 
-	// Create a session to acquire a lock
-	session, _ := concurrency.NewSession(etcdClient)
-	defer session.Close()
+	// Detect the cloud information of this host (used by the cost-prioritized rule)
+	CBNet.CloudInformation = detectCloudInformation(config.CBNetwork.Host)
 
 	// Enable encryption or not
 	CBNet.EnableEncryption(config.CBNetwork.Host.IsEncrypted)
 
 	wg.Add(1)
 	// Watch the other agents' secrets (RSA public keys)
-	go watchSecret(gracefulShutdownContext, etcdClient, &wg)
+	go watchSecret(gracefulShutdownContext, &wg)
 	// Wait until the goroutine is started
 	time.Sleep(200 * time.Millisecond)
 
 	wg.Add(1)
 	// Watch the control command from the remote
-	go watchControlCommand(gracefulShutdownContext, etcdClient, &wg)
+	go watchControlCommand(gracefulShutdownContext, &wg)
 	// Wait until the goroutine is started
 	time.Sleep(200 * time.Millisecond)
 
 	// Synchronize all peers in local
-	// Lock is required for secure synchronization.
-	// Without the lock, it could be missing a part of peer updates while synchronizing and watching peers respectively.
+	// The peers are watched before getting them, so that any peer update is not missed
+	// while synchronizing and watching peers respectively.
 	CBLogger.Debug("Start to synchronize all peers in-memory (map data type)")
 
-	// Prepare lock
-	keyPrefix := fmt.Sprint(etcdkey.LockPeer + "/" + CBNet.CLADNetID)
-
-	lock := concurrency.NewMutex(session, keyPrefix)
-	ctx := context.TODO()
-
-	// Acquire lock (or wait to have it)
-	CBLogger.Debug("Acquire a lock")
-	// Time trying to acquire a lock
-	start := time.Now()
-	if err := lock.Lock(ctx); err != nil {
-		CBLogger.Error(err)
-	}
-	CBLogger.Tracef("Lock acquired for '%s'", keyPrefix)
-
 	wg.Add(1)
 	// Watch all peers
-	go watchPeers(gracefulShutdownContext, etcdClient, &wg)
+	go watchPeers(gracefulShutdownContext, &wg)
 	// Wait until the goroutine is started
 	time.Sleep(200 * time.Millisecond)
 
 	// Get all peers
-	// Create a key of host in the specific CLADNet's networking rule
-	keyPeersInCLADNet := fmt.Sprint(etcdkey.Peer + "/" + CBNet.CLADNetID)
-	CBLogger.Debugf("Get with prefix - %v", keyPeersInCLADNet)
-
-	getResp, respErr := etcdClient.Get(context.TODO(), keyPeersInCLADNet, clientv3.WithPrefix())
-	if respErr != nil {
-		CBLogger.Error(respErr)
-	}
-	CBLogger.Tracef("GetResponse: %#v", getResp)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*getResp)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
-
-	for _, kv := range getResp.Kvs {
-		key := string(kv.Key)
-		CBLogger.Tracef("Key : %v", key)
-		CBLogger.Tracef("The peer: %v", string(kv.Value))
-
-		var peer model.Peer
-		err := json.Unmarshal(kv.Value, &peer)
-		if err != nil {
-			CBLogger.Error(err)
-		}
-		// Store peers to synchronize and use it in local
-		CBNet.StorePeer(peer)
-	}
-
-	// Release lock
-	CBLogger.Debug("Release a lock")
-	if err := lock.Unlock(ctx); err != nil {
-		CBLogger.Error(err)
-	}
-	CBLogger.Tracef("Lock released for '%s'", keyPrefix)
-	// Elapsed time from the time trying to acquire a lock
-	elapsed := time.Since(start)
-	formatted := fmt.Sprintf("%.3f", elapsed.Seconds())
-	CBLogger.Tracef("Elapsed time for locking (sec): %s", formatted)
+	synchronizePeers()
 
 	CBLogger.Debug("End to synchronize all peers in-memory (map data type)")
 
 	// Turn up the virtual network interface (i.e., TUN device) for Cloud Adaptive Network
-	handleCommand(cmdtype.Up, etcdClient)
+	handleCommand(cmdtype.Up, cmdtype.BuildCommandMessage(cmdtype.Up))
+
+	wg.Add(1)
+	// Serve the other peers' network tests (e.g., THROUGHPUT)
+	go serveNetworkTest(gracefulShutdownContext, &wg)
 
 	wg.Add(1)
 	// Watch the test request from the remote
-	go watchTestRequest(gracefulShutdownContext, etcdClient, &wg)
+	go watchTestRequest(gracefulShutdownContext, &wg)
 	// Wait until the goroutine is started
 	time.Sleep(200 * time.Millisecond)
 
+	wg.Add(1)
+	// Send heartbeats to the cb-network service
+	go sendHeartbeats(gracefulShutdownContext, &wg)
+
+	wg.Add(1)
+	// Publish the traffic statistics to the cb-network service
+	go reportPeerStatistics(gracefulShutdownContext, &wg)
+
+	if config.CBNetwork.Host.KeyRotationInterval > 0 {
+		wg.Add(1)
+		// Rotate the RSA key on schedule
+		go rotateKeyPeriodically(gracefulShutdownContext, &wg, config.CBNetwork.Host.KeyRotationInterval)
+	}
+
 	wg.Add(1)
 	go func(wg *sync.WaitGroup) {
 		defer wg.Done()
@@ -916,7 +1362,7 @@ func main() {
 		fmt.Println("[Stop] cb-network agent")
 		CBNet.CloseCBNetworkInterface()
 		// Set this agent state "Released"
-		updatePeerState(netstate.Released, etcdClient)
+		updatePeerState(context.TODO(), netstate.Released)
 
 		// Wait for a while
 		time.Sleep(1 * time.Second)
@@ -928,43 +1374,3 @@ func main() {
 
 	CBLogger.Debug("End.........")
 }
-
-func extractSizes(res clientv3.GetResponse) (totalSize, headerSize, kvsSize, kvsCount int) {
-
-	headerSize = res.Header.Size()
-	kvsCount = int(res.Count)
-	kvsSize = 0
-	for _, kv := range res.Kvs {
-		kvsSize += kv.Size()
-	}
-
-	totalSize = headerSize + kvsSize
-
-	return totalSize, headerSize, kvsSize, kvsCount
-}
-
-// func createFieldsForResponseSizes(res clientv3.GetResponse) logrus.Fields {
-
-// 	// lenKvs := res.Count
-// 	fields := logrus.Fields{}
-
-// 	headerSize := res.Header.Size()
-// 	kvSize := 0
-// 	for _, kv := range res.Kvs {
-// 		kvSize += kv.Size()
-// 	}
-
-// 	totalSize := headerSize + kvSize
-
-// 	fields["total size"] = totalSize
-// 	fields["header size"] = headerSize
-// 	fields["kvs size"] = kvSize
-
-// 	for i, kv := range res.Kvs {
-// 		tempKey := "kv " + strconv.Itoa(i)
-// 		fields[tempKey] = kv.Size()
-// 		// kvSize += kv.Size()
-// 	}
-
-// 	return fields
-// }
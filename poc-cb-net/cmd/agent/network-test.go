@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	cbnet "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/logger"
+	nettest "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-test"
+	testtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/test-type"
+	"github.com/go-ping/ping"
+)
+
+// Defaults of a test specification
+const (
+	defaultTestDuration       = 5 * time.Second
+	defaultTestTrialCount     = 5
+	defaultUDPBitrate         = int64(10 * 1000 * 1000) // 10 Mbps
+	defaultUDPPacketSize      = 1200
+	defaultJitterBitrate      = int64(64 * 1000) // e.g., a voice call
+	defaultJitterPacketSize   = 160
+	defaultPortCheckTimeout   = 3 * time.Second
+	defaultThroughputProtocol = "tcp"
+)
+
+var defaultTestPorts = []int{22}
+
+// testTarget represents a destination of a network test
+type testTarget struct {
+	model.HostPair
+	underlayIP string
+}
+
+// serveNetworkTest serves the other peers' network tests (e.g., THROUGHPUT) on the overlay network
+func serveNetworkTest(ctx context.Context, wg *sync.WaitGroup) {
+	CBLogger.Debug("Start.........")
+	defer wg.Done()
+
+	// Respond to the peers in the CLADNet only
+	isAllowed := func(ip net.IP) bool {
+		address := ip.String()
+		return address == CBNet.ThisPeer.IP || CBNet.NetworkingRule.GetIndexOfPeerIP(address) != -1
+	}
+
+	responder := nettest.NewResponder(nettest.DefaultPort, isAllowed,
+		logger.FromLogrus(CBLogger).WithFields(logger.Fields{logger.CLADNetID: CBNet.CLADNetID, logger.HostID: CBNet.HostID}))
+
+	CBLogger.Infof("Serving the network tests on port %v", nettest.DefaultPort)
+	if err := responder.Serve(ctx); err != nil {
+		CBLogger.Error(err)
+	}
+
+	CBLogger.Debug("End.........")
+}
+
+// selectTestTargets selects the peers in the test specification (all peers if not specified)
+func selectTestTargets(testSpec model.TestSpecification) []testTarget {
+	networkingRule := CBNet.NetworkingRule
+
+	var targets []testTarget
+	for i, hostID := range networkingRule.HostID {
+		if len(testSpec.Peers) > 0 && !contains(testSpec.Peers, hostID) {
+			continue
+		}
+		targets = append(targets, testTarget{
+			HostPair: model.HostPair{
+				SourceIP:        CBNet.ThisPeer.IP,
+				SourceName:      CBNet.ThisPeer.HostName,
+				DestinationIP:   networkingRule.PeerIP[i],
+				DestinationName: networkingRule.HostName[i],
+			},
+			underlayIP: networkingRule.SelectedIP[i],
+		})
+	}
+	return targets
+}
+
+func contains(list []string, x string) bool {
+	for _, item := range list {
+		if item == x {
+			return true
+		}
+	}
+	return false
+}
+
+// runNetworkTest runs a test (except CONNECTIVITY) to the peers, and posts the results
+func runNetworkTest(testType string, runID string, data string) {
+	CBLogger.Debug("Start.........")
+
+	var testSpec model.TestSpecification
+	if err := json.Unmarshal([]byte(data), &testSpec); err != nil {
+		CBLogger.Error(err)
+	}
+
+	duration := time.Duration(testSpec.Duration) * time.Second
+	if duration <= 0 {
+		duration = defaultTestDuration
+	}
+	if duration > nettest.MaxDuration {
+		duration = nettest.MaxDuration
+	}
+
+	targets := selectTestTargets(testSpec)
+	networkStatus := model.NetworkStatus{
+		TestType:               testType,
+		RunID:                  runID,
+		InterHostNetworkStatus: make([]model.InterHostNetworkStatus, 0),
+	}
+
+	switch testType {
+	case testtype.Throughput:
+		// One by one, so that the streams don't compete for the bandwidth of this host
+		for _, target := range targets {
+			networkStatus.Throughput = append(networkStatus.Throughput, testThroughput(target, testSpec, duration))
+		}
+
+	case testtype.Jitter:
+		for _, target := range targets {
+			networkStatus.Jitter = append(networkStatus.Jitter, testJitter(target, testSpec, duration))
+		}
+
+	case testtype.PathMTU:
+		networkStatus.PathMTU = make([]model.PathMTUStatus, len(targets))
+		runConcurrently(len(targets), func(i int) {
+			networkStatus.PathMTU[i] = testPathMTU(targets[i], testSpec)
+		})
+
+	case testtype.PortReachability:
+		ports := testSpec.Ports
+		if len(ports) == 0 {
+			ports = defaultTestPorts
+		}
+		networkStatus.PortReachability = make([]model.PortReachabilityStatus, len(targets)*len(ports))
+		runConcurrently(len(networkStatus.PortReachability), func(i int) {
+			networkStatus.PortReachability[i] = testPortReachability(targets[i/len(ports)], ports[i%len(ports)])
+		})
+
+	case testtype.UnderlayOverlay:
+		networkStatus.UnderlayOverlay = make([]model.UnderlayOverlayStatus, len(targets))
+		runConcurrently(len(targets), func(i int) {
+			networkStatus.UnderlayOverlay[i] = testUnderlayOverlay(targets[i], testSpec)
+		})
+	}
+
+	postNetworkStatus(networkStatus)
+
+	CBLogger.Debug("End.........")
+}
+
+func runConcurrently(n int, run func(i int)) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			run(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func testAddress(target testTarget) string {
+	return net.JoinHostPort(target.DestinationIP, strconv.Itoa(nettest.DefaultPort))
+}
+
+func testThroughput(target testTarget, testSpec model.TestSpecification, duration time.Duration) model.ThroughputStatus {
+	CBLogger.Tracef("Throughput to %s", target.DestinationIP)
+
+	protocol := testSpec.Protocol
+	if protocol == "" {
+		protocol = defaultThroughputProtocol
+	}
+	status := model.ThroughputStatus{HostPair: target.HostPair, Protocol: protocol}
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration+agentRPCTimeout)
+	defer cancel()
+
+	switch protocol {
+	case "tcp":
+		result, err := nettest.TCPThroughput(ctx, testAddress(target), duration)
+		if err != nil {
+			status.Error = err.Error()
+		}
+		status.Duration = result.Elapsed.Seconds()
+		status.BytesSent = result.BytesSent
+		status.BytesReceived = result.BytesReceived
+		status.BitsPerSecond = result.BitsPerSecond()
+
+	case "udp":
+		bitrate := testSpec.Bitrate
+		if bitrate <= 0 {
+			bitrate = defaultUDPBitrate
+		}
+		packetSize := testSpec.PacketSize
+		if packetSize <= 0 {
+			packetSize = defaultUDPPacketSize
+		}
+		result, err := nettest.UDPStream(ctx, testAddress(target), duration, bitrate, packetSize)
+		if err != nil {
+			status.Error = err.Error()
+		}
+		status.Duration = result.Elapsed.Seconds()
+		status.BytesSent = int64(result.PacketsSent * packetSize)
+		status.BytesReceived = result.BytesReceived
+		status.BitsPerSecond = result.BitsPerSecond()
+		status.PacketsSent = result.PacketsSent
+		status.PacketsReceived = result.PacketsReceived
+		status.PacketsLoss = result.PacketsLost
+
+	default:
+		status.Error = fmt.Sprintf("unknown protocol (%v)", protocol)
+	}
+
+	return status
+}
+
+func testJitter(target testTarget, testSpec model.TestSpecification, duration time.Duration) model.JitterStatus {
+	CBLogger.Tracef("Jitter to %s", target.DestinationIP)
+
+	bitrate := testSpec.Bitrate
+	if bitrate <= 0 {
+		bitrate = defaultJitterBitrate
+	}
+	packetSize := testSpec.PacketSize
+	if packetSize <= 0 {
+		packetSize = defaultJitterPacketSize
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration+agentRPCTimeout)
+	defer cancel()
+
+	status := model.JitterStatus{HostPair: target.HostPair}
+	result, err := nettest.UDPStream(ctx, testAddress(target), duration, bitrate, packetSize)
+	if err != nil {
+		status.Error = err.Error()
+	}
+	status.Jitter = result.Jitter.Seconds()
+	status.PacketsSent = result.PacketsSent
+	status.PacketsReceived = result.PacketsReceived
+	status.PacketsLoss = result.PacketsLost
+	status.OutOfOrder = result.OutOfOrder
+
+	return status
+}
+
+func testPathMTU(target testTarget, testSpec model.TestSpecification) model.PathMTUStatus {
+	CBLogger.Tracef("Path MTU to %s", target.DestinationIP)
+
+	// The MTU of the TUN device is the upper bound on the overlay network
+	maxMTU := testSpec.MaxMTU
+	if maxMTU <= 0 {
+		maxMTU, _ = strconv.Atoi(cbnet.MTU)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), agentRPCTimeout*3)
+	defer cancel()
+
+	status := model.PathMTUStatus{HostPair: target.HostPair}
+	pathMTU, err := nettest.PathMTU(ctx, testAddress(target), maxMTU)
+	if err != nil {
+		status.Error = err.Error()
+	}
+	status.PathMTU = pathMTU
+
+	return status
+}
+
+func testPortReachability(target testTarget, port int) model.PortReachabilityStatus {
+	CBLogger.Tracef("TCP port %v of %s", port, target.DestinationIP)
+
+	status := model.PortReachabilityStatus{HostPair: target.HostPair, Port: port}
+	address := net.JoinHostPort(target.DestinationIP, strconv.Itoa(port))
+	state, latency, err := nettest.CheckTCPPort(context.Background(), address, defaultPortCheckTimeout)
+	if err != nil {
+		status.Error = err.Error()
+	}
+	status.State = state
+	status.Latency = latency.Seconds()
+
+	return status
+}
+
+func testUnderlayOverlay(target testTarget, testSpec model.TestSpecification) model.UnderlayOverlayStatus {
+	CBLogger.Tracef("Underlay (%s) vs overlay (%s)", target.underlayIP, target.DestinationIP)
+
+	trialCount := testSpec.TrialCount
+	if trialCount <= 0 {
+		trialCount = defaultTestTrialCount
+	}
+
+	status := model.UnderlayOverlayStatus{HostPair: target.HostPair, UnderlayIP: target.underlayIP}
+
+	var underlay, overlay *ping.Statistics
+	var errUnderlay, errOverlay error
+	runConcurrently(2, func(i int) {
+		if i == 0 {
+			underlay, errUnderlay = pingStatistics(target.underlayIP, trialCount)
+		} else {
+			overlay, errOverlay = pingStatistics(target.DestinationIP, trialCount)
+		}
+	})
+	if errUnderlay != nil {
+		status.Error = errUnderlay.Error()
+		return status
+	}
+	if errOverlay != nil {
+		status.Error = errOverlay.Error()
+		return status
+	}
+
+	status.UnderlayAverageRTT = underlay.AvgRtt.Seconds()
+	status.UnderlayPacketLoss = underlay.PacketsSent - underlay.PacketsRecv
+	status.OverlayAverageRTT = overlay.AvgRtt.Seconds()
+	status.OverlayPacketLoss = overlay.PacketsSent - overlay.PacketsRecv
+	if underlay.PacketsRecv > 0 && overlay.PacketsRecv > 0 {
+		status.OverheadRTT = status.OverlayAverageRTT - status.UnderlayAverageRTT
+	}
+
+	return status
+}
+
+func pingStatistics(address string, trialCount int) (*ping.Statistics, error) {
+	pinger, err := ping.NewPinger(address)
+	if err != nil {
+		return nil, err
+	}
+	pinger.SetPrivileged(true)
+	pinger.Count = trialCount
+	pinger.Timeout = time.Duration(trialCount)*time.Second + agentRPCTimeout
+	if err := pinger.Run(); err != nil {
+		return nil, err
+	}
+	return pinger.Statistics(), nil
+}
@@ -0,0 +1,422 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdclient "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-client"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/manifest"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/migration"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/validator"
+	cblog "github.com/cloud-barista/cb-log"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// CBLogger represents a logger to show execution processes according to the logging level.
+var CBLogger *logrus.Logger
+var config model.Config
+var loggerName = "cb-network"
+
+func init() {
+	fmt.Println("\nStart......... init() of cb-network.go")
+
+	// Load cb-network config from the current directory (usually for the production)
+	ex, err := os.Executable()
+	if err != nil {
+		panic(err)
+	}
+	exePath := filepath.Dir(ex)
+	// fmt.Printf("exe path: %v\n", exePath)
+
+	configPath := filepath.Join(exePath, "config", "config.yaml")
+	if file.Exists(configPath) {
+		fmt.Printf("path of config.yaml: %v\n", configPath)
+		config, _ = model.LoadConfig(configPath)
+	} else {
+		// Load cb-network config from the project directory (usually for the development)
+		configPath = filepath.Join(exePath, "..", "..", "config", "config.yaml")
+
+		if file.Exists(configPath) {
+			config, _ = model.LoadConfig(configPath)
+		} else {
+			err := errors.New("fail to load config.yaml")
+			panic(err)
+		}
+	}
+	fmt.Printf("Load %v", configPath)
+
+	// Set cb-log
+	logConfPath := ""
+	env := os.Getenv("CBLOG_ROOT")
+	if env != "" {
+		// Load cb-log config from the environment variable path (default)
+		fmt.Printf("CBLOG_ROOT: %v\n", env)
+		CBLogger = cblog.GetLogger(loggerName)
+
+	} else {
+
+		// Load cb-log config from the current directory (usually for the production)
+		ex, err := os.Executable()
+		if err != nil {
+			panic(err)
+		}
+		exePath := filepath.Dir(ex)
+		// fmt.Printf("exe path: %v\n", exePath)
+
+		logConfPath = filepath.Join(exePath, "config", "log_conf.yaml")
+		if file.Exists(logConfPath) {
+			fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
+			CBLogger = cblog.GetLoggerWithConfigPath(loggerName, logConfPath)
+
+		} else {
+			// Load cb-log config from the project directory (usually for development)
+			logConfPath = filepath.Join(exePath, "..", "..", "config", "log_conf.yaml")
+			if file.Exists(logConfPath) {
+				fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
+				CBLogger = cblog.GetLoggerWithConfigPath(loggerName, logConfPath)
+			} else {
+				err := errors.New("fail to load log_conf.yaml")
+				panic(err)
+			}
+		}
+		fmt.Printf("Load %v", logConfPath)
+	}
+
+	CBLogger.Debugf("Load %v", configPath)
+	CBLogger.Debugf("Load %v", logConfPath)
+
+	fmt.Println("End......... init() of cb-network.go")
+	fmt.Println("")
+}
+
+const usage = `Usage: cb-network <command> [options]
+
+Commands:
+  migrate    Migrate the data in the registry (etcd) to the schema version of this build
+  export     Export the state of a CLADNet to an archive file
+  import     Import a CLADNet from an archive file
+  apply      Apply a manifest (YAML) of a CLADNet
+  diff       Show the changes which a manifest (YAML) of a CLADNet would make
+  readdress  Change the IPv4 address space of a CLADNet, and show the progress of re-addressing the peers
+
+Run 'cb-network <command> -h' for the options of a command.
+`
+
+// newStore connects to the etcd, and creates the cb-network store on it
+func newStore() (store.Store, error) {
+	etcdClient, err := etcdclient.New(config.ETCD)
+	if err != nil {
+		return nil, err
+	}
+	CBLogger.Infoln("The etcdClient is connected.")
+	return store.NewEtcdStore(etcdClient), nil
+}
+
+// newCLADNetClient connects to the cb-network service, and creates a CLADNet client
+func newCLADNetClient() (pb.CloudAdaptiveNetworkServiceClient, *grpc.ClientConn, error) {
+	options, err := grpcauth.DialOptions(config.Service)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grpcConn, err := grpc.Dial(config.Service.Endpoint, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+	CBLogger.Infoln("The gRPC client is connected.")
+	return pb.NewCloudAdaptiveNetworkServiceClient(grpcConn), grpcConn, nil
+}
+
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	cladnetID := flags.String("cladnet-id", "", "ID of the CLADNet to export (required)")
+	output := flags.String("o", "", "archive file to write (default: <cladnet-id>.json)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *cladnetID == "" {
+		flags.Usage()
+		return errors.New("-cladnet-id is required")
+	}
+	if *output == "" {
+		*output = *cladnetID + ".json"
+	}
+
+	cladnetClient, grpcConn, err := newCLADNetClient()
+	if err != nil {
+		return err
+	}
+	defer grpcConn.Close()
+
+	archive, err := cladnetClient.ExportCLADNet(context.Background(), &pb.CLADNetRequest{CladnetId: *cladnetID})
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(*output, []byte(archive.Archive), 0600); err != nil {
+		return err
+	}
+	fmt.Printf("Exported %v to %v\n", archive.CladnetId, *output)
+	return nil
+}
+
+func runImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	archivePath := flags.String("f", "", "archive file to import (required)")
+	newCLADNetID := flags.String("new-cladnet-id", "", "import under a new CLADNet ID instead of the archived one")
+	dropStalePeers := flags.Bool("drop-stale-peers", false, "drop the peers whose agents were not alive on export")
+	ignoreOriginal := flags.Bool("ignore-original", false, "ignore the conflicts with the archived CLADNet (e.g., the name and the IPv4 address space) on importing under a new CLADNet ID")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *archivePath == "" {
+		flags.Usage()
+		return errors.New("-f is required")
+	}
+
+	archive, err := os.ReadFile(*archivePath)
+	if err != nil {
+		return err
+	}
+
+	cladnetClient, grpcConn, err := newCLADNetClient()
+	if err != nil {
+		return err
+	}
+	defer grpcConn.Close()
+
+	spec, err := cladnetClient.ImportCLADNet(context.Background(), &pb.ImportCLADNetRequest{
+		Archive:        string(archive),
+		NewCladnetId:   *newCLADNetID,
+		DropStalePeers: *dropStalePeers,
+		IgnoreOriginal: *ignoreOriginal,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Imported %v (%v) from %v\n", spec.CladnetId, spec.Ipv4AddressSpace, *archivePath)
+	return nil
+}
+
+func runApply(args []string, dryRun bool) error {
+	name := "apply"
+	if dryRun {
+		name = "diff"
+	}
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	manifestPath := flags.String("f", "", "manifest file (YAML) of a CLADNet (required)")
+	force := false
+	if !dryRun {
+		flags.BoolVar(&force, "force", false, "apply the unsafe changes (e.g., the IPv4 address space under peers)")
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *manifestPath == "" {
+		flags.Usage()
+		return errors.New("-f is required")
+	}
+
+	cladnetManifest, err := os.ReadFile(*manifestPath)
+	if err != nil {
+		return err
+	}
+
+	// Validate the manifest before the request
+	if _, err := manifest.Parse(cladnetManifest); err != nil {
+		return err
+	}
+
+	cladnetClient, grpcConn, err := newCLADNetClient()
+	if err != nil {
+		return err
+	}
+	defer grpcConn.Close()
+
+	response, err := cladnetClient.ApplyCLADNet(context.Background(), &pb.ApplyCLADNetRequest{
+		Manifest: string(cladnetManifest),
+		DryRun:   dryRun,
+		Force:    force,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("CLADNet: %v\n", response.CladnetId)
+	for _, change := range response.Changes {
+		switch {
+		case change.OldValue == "":
+			fmt.Printf("+ %s: %s\n", change.Field, change.NewValue)
+		case change.NewValue == "":
+			fmt.Printf("- %s: %s\n", change.Field, change.OldValue)
+		default:
+			fmt.Printf("~ %s: %s -> %s\n", change.Field, change.OldValue, change.NewValue)
+		}
+		if change.Unsafe {
+			fmt.Printf("  (unsafe: %s)\n", change.Reason)
+		}
+	}
+	fmt.Printf("%d change(s)\n", len(response.Changes))
+
+	if response.Applied {
+		fmt.Println("Applied.")
+	}
+	return nil
+}
+
+func runReaddress(args []string) error {
+	flags := flag.NewFlagSet("readdress", flag.ExitOnError)
+	cladnetID := flags.String("cladnet-id", "", "ID of the CLADNet to re-address (required)")
+	ipv4AddressSpace := flags.String("ipv4-address-space", "", "new IPv4 address space (e.g., 10.78.0.0/24)")
+	overlap := flags.Int64("overlap", 30, "seconds to keep the previous IP address on each peer")
+	dryRun := flags.Bool("dry-run", false, "show the new IP addresses without applying them")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *cladnetID == "" {
+		flags.Usage()
+		return errors.New("-cladnet-id is required")
+	}
+
+	cladnetClient, grpcConn, err := newCLADNetClient()
+	if err != nil {
+		return err
+	}
+	defer grpcConn.Close()
+
+	// Show the progress only if the new address space is not given
+	var readdressingStatus *pb.ReaddressingStatus
+	if *ipv4AddressSpace == "" {
+		readdressingStatus, err = cladnetClient.GetReaddressingStatus(context.Background(), &pb.CLADNetRequest{CladnetId: *cladnetID})
+	} else {
+		readdressingStatus, err = cladnetClient.ReaddressCLADNet(context.Background(), &pb.ReaddressRequest{
+			CladnetId:        *cladnetID,
+			Ipv4AddressSpace: *ipv4AddressSpace,
+			OverlapSeconds:   *overlap,
+			DryRun:           *dryRun,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("CLADNet: %v (%v -> %v)\n", readdressingStatus.CladnetId,
+		readdressingStatus.OldIpv4AddressSpace, readdressingStatus.NewIpv4AddressSpace)
+	for _, peer := range readdressingStatus.Peers {
+		fmt.Printf("  %s: %s -> %s [%s] %s\n", peer.HostId, peer.OldIpv4Cidr, peer.NewIpv4Cidr, peer.State, peer.Message)
+	}
+	if *dryRun {
+		fmt.Println("Dry run: nothing has been changed.")
+	} else if readdressingStatus.IsCompleted {
+		fmt.Println("Completed.")
+	}
+	return nil
+}
+
+func runMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "show the changes without applying them")
+	toVersion := flags.Int("to", etcdkey.CurrentSchemaVersion, "target schema version")
+	snapshotDir := flags.String("snapshot-dir", "snapshot", "directory to save a snapshot to roll back")
+	rollback := flags.String("rollback", "", "roll back a migration by the snapshot file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cbnetStore, err := newStore()
+	if err != nil {
+		return err
+	}
+	defer cbnetStore.Close()
+
+	ctx := context.Background()
+
+	// Roll back by a snapshot
+	if *rollback != "" {
+		snapshot, err := migration.LoadSnapshot(*rollback)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Roll back schema version %d -> %d (%d item(s)) by %v\n",
+			snapshot.ToVersion, snapshot.FromVersion, len(snapshot.KeyValues), *rollback)
+		if *dryRun {
+			return nil
+		}
+		if err := migration.Rollback(ctx, cbnetStore, snapshot); err != nil {
+			return err
+		}
+		fmt.Println("Rolled back.")
+		return nil
+	}
+
+	plan, err := migration.NewPlan(ctx, cbnetStore, *toVersion)
+	if err != nil {
+		return err
+	}
+	fmt.Print(plan)
+
+	if *dryRun {
+		fmt.Println("Dry run: nothing has been changed.")
+		return nil
+	}
+
+	snapshotPath, err := plan.Apply(ctx, cbnetStore, *snapshotDir)
+	if snapshotPath != "" {
+		fmt.Printf("Snapshot: %v\n", snapshotPath)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Migrated to schema version %d.\n", plan.ToVersion)
+	return nil
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "migrate":
+		err = runMigrate(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	case "import":
+		err = runImport(os.Args[2:])
+	case "apply":
+		err = runApply(os.Args[2:], false)
+	case "diff":
+		err = runApply(os.Args[2:], true)
+	case "readdress":
+		err = runReaddress(os.Args[2:])
+	default:
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		CBLogger.Error(err)
+		if fieldErrors := validator.FieldErrors(err); len(fieldErrors) > 0 {
+			fmt.Fprintln(os.Stderr, "Error: invalid argument")
+			for _, fieldError := range fieldErrors {
+				fmt.Fprintf(os.Stderr, "  %s: %s\n", fieldError.Field, fieldError.Description)
+			}
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -9,21 +9,22 @@ import (
 	"net"
 	"os"
 	"path/filepath"
-	"strconv"
-	"strings"
 	"sync"
 	"time"
 
 	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdclient "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-client"
 	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
 	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	jointoken "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/join-token"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/metrics"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/migration"
 	netstate "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-state"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tracing"
 	cblog "github.com/cloud-barista/cb-log"
 	"github.com/rs/xid"
 	"github.com/sirupsen/logrus"
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	clientv3 "go.etcd.io/etcd/client/v3"
-	"go.etcd.io/etcd/client/v3/concurrency"
 )
 
 // CBLogger represents a logger to show execution processes according to the logging level.
@@ -32,8 +33,19 @@ var config model.Config
 var loggerNamePrefix = "controller"
 var controllerID string
 
-func init() {
-	fmt.Println("\nStart......... init() of controller.go")
+// Metrics of the controller
+var (
+	allocationDuration = metrics.NewHistogramVec("cbnet_controller_allocation_duration_seconds",
+		"Latency (seconds) to allocate a new peer.", metrics.DefaultBuckets)
+	lockWaitDuration = metrics.NewHistogramVec("cbnet_controller_lock_wait_seconds",
+		"Time (seconds) waited to acquire the peer lock of a CLADNet.", metrics.DefaultBuckets)
+	workloadAcquisitions = metrics.NewCounterVec("cbnet_controller_workload_acquisitions_total",
+		"Attempts to acquire a workload by result (acquired, occupied or error).", "result")
+)
+
+// setUp loads the config and sets the logger, which is called by main() (not init(), so that the tests set them on their own)
+func setUp() {
+	fmt.Println("\nStart......... setUp() of controller.go")
 
 	// Load cb-network config from the current directory (usually for the production)
 	ex, err := os.Executable()
@@ -106,186 +118,242 @@ func init() {
 	CBLogger.Debugf("Load %v", configPath)
 	CBLogger.Debugf("Load %v", logConfPath)
 
-	fmt.Println("End......... init() of controller.go")
+	fmt.Println("End......... setUp() of controller.go")
 	fmt.Println("")
 }
 
-func watchHostNetworkInformation(wg *sync.WaitGroup, etcdClient *clientv3.Client) {
+// workloadTTL is the time to live of a workload acquired by a cb-network controller
+const workloadTTL = 15 * time.Second
+
+func watchHostNetworkInformation(wg *sync.WaitGroup, cbnetStore store.Store) {
 	CBLogger.Debug("Start.........")
 	defer wg.Done()
 
-	// Create a session to acquire a lock
-	session, _ := concurrency.NewSession(etcdClient)
-	defer session.Close()
-
 	// Watch "/registry/cloud-adaptive-network/host-network-information"
-	CBLogger.Debugf("Watch with prefix - %v", etcdkey.HostNetworkInformation)
+	CBLogger.Debug("Watch host network information")
+
+	watchChan := cbnetStore.WatchHostNetworkInformation(context.Background())
+	for watchResponse := range watchChan {
+		if watchResponse.Err != nil {
+			CBLogger.Error(watchResponse.Err)
+			continue
+		}
 
-	watchChan2 := etcdClient.Watch(context.Background(), etcdkey.HostNetworkInformation, clientv3.WithPrefix())
-	for watchResponse := range watchChan2 {
 		for _, event := range watchResponse.Events {
 			switch event.Type {
-			case mvccpb.PUT: // The watched value has changed.
-				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
+			case store.EventPut: // The watched value has changed.
+				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
 
 				// Try to acquire a workload by multiple cb-network controllers
-				isAcquired := tryToAcquireWorkload(etcdClient, string(event.Kv.Key), watchResponse.Header.GetRevision())
+				isAcquired, err := cbnetStore.TryToAcquireWorkload(context.TODO(), event, workloadTTL)
+				if err != nil {
+					CBLogger.Errorf("transaction error: %#v", err)
+				}
 
 				// Proceed the following by a cb-network controller acquiring the workload
 				if isAcquired {
-
-					var hostNetworkInformation model.HostNetworkInformation
-					if err := json.Unmarshal(event.Kv.Value, &hostNetworkInformation); err != nil {
-						CBLogger.Error(err)
-					}
-					hostName := hostNetworkInformation.HostName
-					hostPublicIP := hostNetworkInformation.PublicIP
-
-					// Find default host network interface and set IP and IPv4CIDR
-					hostIP, hostIPv4CIDR, err := getDefaultInterfaceInfo(hostNetworkInformation.NetworkInterfaces)
-					if err != nil {
-						CBLogger.Error(err)
-						continue
-					}
-
-					// Parse HostID and CLADNetID from the Key
-					slicedKeys := strings.Split(string(event.Kv.Key), "/")
-					parsedHostID := slicedKeys[len(slicedKeys)-1]
-					CBLogger.Tracef("ParsedHostId: %v", parsedHostID)
-					parsedCLADNetID := slicedKeys[len(slicedKeys)-2]
-					CBLogger.Tracef("ParsedCLADNetId: %v", parsedCLADNetID)
-
-					// Prepare lock
-					keyPrefix := fmt.Sprint(etcdkey.LockPeer + "/" + parsedCLADNetID)
-
-					lock := concurrency.NewMutex(session, keyPrefix)
-					ctx := context.TODO()
-
-					// Acquire a lock  (or wait to have it) to update peer
-					CBLogger.Debug("Acquire a lock")
-					// Time trying to acquire a lock
-					start := time.Now()
-					err = lock.Lock(ctx)
-					if err != nil {
-						CBLogger.Errorf("Could NOT acquire lock for '%v', error: %v", keyPrefix, err)
-					}
-					CBLogger.Tracef("Lock acquired for '%s'", keyPrefix)
-
-					// Create a key of peer
-					keyPeer := fmt.Sprint(etcdkey.Peer + "/" + parsedCLADNetID + "/" + parsedHostID)
-
-					// Get a peer
-					CBLogger.Debugf("Get - %v", keyPeer)
-					respRule, respRuleErr := etcdClient.Get(context.TODO(), keyPeer)
-					if respRuleErr != nil {
-						CBLogger.Error(respRuleErr)
-					}
-					CBLogger.Tracef("GetResponse: %#v", respRule)
-
-					totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respRule)
-					CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
-
-					var peer model.Peer
-
-					// Newly allocate the host's configuration
-					if respRule.Count == 0 {
-
-						peer = allocatePeer(parsedCLADNetID, parsedHostID, hostName, hostIPv4CIDR, hostIP, hostPublicIP, etcdClient)
-
-					} else { // Update the host's configuration
-
-						err = json.Unmarshal(respRule.Kvs[0].Value, &peer)
-						if err != nil {
-							CBLogger.Error(err)
-						}
-
-						peer.HostPrivateIPv4CIDR = hostIPv4CIDR
-						peer.HostPrivateIP = hostIP
-						peer.HostPublicIP = hostNetworkInformation.PublicIP
-						peer.State = netstate.Configuring
-					}
-
-					peerBytes, _ := json.Marshal(peer)
-					peerStr := string(peerBytes)
-
-					CBLogger.Debugf("Put - %v", keyPeer)
-					CBLogger.Tracef("Value: %#v", peer)
-
-					size := binary.Size(peerBytes)
-					CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
-
-					putResp, err := etcdClient.Put(context.TODO(), keyPeer, peerStr)
-					if err != nil {
-						CBLogger.Error(err)
-					}
-					CBLogger.Tracef("PutResponse: %#v", putResp)
-
-					// Release a lock to update peer
-					CBLogger.Debug("Release a lock")
-					if err := lock.Unlock(ctx); err != nil {
-						CBLogger.Error(err)
-					}
-					CBLogger.Tracef("Lock released for '%s'", keyPrefix)
-					// Elapsed time from the time trying to acquire a lock
-					elapsed := time.Since(start)
-					formatted := fmt.Sprintf("%.3f", elapsed.Seconds())
-					CBLogger.Tracef("Elapsed time for locking (sec): %s", formatted)
+					workloadAcquisitions.WithLabelValues("acquired").Inc()
+					CBLogger.Debugf("acquires the workload (%v, revision: %v)", event.Key, event.Revision)
+					handleHostNetworkInformation(event, cbnetStore)
+				} else if err != nil {
+					workloadAcquisitions.WithLabelValues("error").Inc()
+				} else {
+					workloadAcquisitions.WithLabelValues("occupied").Inc()
+					CBLogger.Debugf("the workload (%v, revision: %v) already occupied by the other cb-network controlller", event.Key, event.Revision)
 				}
 
-			case mvccpb.DELETE: // The watched key has been deleted.
-				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
+			case store.EventDelete: // The watched key has been deleted.
+				CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
 			default:
-				CBLogger.Errorf("Known event (%s), Key(%q), Value(%q)", event.Type, event.Kv.Key, event.Kv.Value)
+				CBLogger.Errorf("Known event (%s), Key(%q), Value(%q)", event.Type, event.Key, event.Value)
 			}
 		}
 	}
 	CBLogger.Debug("End.........")
 }
 
-func tryToAcquireWorkload(etcdClient *clientv3.Client, key string, revision int64) bool {
+// watchLogLevel changes the log level of this controller at runtime by the one put by the cb-network service
+func watchLogLevel(wg *sync.WaitGroup, cbnetStore store.Store) {
 	CBLogger.Debug("Start.........")
-	// Key to lease temporally by which each cb-network controller can distinguish each updated value
-	keyToLease := fmt.Sprintf("lease%s-%d", key, revision)
-	// fmt.Printf("%#v\n", keyPrefix)
+	defer wg.Done()
+
+	// Watch "/registry/cloud-adaptive-network/log-level/controller"
+	CBLogger.Debug("Watch the log level")
+
+	watchChan := cbnetStore.WatchLogLevel(context.Background(), etcdkey.ControllerComponent)
+	for watchResponse := range watchChan {
+		if watchResponse.Err != nil {
+			CBLogger.Error(watchResponse.Err)
+			continue
+		}
+
+		for _, event := range watchResponse.Events {
+			if event.Type != store.EventPut {
+				continue
+			}
 
-	// Self-assign a workload by Compare-and-Swap (CAS) and Lease
-	lease := clientv3.NewLease(etcdClient)
-	ttl := int64(15)
-	grantResp, grantErr := lease.Grant(context.TODO(), ttl)
-	if grantErr != nil {
-		CBLogger.Errorf("'lease.Grant' error: %#v", grantErr)
+			level, err := logrus.ParseLevel(string(event.Value))
+			if err != nil {
+				CBLogger.Error(err)
+				continue
+			}
+			CBLogger.Infof("Change the log level to %v", level)
+			CBLogger.SetLevel(level)
+		}
+	}
+	CBLogger.Debug("End.........")
+}
+
+// handleHostNetworkInformation allocates (or updates) a peer by the host network information
+func handleHostNetworkInformation(event store.Event, cbnetStore store.Store) {
+	CBLogger.Debug("Start.........")
+
+	var hostNetworkInformation model.HostNetworkInformation
+	if err := json.Unmarshal(event.Value, &hostNetworkInformation); err != nil {
+		CBLogger.Error(err)
+		return
 	}
 
-	CBLogger.Debugf("Transaction (compare-and-swap(CAS)) -  %v", keyToLease)
+	// Continue the trace of the agent registration, whose trace context is embedded in the value
+	ctx := tracing.Extract(context.Background(), hostNetworkInformation.TraceContext)
+	ctx, span := tracing.Start(ctx, "controller.handleHostNetworkInformation",
+		tracing.CLADNetID.String(event.CladnetID), tracing.HostID.String(event.HostID))
+	defer span.End()
 
-	messageToCheck := fmt.Sprintf("Vanished in %d sec", ttl)
-	txResp, err2 := etcdClient.Txn(context.TODO()).
-		If(clientv3.Compare(clientv3.Value(keyToLease), "=", messageToCheck)).
-		Then(clientv3.OpGet(keyToLease)).
-		Else(clientv3.OpPut(keyToLease, messageToCheck, clientv3.WithLease(grantResp.ID))).
-		Commit()
+	hostName := hostNetworkInformation.HostName
+	hostPublicIP := hostNetworkInformation.PublicIP
 
-	if err2 != nil {
-		CBLogger.Errorf("transaction error: %#v", err2)
+	// Find default host network interface and set IP and IPv4CIDR
+	hostIP, hostIPv4CIDR, err := getDefaultInterfaceInfo(hostNetworkInformation)
+	if err != nil {
+		CBLogger.Error(err)
+		return
 	}
 
-	CBLogger.Tracef("TransactionResponse: %#v", txResp)
-	isAcquired := !txResp.Succeeded
+	// HostID and CLADNetID parsed from the Key
+	parsedHostID := event.HostID
+	CBLogger.Tracef("ParsedHostId: %v", parsedHostID)
+	parsedCLADNetID := event.CladnetID
+	CBLogger.Tracef("ParsedCLADNetId: %v", parsedCLADNetID)
+
+	// Acquire a lock (or wait to have it) to update peer
+	CBLogger.Debug("Acquire a lock")
+	// Time trying to acquire a lock
+	start := time.Now()
+	_, lockSpan := tracing.Start(ctx, "controller.acquireLock")
+	unlock, err := cbnetStore.AcquireLock(ctx, store.PeerLock, parsedCLADNetID)
+	tracing.End(lockSpan, err)
+	if err != nil {
+		CBLogger.Errorf("Could NOT acquire lock for '%v', error: %v", parsedCLADNetID, err)
+		return
+	}
+	CBLogger.Tracef("Lock acquired for '%s'", parsedCLADNetID)
+	lockWaitDuration.WithLabelValues().ObserveSince(start)
 
-	if isAcquired {
-		CBLogger.Debugf("acquires by '%s'", keyToLease)
-	} else {
-		CBLogger.Debugf("'%s' already occupied by the other cb-network controlller", keyToLease)
+	defer func() {
+		// Release a lock to update peer
+		CBLogger.Debug("Release a lock")
+		unlock()
+		CBLogger.Tracef("Lock released for '%s'", parsedCLADNetID)
+		// Elapsed time from the time trying to acquire a lock
+		elapsed := time.Since(start)
+		formatted := fmt.Sprintf("%.3f", elapsed.Seconds())
+		CBLogger.Tracef("Elapsed time for locking (sec): %s", formatted)
+	}()
+
+	// Verify the join token only for a host not bound to an agent credential.
+	// A bound host has been authenticated by the service (i.e., by the credential of its agent),
+	// so it joins again (e.g., on restarting the agent) even after the join token is expired or revoked.
+	var joinErr error
+	_, credentialErr := cbnetStore.GetAgentCredential(ctx, parsedCLADNetID, parsedHostID)
+	switch {
+	case credentialErr == nil: // Bound
+	case errors.Is(credentialErr, store.ErrNotFound):
+		joinErr = jointoken.Verify(ctx, cbnetStore, parsedCLADNetID, hostNetworkInformation.JoinToken, time.Now())
+		if joinErr != nil && !errors.Is(joinErr, jointoken.ErrRejected) {
+			CBLogger.Error(joinErr)
+			span.RecordError(joinErr)
+			return
+		}
+	default:
+		CBLogger.Error(credentialErr)
+		span.RecordError(credentialErr)
+		return
+	}
+
+	// Get a peer
+	peer, err := cbnetStore.GetPeer(ctx, parsedCLADNetID, parsedHostID)
+
+	switch {
+	case joinErr != nil && errors.Is(err, store.ErrNotFound): // Refuse to allocate a new peer
+		CBLogger.Errorf("refused to allocate a peer (%v) to the CLADNet (%v): %v", parsedHostID, parsedCLADNetID, joinErr)
+		span.RecordError(joinErr)
+		return
+
+	case joinErr != nil && err == nil: // Reject the peer, which is surfaced to the agent by the peer state
+		CBLogger.Errorf("rejected a peer (%v) of the CLADNet (%v): %v", parsedHostID, parsedCLADNetID, joinErr)
+		span.RecordError(joinErr)
+
+		// Keep the others of the peer as they were, which are not reported by a verified agent
+		peer.State = netstate.Rejected
+		if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+			CBLogger.Error(err)
+			span.RecordError(err)
+		}
+		return
+
+	case errors.Is(err, store.ErrNotFound): // Newly allocate the host's configuration
+
+		// Check the policies of the CLADNet before allocating a new peer
+		if err := checkPeerPolicies(parsedCLADNetID, cbnetStore); err != nil {
+			CBLogger.Errorf("refused to allocate a peer (%v) to the CLADNet (%v): %v", parsedHostID, parsedCLADNetID, err)
+			return
+		}
+
+		allocationStart := time.Now()
+		peer = allocatePeer(ctx, parsedCLADNetID, parsedHostID, hostName, hostIPv4CIDR, hostIP, hostPublicIP, cbnetStore)
+		allocationDuration.WithLabelValues().ObserveSince(allocationStart)
+
+	case err != nil:
+		CBLogger.Error(err)
+		return
+
+	default: // Update the host's configuration
+		peer.HostPrivateIPv4CIDR = hostIPv4CIDR
+		peer.HostPrivateIP = hostIP
+		peer.HostPublicIP = hostNetworkInformation.PublicIP
+		peer.State = netstate.Configuring
+	}
+
+	// Set the cloud information reported by the agent (the one set by updateDetailsOfPeer is kept otherwise)
+	if hostNetworkInformation.CloudInformation != (model.CloudInformation{}) {
+		peer.Details = hostNetworkInformation.CloudInformation
+	}
+
+	CBLogger.Debugf("Put a peer - %v/%v", parsedCLADNetID, parsedHostID)
+	CBLogger.Tracef("Value: %#v", peer)
+
+	// NOTE - The trace context is embedded in the peer for the agent to continue the trace
+	if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+		CBLogger.Error(err)
+		span.RecordError(err)
 	}
 
 	CBLogger.Debug("End.........")
-	return isAcquired
 }
 
-func getDefaultInterfaceInfo(networkInterfaces []model.NetworkInterface) (ipAddr string, ipNet string, err error) {
+// getDefaultInterfaceInfo returns the IP and IPv4CIDR of the underlay network interface of a host.
+// The underlay IPv4 address reported by the agent (i.e., by the default route or the pinned interface) is used.
+// The well-known names of network interfaces are looked up if it is not reported (e.g., by an old agent).
+func getDefaultInterfaceInfo(hostNetworkInformation model.HostNetworkInformation) (ipAddr string, ipNet string, err error) {
 	// Find default host network interface and set IP and IPv4CIDR
 
-	for _, networkInterface := range networkInterfaces {
+	if underlay := hostNetworkInformation.UnderlayIPv4; underlay.IP != "" {
+		CBLogger.Tracef("Underlay IPv4 (interface: %v, pinned: %v): %v", underlay.InterfaceName, underlay.IsPinned, underlay.CIDR)
+		return underlay.IP, underlay.CIDR, nil
+	}
+
+	for _, networkInterface := range hostNetworkInformation.NetworkInterfaces {
 		if networkInterface.Name == "eth0" || networkInterface.Name == "ens4" || networkInterface.Name == "ens5" {
 			return networkInterface.IPv4, networkInterface.IPv4CIDR, nil
 		}
@@ -293,35 +361,47 @@ func getDefaultInterfaceInfo(networkInterfaces []model.NetworkInterface) (ipAddr
 	return "", "", errors.New("could not find default network interface")
 }
 
-func getIpv4AddressSpace(etcdClient *clientv3.Client, key string) (string, error) {
+func getCLADNetSpec(cbnetStore store.Store, cladnetID string) (model.CLADNetSpecification, error) {
 	CBLogger.Debug("Start.........")
 
-	CBLogger.Debugf("Get - %v", key)
-	respSpec, errSpec := etcdClient.Get(context.Background(), key)
-	if errSpec != nil {
-		CBLogger.Error(errSpec)
+	CBLogger.Debugf("Get a CLADNet specification - %v", cladnetID)
+	tempSpec, err := cbnetStore.GetSpec(context.Background(), cladnetID)
+	if errors.Is(err, store.ErrNotFound) {
+		CBLogger.Debug("End.........")
+		return tempSpec, errors.New("no cloud adaptive network exists")
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return tempSpec, err
 	}
-	CBLogger.Tracef("GetResponse: %#v", respSpec)
+	CBLogger.Tracef("TempSpec: %v", tempSpec)
+
+	CBLogger.Debug("End.........")
+	return tempSpec, nil
+}
 
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respSpec)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
+// checkPeerPolicies checks if a new peer can be allocated under the policies of the CLADNet
+func checkPeerPolicies(cladnetID string, cbnetStore store.Store) error {
+	CBLogger.Debug("Start.........")
 
-	var tempSpec model.CLADNetSpecification
+	spec, err := getCLADNetSpec(cbnetStore, cladnetID)
+	if err != nil {
+		return err
+	}
 
-	// Unmarshal the specification of the CLADNet if exists
-	CBLogger.Tracef("RespRule.Kvs: %v", respSpec.Kvs)
-	if len(respSpec.Kvs) != 0 {
-		errUnmarshal := json.Unmarshal(respSpec.Kvs[0].Value, &tempSpec)
-		if errUnmarshal != nil {
-			CBLogger.Error(errUnmarshal)
+	if spec.Policies.MaxPeers > 0 {
+		CBLogger.Debugf("Count peers - %v", cladnetID)
+		count, err := cbnetStore.CountPeers(context.TODO(), cladnetID)
+		if err != nil {
+			return err
+		}
+		if count >= int64(spec.Policies.MaxPeers) {
+			return fmt.Errorf("the CLADNet has reached the maximum number of peers (%d)", spec.Policies.MaxPeers)
 		}
-		CBLogger.Tracef("TempSpec: %v", tempSpec)
-		// Get an IPv4 address space of CLADNet
-		CBLogger.Debug("End.........")
-		return tempSpec.Ipv4AddressSpace, nil
 	}
+
 	CBLogger.Debug("End.........")
-	return "", errors.New("no cloud adaptive network exists")
+	return nil
 }
 
 func assignIPAddressToPeer(ipCIDR string, numberOfIPsAssigned uint32) (string, string, error) {
@@ -375,38 +455,64 @@ func assignIPAddressToPeer(ipCIDR string, numberOfIPsAssigned uint32) (string, s
 	return peerIPv4CIDR, peerIPAddress, nil
 }
 
-func allocatePeer(cladnetID string, hostID string, hostName string, hostIPv4CIDR string, hostIP string, hostPublicIP string, etcdClient *clientv3.Client) model.Peer {
+func allocatePeer(ctx context.Context, cladnetID string, hostID string, hostName string, hostIPv4CIDR string, hostIP string, hostPublicIP string, cbnetStore store.Store) model.Peer {
 	CBLogger.Debug("Start.........")
 
-	// Create a key of the CLADNet specification
-	keyCLADNetSpecificationOfCLADNet := fmt.Sprint(etcdkey.CLADNetSpecification + "/" + cladnetID)
+	ctx, span := tracing.Start(ctx, "controller.allocatePeer")
+	defer span.End()
 
-	// Get the CLADNet specification to check IPv4AddressSpace
-	cladnetIpv4AddressSpace, err := getIpv4AddressSpace(etcdClient, keyCLADNetSpecificationOfCLADNet)
+	// Get the CLADNet specification to check IPv4AddressSpace and reservations
+	spec, err := getCLADNetSpec(cbnetStore, cladnetID)
 	if err != nil {
 		CBLogger.Error(err)
 	}
 
-	// Create a key of peers in a Cloud Adaptive Network
-	keyPeersInCLADNet := fmt.Sprint(etcdkey.Peer + "/" + cladnetID)
-
-	// Get the number of peers
-	CBLogger.Debugf("Get with prefix - %v", keyPeersInCLADNet)
-	resp, respErr := etcdClient.Get(context.TODO(), keyPeersInCLADNet, clientv3.WithPrefix(), clientv3.WithCountOnly())
-	if respErr != nil {
-		CBLogger.Error(respErr)
+	// Get the IP addresses in use
+	// Note - they may not be serial, e.g., after importing a CLADNet without the stale peers
+	CBLogger.Debugf("List peers - %v", cladnetID)
+	peers, err := cbnetStore.ListPeers(ctx, cladnetID)
+	if err != nil {
+		CBLogger.Error(err)
+		span.RecordError(err)
+	}
+	usedIPs := make(map[string]bool)
+	for _, p := range peers {
+		usedIPs[p.IP] = true
 	}
-	CBLogger.Tracef("GetResponse: %#v", resp)
 
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*resp)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
+	// The IP addresses reserved for the other hosts are not available either
+	for _, reservation := range spec.Reservations {
+		if reservation.HostID != hostID {
+			usedIPs[reservation.IP] = true
+		}
+	}
 
+	// Assign the reserved IP address, or the lowest IP address not in use
 	state := netstate.Configuring
-	peerIPv4CIDR, peerIPAddress, err := assignIPAddressToPeer(cladnetIpv4AddressSpace, uint32(resp.Count+2))
+	reservedIP := spec.GetReservedIP(hostID)
+	var peerIPv4CIDR, peerIPAddress string
+	for hostNumber := uint32(2); ; hostNumber++ {
+		peerIPv4CIDR, peerIPAddress, err = assignIPAddressToPeer(spec.Ipv4AddressSpace, hostNumber)
+		if err != nil {
+			break
+		}
+		if reservedIP != "" {
+			if peerIPAddress == reservedIP {
+				break
+			}
+			continue
+		}
+		if !usedIPs[peerIPAddress] {
+			break
+		}
+	}
+	if err == nil && usedIPs[peerIPAddress] {
+		err = fmt.Errorf("the IP (%v) reserved for %v is in use", peerIPAddress, hostID)
+	}
 	if err != nil {
 		CBLogger.Error(err)
+		span.RecordError(err)
 		state = netstate.Failed
-
 	}
 
 	// "0.0.0.0" will be assigned in error case
@@ -427,6 +533,7 @@ func allocatePeer(cladnetID string, hostID string, hostName string, hostIPv4CIDR
 }
 
 func main() {
+	setUp()
 
 	CBLogger.Debug("Start.........")
 
@@ -434,17 +541,17 @@ func main() {
 	var wg sync.WaitGroup
 
 	// etcd Section
-	etcdClient, err := clientv3.New(clientv3.Config{
-		Endpoints:   config.ETCD.Endpoints,
-		DialTimeout: 5 * time.Second,
-	})
+	etcdClient, err := etcdclient.New(config.ETCD)
 
 	if err != nil {
 		CBLogger.Fatal(err)
 	}
 
+	// The cb-network store on the etcd
+	cbnetStore := store.NewEtcdStore(etcdClient)
+
 	defer func() {
-		errClose := etcdClient.Close()
+		errClose := cbnetStore.Close()
 		if errClose != nil {
 			CBLogger.Fatal("Can't close the etcd client", errClose)
 		}
@@ -452,55 +559,41 @@ func main() {
 
 	CBLogger.Infoln("The etcdClient is connected.")
 
-	wg.Add(1)
-	go watchHostNetworkInformation(&wg, etcdClient)
-
-	// wg.Add(1)
-	// go watchPeer(&wg, etcdClient)
-
-	// Waiting for all goroutines to finish
-	CBLogger.Info("Waiting for all goroutines to finish")
-	wg.Wait()
-
-	CBLogger.Debug("End.........")
-}
-
-func extractSizes(res clientv3.GetResponse) (totalSize, headerSize, kvsSize, kvsCount int) {
-
-	headerSize = res.Header.Size()
-	kvsCount = int(res.Count)
-	kvsSize = 0
-	for _, kv := range res.Kvs {
-		kvsSize += kv.Size()
+	// Check the schema version of the registry, which must be migrated by 'cb-network migrate' if it is older
+	if err := migration.CheckSchemaVersion(context.TODO(), cbnetStore); err != nil {
+		CBLogger.Fatal(err)
 	}
 
-	totalSize = headerSize + kvsSize
-
-	return totalSize, headerSize, kvsSize, kvsCount
-}
-
-func createFieldsForResponseSizes(res clientv3.GetResponse) logrus.Fields {
-
-	// lenKvs := res.Count
-	fields := logrus.Fields{}
+	// Export the spans of the workflows (e.g., allocating a peer), if the exporter is configured
+	shutdownTracing, err := tracing.Init(context.TODO(), "cb-network-controller", config.Tracing)
+	if err != nil {
+		CBLogger.Fatal(err)
+	}
+	defer func() {
+		if errShutdown := shutdownTracing(context.Background()); errShutdown != nil {
+			CBLogger.Error(errShutdown)
+		}
+	}()
 
-	headerSize := res.Header.Size()
-	kvSize := 0
-	for _, kv := range res.Kvs {
-		kvSize += kv.Size()
+	// Serve the metrics (e.g., allocation latency and lock wait time), if the listen address is configured
+	if config.Metrics.ListenAddress != "" {
+		go func() {
+			CBLogger.Infof("Serving the metrics on %v/metrics", config.Metrics.ListenAddress)
+			if err := metrics.ListenAndServe(config.Metrics.ListenAddress); err != nil {
+				CBLogger.Error(err)
+			}
+		}()
 	}
 
-	totalSize := headerSize + kvSize
+	wg.Add(1)
+	go watchHostNetworkInformation(&wg, cbnetStore)
 
-	fields["total size"] = totalSize
-	fields["header size"] = headerSize
-	fields["kvs size"] = kvSize
+	wg.Add(1)
+	go watchLogLevel(&wg, cbnetStore)
 
-	for i, kv := range res.Kvs {
-		tempKey := "kv " + strconv.Itoa(i)
-		fields[tempKey] = kv.Size()
-		// kvSize += kv.Size()
-	}
+	// Waiting for all goroutines to finish
+	CBLogger.Info("Waiting for all goroutines to finish")
+	wg.Wait()
 
-	return fields
+	CBLogger.Debug("End.........")
 }
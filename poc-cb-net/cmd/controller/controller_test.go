@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	jointoken "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/join-token"
+	netstate "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-state"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMain(m *testing.M) {
+	CBLogger = logrus.New()
+	CBLogger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// newTestStore creates a memory store having a CLADNet, and returns a join token of the CLADNet
+func newTestStore(t *testing.T, spec model.CLADNetSpecification) (store.Store, string) {
+	t.Helper()
+	ctx := context.Background()
+	cbnetStore := store.NewMemoryStore()
+
+	if err := cbnetStore.PutSpec(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenID, secret, token, err := jointoken.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	joinToken := model.JoinToken{
+		TokenID:    tokenID,
+		CladnetID:  spec.CladnetID,
+		SecretHash: jointoken.HashSecret(secret),
+		CreatedAt:  time.Now(),
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	if err := cbnetStore.PutJoinToken(ctx, joinToken, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	return cbnetStore, token
+}
+
+// hostNetworkInformationEvent returns an event of the host network information registered by an agent
+func hostNetworkInformationEvent(cladnetID string, hostID string, joinToken string, underlayIP string) store.Event {
+	value, _ := json.Marshal(model.HostNetworkInformation{
+		HostName:     hostID,
+		JoinToken:    joinToken,
+		PublicIP:     "203.0.113.1",
+		UnderlayIPv4: model.UnderlayAddress{InterfaceName: "eth0", IP: underlayIP, CIDR: "192.168.0.0/24"},
+	})
+	return store.Event{Type: store.EventPut, CladnetID: cladnetID, HostID: hostID, Value: value}
+}
+
+func TestHandleHostNetworkInformation(t *testing.T) {
+	ctx := context.Background()
+	cbnetStore, joinToken := newTestStore(t, model.CLADNetSpecification{
+		CladnetID:        "cladnet-01",
+		Ipv4AddressSpace: "10.0.0.0/24",
+		Reservations:     []model.IPReservation{{HostID: "host-03", IP: "10.0.0.100"}},
+	})
+
+	// Allocate the lowest IP addresses not in use, or the reserved one
+	for _, hostID := range []string{"host-01", "host-02", "host-03", "host-04"} {
+		handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", hostID, joinToken, "192.168.0.10"), cbnetStore)
+	}
+	for hostID, wantIP := range map[string]string{"host-01": "10.0.0.2", "host-02": "10.0.0.3", "host-03": "10.0.0.100", "host-04": "10.0.0.4"} {
+		peer, err := cbnetStore.GetPeer(ctx, "cladnet-01", hostID)
+		if err != nil {
+			t.Fatalf("GetPeer(%v) error = %v", hostID, err)
+		}
+		if peer.IP != wantIP || peer.IPv4CIDR != wantIP+"/24" || peer.State != netstate.Configuring {
+			t.Errorf("peer of %v = %v (%v, %v), want %v/24 (configuring)", hostID, peer.IP, peer.IPv4CIDR, peer.State, wantIP)
+		}
+		if peer.HostPrivateIP != "192.168.0.10" || peer.HostPublicIP != "203.0.113.1" {
+			t.Errorf("peer of %v has the host IPs %v and %v", hostID, peer.HostPrivateIP, peer.HostPublicIP)
+		}
+	}
+
+	// A peer joining again keeps its IP address, and the host network information is updated
+	peer, _ := cbnetStore.GetPeer(ctx, "cladnet-01", "host-01")
+	peer.State = netstate.Tunneling
+	if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+		t.Fatal(err)
+	}
+	handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", "host-01", joinToken, "192.168.0.20"), cbnetStore)
+	peer, _ = cbnetStore.GetPeer(ctx, "cladnet-01", "host-01")
+	if peer.IP != "10.0.0.2" || peer.HostPrivateIP != "192.168.0.20" || peer.State != netstate.Configuring {
+		t.Errorf("peer of host-01 = %+v, want 10.0.0.2 reconfigured by 192.168.0.20", peer)
+	}
+}
+
+func TestHandleHostNetworkInformationRejection(t *testing.T) {
+	ctx := context.Background()
+	cbnetStore, joinToken := newTestStore(t, model.CLADNetSpecification{CladnetID: "cladnet-01", Ipv4AddressSpace: "10.0.0.0/24"})
+	// A join token of another CLADNet
+	otherStore, otherJoinToken := newTestStore(t, model.CLADNetSpecification{CladnetID: "cladnet-02", Ipv4AddressSpace: "10.0.1.0/24"})
+	otherStore.Close()
+
+	// A new peer is never allocated without a valid join token
+	for _, token := range []string{"", "xxxx", otherJoinToken} {
+		handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", "host-01", token, "192.168.0.10"), cbnetStore)
+		if _, err := cbnetStore.GetPeer(ctx, "cladnet-01", "host-01"); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("GetPeer() by the join token %q error = %v, want ErrNotFound", token, err)
+		}
+	}
+
+	// The token is verified on every join of a host not bound to an agent credential,
+	// so such an existing peer is rejected after the token is revoked
+	handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", "host-01", joinToken, "192.168.0.10"), cbnetStore)
+	handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", "host-02", joinToken, "192.168.0.20"), cbnetStore)
+	if _, err := cbnetStore.CompareAndSwapAgentCredential(ctx, store.AgentCredential{CladnetID: "cladnet-01", HostID: "host-02"}, "xxxx"); err != nil {
+		t.Fatal(err)
+	}
+	tokenID, _, _ := jointoken.Parse(joinToken)
+	if _, err := cbnetStore.DeleteJoinToken(ctx, "cladnet-01", tokenID); err != nil {
+		t.Fatal(err)
+	}
+	handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", "host-01", joinToken, "192.168.0.99"), cbnetStore)
+
+	peer, err := cbnetStore.GetPeer(ctx, "cladnet-01", "host-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peer.State != netstate.Rejected {
+		t.Errorf("the state of the peer = %v, want rejected", peer.State)
+	}
+	// The others are not updated by the rejected agent
+	if peer.IP != "10.0.0.2" || peer.HostPrivateIP != "192.168.0.10" {
+		t.Errorf("peer = %+v, want 10.0.0.2 by 192.168.0.10", peer)
+	}
+
+	// A bound host joins again (e.g., on restarting the agent) after the token is revoked
+	handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", "host-02", joinToken, "192.168.0.98"), cbnetStore)
+	peer, err = cbnetStore.GetPeer(ctx, "cladnet-01", "host-02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peer.State != netstate.Configuring || peer.IP != "10.0.0.3" || peer.HostPrivateIP != "192.168.0.98" {
+		t.Errorf("peer of the bound host = %+v, want 10.0.0.3 reconfigured by 192.168.0.98", peer)
+	}
+
+	// A value which cannot be unmarshalled is ignored
+	handleHostNetworkInformation(store.Event{Type: store.EventPut, CladnetID: "cladnet-01", HostID: "host-03", Value: []byte("{")}, cbnetStore)
+	if _, err := cbnetStore.GetPeer(ctx, "cladnet-01", "host-03"); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("GetPeer() by an invalid value error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestHandleHostNetworkInformationMaxPeers(t *testing.T) {
+	ctx := context.Background()
+	cbnetStore, joinToken := newTestStore(t, model.CLADNetSpecification{
+		CladnetID:        "cladnet-01",
+		Ipv4AddressSpace: "10.0.0.0/24",
+		Policies:         model.CLADNetPolicies{MaxPeers: 2},
+	})
+
+	for _, hostID := range []string{"host-01", "host-02", "host-03"} {
+		handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", hostID, joinToken, "192.168.0.10"), cbnetStore)
+	}
+	if count, err := cbnetStore.CountPeers(ctx, "cladnet-01"); err != nil || count != 2 {
+		t.Errorf("CountPeers() = %v, %v, want 2", count, err)
+	}
+	if _, err := cbnetStore.GetPeer(ctx, "cladnet-01", "host-03"); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("GetPeer(host-03) error = %v, want ErrNotFound", err)
+	}
+
+	// An existing peer joins again even if the CLADNet is full
+	handleHostNetworkInformation(hostNetworkInformationEvent("cladnet-01", "host-02", joinToken, "192.168.0.20"), cbnetStore)
+	if peer, err := cbnetStore.GetPeer(ctx, "cladnet-01", "host-02"); err != nil || peer.HostPrivateIP != "192.168.0.20" {
+		t.Errorf("GetPeer(host-02) = %+v, %v, want updated by 192.168.0.20", peer, err)
+	}
+}
@@ -0,0 +1,601 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
+	jointoken "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/join-token"
+	netstate "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-state"
+	secutil "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/secret-util"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// agentHeartbeatTTL is the time to live (sec) of an agent's heartbeat
+const agentHeartbeatTTL = int64(30)
+
+// minAgentCredentialLength is the minimum length of an agent credential
+const minAgentCredentialLength = 32
+
+// serverAgent is the agent-facing service by which the cb-network agents never access the etcd directly.
+type serverAgent struct {
+	pb.UnimplementedAgentServiceServer
+}
+
+func validateAgentRequest(cladnetID string, hostID string) error {
+	if cladnetID == "" || hostID == "" {
+		return status.Errorf(codes.InvalidArgument, "cladnetId (%+v) and hostId (%+v) are required", cladnetID, hostID)
+	}
+	// Check if the IDs can be segments of the etcd keys
+	if err := etcdkey.ValidateID(cladnetID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid cladnetId: %v", err)
+	}
+	if err := etcdkey.ValidateID(hostID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid hostId: %v", err)
+	}
+	return nil
+}
+
+// authorizeAgentRequest validates the IDs of a request, and checks if the caller is the agent bound to the host.
+// A host is bound to the credential of an agent on the first call verified by a join token,
+// so the other agents (even having a valid join token) cannot act on behalf of the host.
+func authorizeAgentRequest(ctx context.Context, cladnetID string, hostID string) error {
+	if err := validateAgentRequest(cladnetID, hostID); err != nil {
+		return err
+	}
+
+	credential := grpcauth.AgentCredentialFromContext(ctx)
+	if len(credential) < minAgentCredentialLength {
+		return status.Errorf(codes.Unauthenticated, "missing or too short agent credential (at least %v characters)", minAgentCredentialLength)
+	}
+
+	current, err := cbnetStore.GetAgentCredential(ctx, cladnetID, hostID)
+	if errors.Is(err, store.ErrNotFound) {
+		return bindAgentCredential(ctx, cladnetID, hostID, credential)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return status.Errorf(codes.Internal, "error while getting an agent credential: %v", err)
+	}
+
+	if !jointoken.VerifySecret(credential, current.SecretHash) {
+		return status.Errorf(codes.PermissionDenied, "the agent credential does not match the host (%v/%v)", cladnetID, hostID)
+	}
+	return nil
+}
+
+// bindAgentCredential binds a host to the credential of an agent, if the agent presents a valid join token
+func bindAgentCredential(ctx context.Context, cladnetID string, hostID string, credential string) error {
+	err := jointoken.Verify(ctx, cbnetStore, cladnetID, grpcauth.JoinTokenFromContext(ctx), time.Now())
+	if errors.Is(err, jointoken.ErrRejected) {
+		return status.Errorf(codes.PermissionDenied, "the host (%v/%v) has not joined the CLADNet: %v", cladnetID, hostID, err)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return status.Errorf(codes.Internal, "error while verifying a join token: %v", err)
+	}
+
+	CBLogger.Debugf("Bind an agent credential - %v/%v", cladnetID, hostID)
+	isBound, err := cbnetStore.CompareAndSwapAgentCredential(ctx,
+		store.AgentCredential{CladnetID: cladnetID, HostID: hostID}, jointoken.HashSecret(credential))
+	if err != nil {
+		CBLogger.Error(err)
+		return status.Errorf(codes.Internal, "error while putting an agent credential: %v", err)
+	}
+	if !isBound {
+		return status.Errorf(codes.Aborted, "the host (%v/%v) has been bound to another agent in the meantime", cladnetID, hostID)
+	}
+
+	CBLogger.Infof("bound the host (%v/%v) to an agent credential", cladnetID, hostID)
+	return nil
+}
+
+func (s *serverAgent) RegisterAgent(ctx context.Context, req *pb.AgentRegistration) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	var hostNetworkInformation model.HostNetworkInformation
+	if err := json.Unmarshal([]byte(req.HostNetworkInformation), &hostNetworkInformation); err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.InvalidArgument, "invalid host network information: %v", err)
+	}
+
+	// Check if the Cloud Adaptive Network exists or not
+	CBLogger.Debugf("Get a CLADNet specification - %v", req.CladnetId)
+	_, errStore := cbnetStore.GetSpec(ctx, req.CladnetId)
+	if errors.Is(errStore, store.ErrNotFound) {
+		return &pb.AgentResponse{IsSucceeded: false, Message: "not found CLADNet"},
+			status.Errorf(codes.NotFound, "not found a CLADNet by cladnetId (%+v)", req.CladnetId)
+	}
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.AgentResponse{IsSucceeded: false, Message: errStore.Error()},
+			status.Errorf(codes.Internal, "error while getting a CLADNet specification: %v", errStore)
+	}
+
+	// NOTE - The join token has been verified on binding the host to the agent credential (see authorizeAgentRequest),
+	// so a bound host joins again (e.g., on restarting the agent) even after the join token is expired or revoked.
+
+	// Put the host network information, which is watched by the cb-network controllers
+	CBLogger.Debugf("Put host network information - %v/%v", req.CladnetId, req.HostId)
+
+	size := binary.Size([]byte(req.HostNetworkInformation))
+	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+
+	// NOTE - The context carries the trace context of the agent, which is embedded in the value for the controllers
+	err := cbnetStore.PutHostNetworkInformation(ctx, req.CladnetId, req.HostId, hostNetworkInformation)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting host network information: %v", err)
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: "registered"}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) Heartbeat(ctx context.Context, req *pb.AgentHeartbeat) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	heartbeat := model.AgentHeartbeat{
+		CladnetID: req.CladnetId,
+		HostID:    req.HostId,
+		State:     req.State,
+		Timestamp: time.Now(),
+	}
+
+	// Put the heartbeat, which vanishes if an agent stops sending it
+	CBLogger.Debugf("Put a heartbeat - %v/%v", req.CladnetId, req.HostId)
+	CBLogger.Tracef("Value: %#v", heartbeat)
+
+	err := cbnetStore.PutHeartbeat(ctx, heartbeat, time.Duration(agentHeartbeatTTL)*time.Second)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting a heartbeat: %v", err)
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: ""}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) WatchAgentEvents(req *pb.AgentWatchRequest, stream pb.AgentService_WatchAgentEventsServer) error {
+	CBLogger.Debug("Start.........")
+	CBLogger.Debugf("Received: %#v", req)
+
+	if err := authorizeAgentRequest(stream.Context(), req.CladnetId, req.HostId); err != nil {
+		return err
+	}
+
+	// Watch by the event type, and resume watching from the revision after the last event received by the agent
+	ctx := stream.Context()
+	var watchChan store.WatchChan
+
+	switch req.EventType {
+	case pb.AgentEventType_PEER:
+		watchChan = cbnetStore.WatchPeers(ctx, req.CladnetId, req.StartRevision)
+	case pb.AgentEventType_SECRET:
+		watchChan = cbnetStore.WatchSecrets(ctx, req.CladnetId, req.StartRevision)
+	case pb.AgentEventType_CONTROL_COMMAND:
+		watchChan = cbnetStore.WatchControlCommand(ctx, req.CladnetId, req.HostId, req.StartRevision)
+	case pb.AgentEventType_TEST_REQUEST:
+		watchChan = cbnetStore.WatchTestRequest(ctx, req.CladnetId, req.HostId, req.StartRevision)
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown event type (%v)", req.EventType)
+	}
+
+	CBLogger.Debugf("Watch - %v (%v/%v)", req.EventType, req.CladnetId, req.HostId)
+	for watchResponse := range watchChan {
+		if errors.Is(watchResponse.Err, store.ErrCompacted) {
+			return status.Errorf(codes.OutOfRange, "revision (%v) has been compacted (compact revision: %v)", req.StartRevision, watchResponse.CompactRevision)
+		}
+		if watchResponse.Err != nil {
+			CBLogger.Error(watchResponse.Err)
+			return status.Errorf(codes.Unavailable, "error while watching: %v", watchResponse.Err)
+		}
+
+		// Notify the agent that the watch has been established
+		if watchResponse.Created {
+			if err := stream.SendHeader(metadata.MD{}); err != nil {
+				CBLogger.Error(err)
+				return err
+			}
+			continue
+		}
+
+		for _, event := range watchResponse.Events {
+			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Key, event.Value)
+
+			agentEvent := &pb.AgentEvent{
+				EventType: req.EventType,
+				IsDeleted: event.Type == store.EventDelete,
+				HostId:    event.HostID,
+				Value:     string(event.Value),
+				Revision:  event.Revision,
+			}
+
+			if err := stream.Send(agentEvent); err != nil {
+				CBLogger.Error(err)
+				return err
+			}
+		}
+	}
+
+	CBLogger.Debug("End.........")
+	return status.FromContextError(stream.Context().Err()).Err()
+}
+
+func (s *serverAgent) UpdatePeerState(ctx context.Context, req *pb.AgentPeerState) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	// Acquire a lock (or wait to have it) to update peer
+	CBLogger.Debug("Acquire a lock")
+	unlock, err := cbnetStore.AcquireLock(ctx, store.PeerLock, req.CladnetId)
+	if err != nil {
+		CBLogger.Errorf("Could NOT acquire lock for '%v', error: %v", req.CladnetId, err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while acquiring a lock: %v", err)
+	}
+	CBLogger.Tracef("Lock acquired for '%s'", req.CladnetId)
+
+	defer func() {
+		// Release a lock to update peer
+		CBLogger.Debug("Release a lock")
+		unlock()
+		CBLogger.Tracef("Lock released for '%s'", req.CladnetId)
+	}()
+
+	// Get the peer
+	CBLogger.Debugf("Get a peer - %v/%v", req.CladnetId, req.HostId)
+	peer, errStore := cbnetStore.GetPeer(ctx, req.CladnetId, req.HostId)
+	if errors.Is(errStore, store.ErrNotFound) {
+		return &pb.AgentResponse{IsSucceeded: false, Message: "not found peer"},
+			status.Errorf(codes.NotFound, "not found a peer by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+	}
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.AgentResponse{IsSucceeded: false, Message: errStore.Error()},
+			status.Errorf(codes.Internal, "error while getting a peer: %v", errStore)
+	}
+
+	// A rejected peer is changed only by the controllers (i.e., by joining again with a valid join token)
+	if peer.State == netstate.Rejected {
+		return &pb.AgentResponse{IsSucceeded: false, Message: "rejected peer"},
+			status.Errorf(codes.FailedPrecondition, "the peer (%v/%v) has been rejected", req.CladnetId, req.HostId)
+	}
+
+	// Update the state of the peer
+	peer.State = req.State
+
+	CBLogger.Debugf("Put a peer - %v/%v", req.CladnetId, req.HostId)
+	CBLogger.Tracef("Value: %#v", peer)
+
+	err = cbnetStore.PutPeer(ctx, peer)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting a peer: %v", err)
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: ""}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) InitializeSecret(ctx context.Context, req *pb.AgentSecret) (*pb.AgentSecrets, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentSecrets{}, err
+	}
+
+	// Check if the public key is revoked or not
+	fingerprint, err := secutil.PublicKeyFingerprint(req.PublicKey)
+	if err != nil {
+		return &pb.AgentSecrets{}, status.Errorf(codes.InvalidArgument, "invalid public key: %v", err)
+	}
+
+	unlock, err := lockSecret(ctx, req.CladnetId)
+	if err != nil {
+		return &pb.AgentSecrets{}, err
+	}
+	defer unlock()
+
+	CBLogger.Debugf("Check a revocation - %v/%v/%v", req.CladnetId, req.HostId, fingerprint)
+	isRevoked, errStore := cbnetStore.IsSecretRevoked(ctx, req.CladnetId, req.HostId, fingerprint)
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.AgentSecrets{}, status.Errorf(codes.Internal, "error while getting a revocation: %v", errStore)
+	}
+	if isRevoked {
+		putSecretAuditRecord(ctx, model.SecretAuditRecord{
+			CladnetID:   req.CladnetId,
+			HostID:      req.HostId,
+			Action:      model.SecretActionReject,
+			Fingerprint: fingerprint,
+			Actor:       auditActor(ctx, req.HostId),
+			Reason:      "the public key has been revoked",
+		})
+		return &pb.AgentSecrets{}, status.Errorf(codes.PermissionDenied, "the public key (%v) has been revoked", fingerprint)
+	}
+
+	// Get the secrets
+	CBLogger.Debugf("Get secrets - %v", req.CladnetId)
+	tempSecrets, errStore := cbnetStore.ListSecrets(ctx, req.CladnetId)
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.AgentSecrets{}, status.Errorf(codes.Internal, "error while getting secrets: %v", errStore)
+	}
+
+	// Gather the other hosts' secrets
+	secrets := &pb.AgentSecrets{}
+	hasSecret := false
+	for _, tempSecret := range tempSecrets {
+		CBLogger.Tracef("ParsedHostID: %v", tempSecret.HostID)
+
+		if tempSecret.HostID == req.HostId {
+			hasSecret = true
+		} else {
+			secrets.Secrets = append(secrets.Secrets, &pb.AgentSecret{
+				CladnetId: req.CladnetId,
+				HostId:    tempSecret.HostID,
+				PublicKey: tempSecret.PublicKey,
+			})
+		}
+	}
+
+	// Compare-and-swap(CAS) the secret
+	CBLogger.Debugf("Compare-and-swap(CAS) a secret - %v/%v", req.CladnetId, req.HostId)
+	CBLogger.Tracef("Value: %v", req.PublicKey)
+
+	size := binary.Size([]byte(req.PublicKey))
+	CBLogger.Tracef("TransactionRequest size (bytes): total_size: %v", size)
+
+	isSwapped, err := cbnetStore.CompareAndSwapSecret(ctx, req.CladnetId, req.HostId, req.PublicKey)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentSecrets{}, status.Errorf(codes.Internal, "error while putting a secret: %v", err)
+	}
+
+	// Audit if the secret is registered or rotated
+	if isSwapped {
+		action := model.SecretActionRegister
+		if hasSecret {
+			action = model.SecretActionRotate
+		}
+		putSecretAuditRecord(ctx, model.SecretAuditRecord{
+			CladnetID:   req.CladnetId,
+			HostID:      req.HostId,
+			Action:      action,
+			Fingerprint: fingerprint,
+			Actor:       auditActor(ctx, req.HostId),
+		})
+	}
+
+	CBLogger.Debug("End.........")
+	return secrets, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) PutNetworkingRule(ctx context.Context, req *pb.AgentNetworkingRule) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	var networkingRule model.NetworkingRule
+	if err := json.Unmarshal([]byte(req.NetworkingRule), &networkingRule); err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.InvalidArgument, "invalid networking rule: %v", err)
+	}
+
+	// Compare-and-swap(CAS) to put networking rule for a peer
+	CBLogger.Debugf("Compare-and-swap(CAS) a networking rule - %v/%v", req.CladnetId, req.HostId)
+	CBLogger.Tracef("Value: %#v", networkingRule)
+
+	size := binary.Size([]byte(req.NetworkingRule))
+	networkingRuleCount := len(networkingRule.HostID)
+	CBLogger.Tracef("TransactionRequest size (bytes): total_size: %v, networking_rule_count: %v", size, networkingRuleCount)
+
+	isSwapped, err := cbnetStore.CompareAndSwapRule(ctx, req.CladnetId, req.HostId, networkingRule)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting a networking rule: %v", err)
+	}
+	CBLogger.Tracef("Swapped: %v", isSwapped)
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: ""}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) PostTestResult(ctx context.Context, req *pb.AgentTestResult) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	var networkStatus model.NetworkStatus
+	if err := json.Unmarshal([]byte(req.NetworkStatus), &networkStatus); err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.InvalidArgument, "invalid network status: %v", err)
+	}
+
+	// Put the network status of the CLADNet
+	CBLogger.Debugf("Put a network status - %v/%v", req.CladnetId, req.HostId)
+	CBLogger.Tracef("Value: %#v", networkStatus)
+
+	size := binary.Size([]byte(req.NetworkStatus))
+	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+
+	networkStatus.HostID = req.HostId
+	networkStatus.ReportedAt = time.Now()
+	err := cbnetStore.PutNetworkStatus(ctx, req.CladnetId, req.HostId, networkStatus)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting a test result: %v", err)
+	}
+
+	// Retain the result of the test run (the latest one above is overwritten by the next run)
+	if err := retainTestResult(ctx, req.CladnetId, networkStatus); err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while retaining a test result: %v", err)
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: ""}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) ReportPeerStatistics(ctx context.Context, req *pb.AgentPeerStatistics) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	var peerStatistics model.PeerStatistics
+	if err := json.Unmarshal([]byte(req.PeerStatistics), &peerStatistics); err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.InvalidArgument, "invalid peer statistics: %v", err)
+	}
+	// The IDs of the request take precedence over the reported ones
+	peerStatistics.CladnetID = req.CladnetId
+	peerStatistics.HostID = req.HostId
+
+	// Put the traffic statistics of the peer
+	CBLogger.Debugf("Put peer statistics - %v/%v", req.CladnetId, req.HostId)
+	CBLogger.Tracef("Value: %#v", peerStatistics)
+
+	err := cbnetStore.PutPeerStatistics(ctx, peerStatistics)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting peer statistics: %v", err)
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: ""}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) UploadPacketCapture(ctx context.Context, req *pb.AgentPacketCapture) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	var uploaded model.PacketCapture
+	if err := json.Unmarshal([]byte(req.PacketCapture), &uploaded); err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.InvalidArgument, "invalid packet capture: %v", err)
+	}
+	if err := etcdkey.ValidateID(uploaded.CaptureID); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.InvalidArgument, "invalid captureId: %v", err)
+	}
+
+	// Only the requested packet captures are accepted
+	capture, err := cbnetStore.GetPacketCapture(ctx, req.CladnetId, req.HostId, uploaded.CaptureID)
+	if errors.Is(err, store.ErrNotFound) {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.NotFound, "not found the packet capture (%v), which has not been requested or has expired", uploaded.CaptureID)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while getting the packet capture: %v", err)
+	}
+
+	// The request (e.g., the filter and the size cap) is kept, and the result is updated
+	capture.State = uploaded.State
+	capture.Message = uploaded.Message
+	capture.InnerPackets, capture.OuterPackets = uploaded.InnerPackets, uploaded.OuterPackets
+	capture.InnerBytes, capture.OuterBytes = len(req.Inner), len(req.Outer)
+	capture.StartedAt, capture.StoppedAt = uploaded.StartedAt, uploaded.StoppedAt
+
+	if capture.InnerBytes+capture.OuterBytes > capture.MaxBytes {
+		err := status.Errorf(codes.InvalidArgument, "the pcapng files (%d bytes) exceed the size cap (%d bytes)",
+			capture.InnerBytes+capture.OuterBytes, capture.MaxBytes)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	// Put the files before the metadata, so that the completed capture is downloadable
+	CBLogger.Debugf("Put a packet capture - %v/%v/%v", req.CladnetId, req.HostId, capture.CaptureID)
+	ttl := time.Duration(packetCaptureTTL) * time.Second
+	for layer, file := range map[string][]byte{model.CaptureInner: req.Inner, model.CaptureOuter: req.Outer} {
+		if len(file) == 0 {
+			continue
+		}
+		err := cbnetStore.PutPacketCaptureFile(ctx, req.CladnetId, req.HostId, capture.CaptureID, layer, file, ttl)
+		if err != nil {
+			CBLogger.Error(err)
+			return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+				status.Errorf(codes.Internal, "error while putting the pcapng file: %v", err)
+		}
+	}
+
+	err = cbnetStore.PutPacketCapture(ctx, capture, ttl)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting the packet capture: %v", err)
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: ""}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) GetCLADNet(ctx context.Context, req *pb.AgentRequest) (*pb.CLADNetSpecification, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.CLADNetSpecification{}, err
+	}
+
+	// An agent gets the CLADNet which its host belongs to
+	spec, err := (&serverCloudAdaptiveNetwork{}).GetCLADNet(ctx, &pb.CLADNetRequest{CladnetId: req.CladnetId})
+
+	CBLogger.Debug("End.........")
+	return spec, err
+}
+
+func (s *serverAgent) GetPeerList(ctx context.Context, req *pb.AgentRequest) (*pb.Peers, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.Peers{}, err
+	}
+
+	// An agent gets the peers in the CLADNet which its host belongs to
+	peers, err := (&serverCloudAdaptiveNetwork{}).GetPeerList(ctx, &pb.PeerRequest{CladnetId: req.CladnetId})
+
+	CBLogger.Debug("End.........")
+	return peers, err
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	jointoken "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/join-token"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	CBLogger = logrus.New()
+	CBLogger.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+var (
+	credentialA = strings.Repeat("a", minAgentCredentialLength)
+	credentialB = strings.Repeat("b", minAgentCredentialLength)
+)
+
+// setUpStore sets a memory store having a CLADNet, and returns a join token of the CLADNet
+func setUpStore(t *testing.T) string {
+	t.Helper()
+	ctx := context.Background()
+	cbnetStore = store.NewMemoryStore()
+
+	spec := model.CLADNetSpecification{CladnetID: "cladnet-01", Ipv4AddressSpace: "10.0.0.0/24"}
+	if err := cbnetStore.PutSpec(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenID, secret, token, err := jointoken.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	joinToken := model.JoinToken{
+		TokenID:    tokenID,
+		CladnetID:  "cladnet-01",
+		SecretHash: jointoken.HashSecret(secret),
+		CreatedAt:  time.Now(),
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	if err := cbnetStore.PutJoinToken(ctx, joinToken, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// agentContext returns an incoming context of a call with the credential and the join token of an agent
+func agentContext(credential string, joinToken string) context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("cb-agent-credential", credential, "cb-join-token", joinToken))
+}
+
+func TestAuthorizeAgentRequest(t *testing.T) {
+	joinToken := setUpStore(t)
+
+	// The steps run in order, because the first call verified by a join token binds a host
+	steps := []struct {
+		name     string
+		ctx      context.Context
+		hostID   string
+		wantCode codes.Code
+	}{
+		{name: "no metadata", ctx: context.Background(), hostID: "host-01", wantCode: codes.Unauthenticated},
+		{name: "too short credential", ctx: agentContext("short", joinToken), hostID: "host-01", wantCode: codes.Unauthenticated},
+		{name: "not bound without a join token", ctx: agentContext(credentialA, ""), hostID: "host-01", wantCode: codes.PermissionDenied},
+		{name: "not bound with an invalid join token", ctx: agentContext(credentialA, "xxxxxxxx.xxxx"), hostID: "host-01", wantCode: codes.PermissionDenied},
+		{name: "bind by a join token", ctx: agentContext(credentialA, joinToken), hostID: "host-01", wantCode: codes.OK},
+		{name: "bound without a join token", ctx: agentContext(credentialA, ""), hostID: "host-01", wantCode: codes.OK},
+		{name: "another agent with a join token", ctx: agentContext(credentialB, joinToken), hostID: "host-01", wantCode: codes.PermissionDenied},
+		{name: "another agent binds another host", ctx: agentContext(credentialB, joinToken), hostID: "host-02", wantCode: codes.OK},
+		{name: "the agent acts on behalf of another host", ctx: agentContext(credentialA, joinToken), hostID: "host-02", wantCode: codes.PermissionDenied},
+		{name: "invalid host ID", ctx: agentContext(credentialA, joinToken), hostID: "host/01", wantCode: codes.InvalidArgument},
+	}
+
+	for _, step := range steps {
+		err := authorizeAgentRequest(step.ctx, "cladnet-01", step.hostID)
+		if got := status.Code(err); got != step.wantCode {
+			t.Errorf("%v: authorizeAgentRequest() error = %v, want %v", step.name, err, step.wantCode)
+		}
+	}
+}
+
+func TestAgentHandlersRejectAnotherAgent(t *testing.T) {
+	joinToken := setUpStore(t)
+	server := &serverAgent{}
+
+	// The agent A joins as host-01
+	if _, err := server.Heartbeat(agentContext(credentialA, joinToken), &pb.AgentHeartbeat{CladnetId: "cladnet-01", HostId: "host-01"}); err != nil {
+		t.Fatalf("Heartbeat() error = %v", err)
+	}
+
+	// The agent B, even with the join token, cannot act on behalf of host-01
+	ctx := agentContext(credentialB, joinToken)
+	if _, err := server.InitializeSecret(ctx, &pb.AgentSecret{CladnetId: "cladnet-01", HostId: "host-01", PublicKey: "xxxx"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("InitializeSecret() error = %v, want PermissionDenied", err)
+	}
+	if _, err := cbnetStore.GetSecret(context.Background(), "cladnet-01", "host-01"); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("GetSecret() error = %v, want ErrNotFound", err)
+	}
+
+	if _, err := server.PutNetworkingRule(ctx, &pb.AgentNetworkingRule{CladnetId: "cladnet-01", HostId: "host-01", NetworkingRule: "{}"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("PutNetworkingRule() error = %v, want PermissionDenied", err)
+	}
+	if _, err := server.UpdatePeerState(ctx, &pb.AgentPeerState{CladnetId: "cladnet-01", HostId: "host-01", State: "tunneling"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdatePeerState() error = %v, want PermissionDenied", err)
+	}
+}
+
+func TestRegisterAgent(t *testing.T) {
+	joinToken := setUpStore(t)
+	server := &serverAgent{}
+
+	register := func(ctx context.Context, hostID string, joinToken string) error {
+		hostNetworkInformation, _ := json.Marshal(model.HostNetworkInformation{JoinToken: joinToken})
+		_, err := server.RegisterAgent(ctx, &pb.AgentRegistration{
+			CladnetId:              "cladnet-01",
+			HostId:                 hostID,
+			HostNetworkInformation: string(hostNetworkInformation),
+		})
+		return err
+	}
+
+	if err := register(agentContext(credentialA, joinToken), "host-01", joinToken); err != nil {
+		t.Fatalf("RegisterAgent() error = %v", err)
+	}
+
+	// A rejection is returned to the agent, so that it is surfaced
+	if err := register(agentContext(credentialB, "xxxxxxxx.xxxx"), "host-02", "xxxxxxxx.xxxx"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RegisterAgent() with an invalid join token error = %v, want PermissionDenied", err)
+	}
+	if err := register(agentContext(credentialB, joinToken), "host-01", joinToken); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RegisterAgent() by another agent error = %v, want PermissionDenied", err)
+	}
+
+	// The bound host joins again (e.g., on restarting the agent) after the join token is revoked, but a new host does not
+	tokenID, _, _ := jointoken.Parse(joinToken)
+	if _, err := cbnetStore.DeleteJoinToken(context.Background(), "cladnet-01", tokenID); err != nil {
+		t.Fatal(err)
+	}
+	if err := register(agentContext(credentialA, joinToken), "host-01", joinToken); err != nil {
+		t.Errorf("RegisterAgent() of the bound host after the revocation error = %v", err)
+	}
+	if err := register(agentContext(credentialB, joinToken), "host-02", joinToken); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RegisterAgent() of a new host after the revocation error = %v, want PermissionDenied", err)
+	}
+}
+
+func TestAgentGetCLADNetAndPeerList(t *testing.T) {
+	joinToken := setUpStore(t)
+	server := &serverAgent{}
+	ctx := agentContext(credentialA, joinToken)
+	req := &pb.AgentRequest{CladnetId: "cladnet-01", HostId: "host-01"}
+
+	spec, err := server.GetCLADNet(ctx, req)
+	if err != nil || spec.Ipv4AddressSpace != "10.0.0.0/24" {
+		t.Errorf("GetCLADNet() = %+v, %v, want 10.0.0.0/24", spec, err)
+	}
+
+	if err := cbnetStore.PutPeer(context.Background(), model.Peer{CladnetID: "cladnet-01", HostID: "host-01", IP: "10.0.0.2"}); err != nil {
+		t.Fatal(err)
+	}
+	peers, err := server.GetPeerList(ctx, req)
+	if err != nil || len(peers.Peers) != 1 || peers.Peers[0].Ip != "10.0.0.2" {
+		t.Errorf("GetPeerList() = %+v, %v, want 1 peer", peers, err)
+	}
+
+	// Neither another agent of the host nor an agent without a join token gets the CLADNet
+	if _, err := server.GetCLADNet(agentContext(credentialB, joinToken), req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetCLADNet() by another agent error = %v, want PermissionDenied", err)
+	}
+	if _, err := server.GetPeerList(agentContext(credentialB, ""), &pb.AgentRequest{CladnetId: "cladnet-01", HostId: "host-02"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetPeerList() without a join token error = %v, want PermissionDenied", err)
+	}
+}
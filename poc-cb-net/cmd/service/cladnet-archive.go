@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverCloudAdaptiveNetwork) ExportCLADNet(ctx context.Context, req *pb.CLADNetRequest) (*pb.CLADNetArchive, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	archive, err := exportCLADNet(ctx, req.CladnetId)
+	if errors.Is(err, store.ErrNotFound) {
+		return &pb.CLADNetArchive{}, status.Errorf(codes.NotFound, "could not find a CLADNet by %v", req.CladnetId)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.CLADNetArchive{}, status.Errorf(codes.Internal, "error while exporting a CLADNet: %v", err)
+	}
+
+	archiveBytes, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.CLADNetArchive{}, status.Errorf(codes.Internal, "error while marshaling an archive: %v", err)
+	}
+	CBLogger.Debugf("Exported - %v (peers: %v, networking rules: %v, secrets: %v)",
+		req.CladnetId, len(archive.Peers), len(archive.NetworkingRules), len(archive.Secrets))
+
+	return &pb.CLADNetArchive{
+		CladnetId: req.CladnetId,
+		Archive:   string(archiveBytes),
+	}, status.New(codes.OK, "").Err()
+}
+
+// exportCLADNet gathers the state of a CLADNet from the store
+func exportCLADNet(ctx context.Context, cladnetID string) (model.CLADNetArchive, error) {
+	CBLogger.Debug("Start.........")
+
+	archive := model.CLADNetArchive{
+		ArchiveVersion: model.CLADNetArchiveVersion,
+		ExportedAt:     time.Now(),
+	}
+
+	var err error
+	if archive.Specification, err = cbnetStore.GetSpec(ctx, cladnetID); err != nil {
+		return archive, err
+	}
+	if archive.SchemaVersion, err = cbnetStore.GetSchemaVersion(ctx); err != nil {
+		return archive, err
+	}
+	if archive.Peers, err = cbnetStore.ListPeers(ctx, cladnetID); err != nil {
+		return archive, err
+	}
+
+	rules, err := cbnetStore.ListRules(ctx, cladnetID)
+	if err != nil {
+		return archive, err
+	}
+	for hostID, rule := range rules {
+		archive.NetworkingRules = append(archive.NetworkingRules, model.ArchivedNetworkingRule{HostID: hostID, Rule: rule})
+	}
+	sort.Slice(archive.NetworkingRules, func(i, j int) bool {
+		return archive.NetworkingRules[i].HostID < archive.NetworkingRules[j].HostID
+	})
+
+	secrets, err := cbnetStore.ListSecrets(ctx, cladnetID)
+	if err != nil {
+		return archive, err
+	}
+	for _, secret := range secrets {
+		archive.Secrets = append(archive.Secrets, model.ArchivedSecret{HostID: secret.HostID, PublicKey: secret.PublicKey})
+	}
+
+	if archive.SecretRevocations, err = cbnetStore.ListSecretRevocations(ctx, cladnetID); err != nil {
+		return archive, err
+	}
+	if archive.Heartbeats, err = cbnetStore.ListHeartbeats(ctx, cladnetID); err != nil {
+		return archive, err
+	}
+
+	CBLogger.Debug("End.........")
+	return archive, nil
+}
+
+// importCLADNetState puts the peers, networking rules, secrets and revocations of an archive under a CLADNet ID,
+// except the stale peers
+func importCLADNetState(ctx context.Context, cladnetID string, archive model.CLADNetArchive, stalePeers map[string]bool) error {
+	for _, peer := range archive.Peers {
+		if stalePeers[peer.HostID] {
+			continue
+		}
+		peer.CladnetID = cladnetID
+		CBLogger.Debugf("Put a peer - %v/%v (%v)", cladnetID, peer.HostID, peer.IP)
+		if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+			return fmt.Errorf("error while putting a peer: %v", err)
+		}
+	}
+
+	for _, archivedRule := range archive.NetworkingRules {
+		if stalePeers[archivedRule.HostID] {
+			continue
+		}
+		rule := archivedRule.Rule
+		rule.CladnetID = cladnetID
+		for hostID := range stalePeers {
+			rule.RemoveRule(hostID)
+		}
+		CBLogger.Debugf("Put a networking rule - %v/%v", cladnetID, archivedRule.HostID)
+		if _, err := cbnetStore.CompareAndSwapRule(ctx, cladnetID, archivedRule.HostID, rule); err != nil {
+			return fmt.Errorf("error while putting a networking rule: %v", err)
+		}
+	}
+
+	for _, secret := range archive.Secrets {
+		if stalePeers[secret.HostID] {
+			continue
+		}
+		CBLogger.Debugf("Put a secret - %v/%v", cladnetID, secret.HostID)
+		if _, err := cbnetStore.CompareAndSwapSecret(ctx, cladnetID, secret.HostID, secret.PublicKey); err != nil {
+			return fmt.Errorf("error while putting a secret: %v", err)
+		}
+	}
+
+	// Keep the revocations so that the revoked keys are never accepted again
+	for _, revocation := range archive.SecretRevocations {
+		revocation.CladnetID = cladnetID
+		if err := cbnetStore.PutSecretRevocation(ctx, revocation); err != nil {
+			return fmt.Errorf("error while putting a revocation: %v", err)
+		}
+	}
+	return nil
+}
+
+func (s *serverCloudAdaptiveNetwork) ImportCLADNet(ctx context.Context, req *pb.ImportCLADNetRequest) (*pb.CLADNetSpecification, error) {
+	CBLogger.Debug("Start.........")
+
+	var archive model.CLADNetArchive
+	if err := json.Unmarshal([]byte(req.Archive), &archive); err != nil {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.InvalidArgument, "invalid archive: %v", err)
+	}
+
+	if archive.ArchiveVersion != model.CLADNetArchiveVersion {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.FailedPrecondition,
+			"unsupported archive version (%v), supported version: %v", archive.ArchiveVersion, model.CLADNetArchiveVersion)
+	}
+	if archive.SchemaVersion > etcdkey.CurrentSchemaVersion {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.FailedPrecondition,
+			"the archive has schema version %v, but the registry supports up to %v", archive.SchemaVersion, etcdkey.CurrentSchemaVersion)
+	}
+
+	// Import under the new CLADNet ID if requested
+	cladnetID := archive.Specification.CladnetID
+	if req.NewCladnetId != "" {
+		cladnetID = req.NewCladnetId
+	}
+	if err := etcdkey.ValidateID(cladnetID); err != nil {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.InvalidArgument, "invalid cladnetId: %v", err)
+	}
+
+	// Check if the Cloud Adaptive Network exists or not
+	_, err := cbnetStore.GetSpec(ctx, cladnetID)
+	if err == nil {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.AlreadyExists, "already exists (CladnetID: %s)", cladnetID)
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		CBLogger.Error(err)
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while getting a CLADNetSpecification: %v", err)
+	}
+
+	// The imported CLADNet must be valid by itself (e.g., an archive edited by hand),
+	// and must not conflict with the existing ones (except the archived one if it is ignored)
+	specs, err := cbnetStore.ListSpecs(ctx)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while listing CLADNetSpecifications: %v", err)
+	}
+	others := make([]model.CLADNetSpecification, 0, len(specs))
+	for _, other := range specs {
+		if req.IgnoreOriginal && other.CladnetID == archive.Specification.CladnetID {
+			CBLogger.Debugf("Ignore the conflicts with the original CLADNet (%v)", other.CladnetID)
+			continue
+		}
+		others = append(others, other)
+	}
+	spec := archive.Specification
+	spec.CladnetID = cladnetID
+	if err := validator.ValidateSpecification(spec, others, nil); err != nil {
+		return &pb.CLADNetSpecification{}, err
+	}
+
+	// Find the stale peers, whose agents were not alive on export
+	stalePeers := make(map[string]bool)
+	if req.DropStalePeers {
+		alive := make(map[string]bool)
+		for _, heartbeat := range archive.Heartbeats {
+			alive[heartbeat.HostID] = true
+		}
+		for _, peer := range archive.Peers {
+			if !alive[peer.HostID] {
+				stalePeers[peer.HostID] = true
+			}
+		}
+		CBLogger.Debugf("Stale peers to drop: %v", stalePeers)
+	}
+
+	// Create the specification, which fails if another import (or create) of the CLADNet wins the race
+	CBLogger.Debugf("Create a CLADNet specification - %v", cladnetID)
+	isCreated, err := cbnetStore.CreateSpec(ctx, spec)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while putting CLADNetSpecification: %v", err)
+	}
+	if !isCreated {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.AlreadyExists, "already exists (CladnetID: %s)", cladnetID)
+	}
+
+	// Put the others with the preserved IP addresses, or roll back all of them (and the specification) on error
+	if err := importCLADNetState(ctx, cladnetID, archive, stalePeers); err != nil {
+		CBLogger.Error(err)
+		// Roll back even if the request has been canceled
+		if rollbackErr := cbnetStore.DeleteCLADNet(context.Background(), cladnetID); rollbackErr != nil {
+			CBLogger.Error(rollbackErr)
+			return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal,
+				"%v, and failed to roll back the CLADNet (%v): %v", err, cladnetID, rollbackErr)
+		}
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "%v (rolled back)", err)
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.CLADNetSpecification{
+		CladnetId:        spec.CladnetID,
+		Name:             spec.Name,
+		Ipv4AddressSpace: spec.Ipv4AddressSpace,
+		Description:      spec.Description,
+		RuleType:         spec.RuleType,
+	}, status.New(codes.OK, "").Err()
+}
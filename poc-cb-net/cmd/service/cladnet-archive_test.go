@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportTestCLADNet puts a CLADNet having peers, networking rules and secrets to the store, and exports it
+func exportTestCLADNet(t *testing.T) model.CLADNetArchive {
+	t.Helper()
+	ctx := context.Background()
+	setUpStore(t)
+
+	for _, peer := range []model.Peer{
+		{CladnetID: "cladnet-01", HostID: "host-01", IP: "10.0.0.2", IPv4CIDR: "10.0.0.2/24"},
+		{CladnetID: "cladnet-01", HostID: "host-02", IP: "10.0.0.3", IPv4CIDR: "10.0.0.3/24"},
+	} {
+		if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+			t.Fatal(err)
+		}
+		var rule model.NetworkingRule
+		rule.UpdateRule(peer.HostID, peer.HostID, peer.IP, "192.168.0.1", "private", "tunneling")
+		if _, err := cbnetStore.CompareAndSwapRule(ctx, "cladnet-01", peer.HostID, rule); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cbnetStore.CompareAndSwapSecret(ctx, "cladnet-01", peer.HostID, "key-of-"+peer.HostID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive, err := exportCLADNet(ctx, "cladnet-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return archive
+}
+
+// importCLADNet imports an archive by the handler
+func importCLADNet(archive model.CLADNetArchive, newCladnetID string, ignoreOriginal bool) error {
+	archiveBytes, _ := json.Marshal(archive)
+	_, err := (&serverCloudAdaptiveNetwork{}).ImportCLADNet(context.Background(), &pb.ImportCLADNetRequest{
+		Archive:        string(archiveBytes),
+		NewCladnetId:   newCladnetID,
+		IgnoreOriginal: ignoreOriginal,
+	})
+	return err
+}
+
+func TestImportCLADNet(t *testing.T) {
+	ctx := context.Background()
+	archive := exportTestCLADNet(t)
+
+	if err := importCLADNet(archive, "", false); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("ImportCLADNet() under the archived ID error = %v, want AlreadyExists", err)
+	}
+
+	// A copy conflicts with the original unless it is ignored
+	if err := importCLADNet(archive, "cladnet-02", false); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ImportCLADNet() of a copy error = %v, want InvalidArgument", err)
+	}
+	if _, err := cbnetStore.GetSpec(ctx, "cladnet-02"); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("GetSpec() of the refused copy error = %v, want ErrNotFound", err)
+	}
+
+	if err := importCLADNet(archive, "cladnet-02", true); err != nil {
+		t.Fatalf("ImportCLADNet() of a copy ignoring the original error = %v", err)
+	}
+	peer, err := cbnetStore.GetPeer(ctx, "cladnet-02", "host-02")
+	if err != nil || peer.IP != "10.0.0.3" || peer.CladnetID != "cladnet-02" {
+		t.Errorf("GetPeer() of the copy = %+v, %v, want 10.0.0.3 preserved", peer, err)
+	}
+	if secret, err := cbnetStore.GetSecret(ctx, "cladnet-02", "host-01"); err != nil || secret.PublicKey != "key-of-host-01" {
+		t.Errorf("GetSecret() of the copy = %+v, %v", secret, err)
+	}
+
+	// The other CLADNets are still checked
+	if err := importCLADNet(archive, "cladnet-03", true); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ImportCLADNet() of another copy error = %v, want InvalidArgument (conflicts with cladnet-02)", err)
+	}
+
+	// The specification is validated by itself
+	for name, change := range map[string]func(spec *model.CLADNetSpecification){
+		"public address space": func(spec *model.CLADNetSpecification) { spec.Ipv4AddressSpace = "8.8.8.0/24" },
+		"unknown rule type": func(spec *model.CLADNetSpecification) {
+			spec.Ipv4AddressSpace, spec.RuleType = "10.9.0.0/24", "fastest"
+		},
+	} {
+		invalid := archive
+		change(&invalid.Specification)
+		if err := importCLADNet(invalid, "cladnet-04", true); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ImportCLADNet() of an archive having %v error = %v, want InvalidArgument", name, err)
+		}
+		if _, err := cbnetStore.GetSpec(ctx, "cladnet-04"); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("GetSpec() of the refused archive having %v error = %v, want ErrNotFound", name, err)
+		}
+	}
+}
+
+func TestImportCLADNetRollback(t *testing.T) {
+	ctx := context.Background()
+	archive := exportTestCLADNet(t)
+
+	// The peers are put, and then putting the networking rule of an invalid host ID fails
+	archive.NetworkingRules = append(archive.NetworkingRules, model.ArchivedNetworkingRule{HostID: "host/03"})
+	if err := importCLADNet(archive, "cladnet-02", true); status.Code(err) != codes.Internal {
+		t.Fatalf("ImportCLADNet() error = %v, want Internal", err)
+	}
+
+	// Nothing is left
+	if _, err := cbnetStore.GetSpec(ctx, "cladnet-02"); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("GetSpec() after the rollback error = %v, want ErrNotFound", err)
+	}
+	for _, family := range []string{etcdkey.Peer, etcdkey.NetworkingRule, etcdkey.Secret} {
+		prefix, _ := etcdkey.Prefix(family, "cladnet-02")
+		if kvs, err := cbnetStore.ListKeyValues(ctx, prefix); err != nil || len(kvs) != 0 {
+			t.Errorf("%v after the rollback = %+v, %v, want none", prefix, kvs, err)
+		}
+	}
+
+	// The original is intact
+	if peers, err := cbnetStore.ListPeers(ctx, "cladnet-01"); err != nil || len(peers) != 2 {
+		t.Errorf("ListPeers() of the original = %+v, %v, want 2 peers", peers, err)
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/manifest"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverCloudAdaptiveNetwork) ApplyCLADNet(ctx context.Context, req *pb.ApplyCLADNetRequest) (*pb.ApplyCLADNetResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	cladnetManifest, err := manifest.Parse([]byte(req.Manifest))
+	if err != nil {
+		return &pb.ApplyCLADNetResponse{}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	desired := cladnetManifest.Specification()
+	cladnetID := desired.CladnetID
+
+	// Acquire a lock not to race with the allocation of peers
+	unlock, err := cbnetStore.AcquireLock(ctx, store.PeerLock, cladnetID)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.ApplyCLADNetResponse{}, status.Errorf(codes.Internal, "error while acquiring a lock: %v", err)
+	}
+	defer unlock()
+
+	// Get the current state (nil if the CLADNet does not exist)
+	current, err := cbnetStore.GetSpec(ctx, cladnetID)
+	currentSpec := &current
+	if errors.Is(err, store.ErrNotFound) {
+		currentSpec = nil
+	} else if err != nil {
+		CBLogger.Error(err)
+		return &pb.ApplyCLADNetResponse{}, status.Errorf(codes.Internal, "error while getting a CLADNetSpecification: %v", err)
+	}
+
+	peers, err := cbnetStore.ListPeers(ctx, cladnetID)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.ApplyCLADNetResponse{}, status.Errorf(codes.Internal, "error while listing peers: %v", err)
+	}
+
+	others, err := cbnetStore.ListSpecs(ctx)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.ApplyCLADNetResponse{}, status.Errorf(codes.Internal, "error while listing CLADNetSpecifications: %v", err)
+	}
+
+	if err := validator.CheckConflicts(desired, others, peers); err != nil {
+		return &pb.ApplyCLADNetResponse{}, err
+	}
+
+	changes := manifest.Diff(currentSpec, desired, peers)
+	response := &pb.ApplyCLADNetResponse{CladnetId: cladnetID}
+	var unsafeReasons []string
+	for _, change := range changes {
+		response.Changes = append(response.Changes, &pb.CLADNetChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+			Unsafe:   change.Unsafe,
+			Reason:   change.Reason,
+		})
+		if change.Unsafe {
+			unsafeReasons = append(unsafeReasons, change.Field+": "+change.Reason)
+		}
+	}
+	CBLogger.Debugf("%d change(s) to apply to %v", len(changes), cladnetID)
+
+	if req.DryRun || (currentSpec != nil && len(changes) == 0) {
+		CBLogger.Debug("End.........")
+		return response, status.New(codes.OK, "").Err()
+	}
+
+	if len(unsafeReasons) > 0 && !req.Force {
+		return &pb.ApplyCLADNetResponse{}, status.Errorf(codes.FailedPrecondition,
+			"refused to apply the unsafe change(s) without force (%v)", strings.Join(unsafeReasons, "; "))
+	}
+
+	// A forced change of the address space under the peers re-addresses them (see ReaddressCLADNet)
+	if currentSpec != nil && len(peers) > 0 && current.Ipv4AddressSpace != desired.Ipv4AddressSpace {
+		CBLogger.Debugf("Re-address a CLADNet - %v (%v -> %v)", cladnetID, current.Ipv4AddressSpace, desired.Ipv4AddressSpace)
+		if _, err := readdressCLADNet(ctx, current, desired, defaultReaddressingOverlap, false); err != nil {
+			return &pb.ApplyCLADNetResponse{}, err
+		}
+		response.Applied = true
+
+		CBLogger.Debug("End.........")
+		return response, status.New(codes.OK, "").Err()
+	}
+
+	CBLogger.Tracef("Value: %#v", desired)
+	CBLogger.Debugf("Put a CLADNet specification - %v", cladnetID)
+	if err := cbnetStore.PutSpec(ctx, desired); err != nil {
+		CBLogger.Error(err)
+		return &pb.ApplyCLADNetResponse{}, status.Errorf(codes.Internal, "error while putting CLADNetSpecification: %v", err)
+	}
+	response.Applied = true
+
+	CBLogger.Debug("End.........")
+	return response, status.New(codes.OK, "").Err()
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	netstate "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestApplyCLADNetForcedReaddressing(t *testing.T) {
+	ctx := context.Background()
+	setUpStore(t)
+	server := &serverCloudAdaptiveNetwork{}
+
+	peer := model.Peer{CladnetID: "cladnet-01", HostID: "host-01", IP: "10.0.0.2", IPv4CIDR: "10.0.0.2/24", State: netstate.Tunneling}
+	if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := `apiVersion: cb-network/v1
+kind: CLADNet
+metadata:
+  cladnetId: cladnet-01
+  name: renamed
+spec:
+  ipv4AddressSpace: 10.1.0.0/24
+`
+	if _, err := server.ApplyCLADNet(ctx, &pb.ApplyCLADNetRequest{Manifest: manifest}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ApplyCLADNet() without force error = %v, want FailedPrecondition", err)
+	}
+
+	response, err := server.ApplyCLADNet(ctx, &pb.ApplyCLADNetRequest{Manifest: manifest, Force: true})
+	if err != nil || !response.Applied {
+		t.Fatalf("ApplyCLADNet() with force = %+v, %v", response, err)
+	}
+
+	// The peer is re-addressed, not left in the previous address space
+	spec, err := cbnetStore.GetSpec(ctx, "cladnet-01")
+	if err != nil || spec.Ipv4AddressSpace != "10.1.0.0/24" || spec.Name != "renamed" {
+		t.Errorf("GetSpec() = %+v, %v, want renamed with 10.1.0.0/24", spec, err)
+	}
+	peer, err = cbnetStore.GetPeer(ctx, "cladnet-01", "host-01")
+	if err != nil || peer.IPv4CIDR != "10.1.0.2/24" || peer.PreviousIPv4CIDR != "10.0.0.2/24" {
+		t.Errorf("GetPeer() = %+v, %v, want 10.1.0.2/24 re-addressed from 10.0.0.2/24", peer, err)
+	}
+	readdressing, err := cbnetStore.GetReaddressing(ctx, "cladnet-01")
+	if err != nil || readdressing.NewIpv4AddressSpace != "10.1.0.0/24" || len(readdressing.Peers) != 1 {
+		t.Errorf("GetReaddressing() = %+v, %v", readdressing, err)
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getTargetPeers gets a peer (or all peers if the host ID is empty) in a Cloud Adaptive Network to be controlled.
+func getTargetPeers(ctx context.Context, cladnetID string, hostID string) ([]model.Peer, error) {
+	if cladnetID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cladnetId is required")
+	}
+
+	var peers []model.Peer
+	var err error
+	if hostID != "" {
+		CBLogger.Debugf("Get a peer - %v/%v", cladnetID, hostID)
+		var peer model.Peer
+		peer, err = cbnetStore.GetPeer(ctx, cladnetID, hostID)
+		if err == nil {
+			peers = append(peers, peer)
+		}
+	} else {
+		CBLogger.Debugf("Get peers - %v", cladnetID)
+		peers, err = cbnetStore.ListPeers(ctx, cladnetID)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		CBLogger.Error(err)
+		return nil, status.Errorf(codes.Internal, "error while getting peers: %v", err)
+	}
+
+	if len(peers) == 0 {
+		return nil, status.Errorf(codes.NotFound, "not found peers by cladnetId (%+v) and hostId (%+v)", cladnetID, hostID)
+	}
+	return peers, nil
+}
+
+func (s *serverSystemManagement) SetLogLevel(ctx context.Context, req *pb.LogLevelRequest) (*pb.ControlResponse, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	controlResponse := &pb.ControlResponse{
+		IsSucceeded: false,
+		Message:     "",
+	}
+
+	level, err := logrus.ParseLevel(req.Level)
+	if err != nil {
+		controlResponse.Message = err.Error()
+		return controlResponse, status.Errorf(codes.InvalidArgument, "invalid level: %v", err)
+	}
+
+	switch req.Component {
+	case pb.Component_SERVICE:
+		CBLogger.Infof("Change the log level to %v", level)
+		CBLogger.SetLevel(level)
+
+	case pb.Component_CONTROLLER:
+		// The controllers watch the log level on the etcd
+		CBLogger.Debugf("Put the log level of the controllers - %v", level)
+		if err := cbnetStore.PutLogLevel(ctx, etcdkey.ControllerComponent, level.String()); err != nil {
+			CBLogger.Error(err)
+			controlResponse.Message = fmt.Sprintf("error while putting the log level: %v", err)
+			return controlResponse, status.Error(codes.Internal, controlResponse.Message)
+		}
+
+	case pb.Component_AGENT:
+		peers, err := getTargetPeers(ctx, req.CladnetId, req.HostId)
+		if err != nil {
+			controlResponse.Message = err.Error()
+			return controlResponse, err
+		}
+
+		for _, peer := range peers {
+			CBLogger.Debugf("Put a control command - %v/%v", peer.CladnetID, peer.HostID)
+			err := cbnetStore.PutControlCommandWithParameters(ctx, peer.CladnetID, peer.HostID,
+				cmdtype.SetLogLevel, map[string]string{cmdtype.LogLevel: level.String()})
+			if err != nil {
+				CBLogger.Error(err)
+				controlResponse.Message = fmt.Sprintf("error while putting the command: %v", err)
+				return controlResponse, status.Error(codes.Internal, controlResponse.Message)
+			}
+		}
+
+	default:
+		controlResponse.Message = fmt.Sprintf("unknown component (%v)", req.Component)
+		return controlResponse, status.Error(codes.InvalidArgument, controlResponse.Message)
+	}
+
+	controlResponse.IsSucceeded = true
+	controlResponse.Message = fmt.Sprintf("The log level (%v) successfully changed.", level)
+
+	return controlResponse, status.New(codes.OK, "").Err()
+}
+
+func (s *serverSystemManagement) TracePackets(ctx context.Context, req *pb.PacketTraceRequest) (*pb.ControlResponse, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	controlResponse := &pb.ControlResponse{
+		IsSucceeded: false,
+		Message:     "",
+	}
+
+	duration := time.Duration(req.Duration) * time.Second
+	if duration < 0 || duration > cmdtype.MaxPacketTraceDuration {
+		controlResponse.Message = fmt.Sprintf("duration (%v) must be between 0 and %v seconds", req.Duration, cmdtype.MaxPacketTraceDuration.Seconds())
+		return controlResponse, status.Error(codes.InvalidArgument, controlResponse.Message)
+	}
+	if duration == 0 {
+		duration = cmdtype.DefaultPacketTraceDuration
+	}
+
+	peers, err := getTargetPeers(ctx, req.CladnetId, req.HostId)
+	if err != nil {
+		controlResponse.Message = err.Error()
+		return controlResponse, err
+	}
+
+	parameters := map[string]string{
+		cmdtype.Peer:     req.Peer,
+		cmdtype.Duration: strconv.FormatInt(int64(duration.Seconds()), 10),
+	}
+	for _, peer := range peers {
+		CBLogger.Debugf("Put a control command - %v/%v", peer.CladnetID, peer.HostID)
+		err := cbnetStore.PutControlCommandWithParameters(ctx, peer.CladnetID, peer.HostID, cmdtype.TracePackets, parameters)
+		if err != nil {
+			CBLogger.Error(err)
+			controlResponse.Message = fmt.Sprintf("error while putting the command: %v", err)
+			return controlResponse, status.Error(codes.Internal, controlResponse.Message)
+		}
+	}
+
+	controlResponse.IsSucceeded = true
+	controlResponse.Message = fmt.Sprintf("The packet tracing successfully requested for %v.", duration)
+
+	return controlResponse, status.New(codes.OK, "").Err()
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// rpcDuration represents the latency of the RPCs by method and status code
+var rpcDuration = metrics.NewHistogramVec("cbnet_service_rpc_duration_seconds",
+	"Latency (seconds) of the RPCs handled by the service.", metrics.DefaultBuckets, "method", "code")
+
+// unaryMetricsInterceptor observes the latency of each unary RPC
+func unaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	rpcDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).ObserveSince(start)
+	return resp, err
+}
+
+// streamMetricsInterceptor observes the duration of each streaming RPC
+func streamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	rpcDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).ObserveSince(start)
+	return err
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	capturefilter "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/capture-filter"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/rs/xid"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// packetCaptureTTL is the time to live (sec) of a packet capture and its pcapng files
+const packetCaptureTTL = int64(24 * 60 * 60)
+
+// pcapngContentType is the media type of the pcapng files
+const pcapngContentType = "application/x-pcapng"
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func packetCaptureToPB(capture model.PacketCapture) *pb.PacketCapture {
+	return &pb.PacketCapture{
+		CladnetId:    capture.CladnetID,
+		HostId:       capture.HostID,
+		CaptureId:    capture.CaptureID,
+		Filter:       capture.Filter,
+		Duration:     capture.Duration,
+		MaxBytes:     int64(capture.MaxBytes),
+		State:        capture.State,
+		Message:      capture.Message,
+		InnerPackets: int64(capture.InnerPackets),
+		OuterPackets: int64(capture.OuterPackets),
+		InnerBytes:   int64(capture.InnerBytes),
+		OuterBytes:   int64(capture.OuterBytes),
+		RequestedAt:  formatTime(capture.RequestedAt),
+		StartedAt:    formatTime(capture.StartedAt),
+		StoppedAt:    formatTime(capture.StoppedAt),
+	}
+}
+
+func (s *serverSystemManagement) CapturePackets(ctx context.Context, req *pb.PacketCaptureRequest) (*pb.PacketCaptures, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	duration := time.Duration(req.Duration) * time.Second
+	if duration < 0 || duration > cmdtype.MaxPacketCaptureDuration {
+		return &pb.PacketCaptures{}, status.Errorf(codes.InvalidArgument, "duration (%v) must be between 0 and %v seconds",
+			req.Duration, cmdtype.MaxPacketCaptureDuration.Seconds())
+	}
+	if duration == 0 {
+		duration = cmdtype.DefaultPacketCaptureDuration
+	}
+
+	maxBytes := int(req.MaxBytes)
+	if maxBytes < 0 || maxBytes > cmdtype.MaxPacketCaptureBytes {
+		return &pb.PacketCaptures{}, status.Errorf(codes.InvalidArgument, "maxBytes (%v) must be between 0 and %v",
+			req.MaxBytes, cmdtype.MaxPacketCaptureBytes)
+	}
+	if maxBytes == 0 {
+		maxBytes = cmdtype.DefaultPacketCaptureBytes
+	}
+
+	// Validate the filter here, so that the agents don't fail to start the capture
+	filter, err := capturefilter.Parse(req.Filter)
+	if err != nil {
+		return &pb.PacketCaptures{}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	peers, err := getTargetPeers(ctx, req.CladnetId, req.HostId)
+	if err != nil {
+		return &pb.PacketCaptures{}, err
+	}
+
+	// The agents share the capture ID, which is distinguished by the host ID
+	captureID := xid.New().String()
+	parameters := map[string]string{
+		cmdtype.CaptureID: captureID,
+		cmdtype.Filter:    filter.String(),
+		cmdtype.Duration:  strconv.FormatInt(int64(duration.Seconds()), 10),
+		cmdtype.MaxBytes:  strconv.Itoa(maxBytes),
+	}
+
+	captures := &pb.PacketCaptures{}
+	for _, peer := range peers {
+		capture := model.PacketCapture{
+			CladnetID:   peer.CladnetID,
+			HostID:      peer.HostID,
+			CaptureID:   captureID,
+			Filter:      filter.String(),
+			Duration:    int64(duration.Seconds()),
+			MaxBytes:    maxBytes,
+			State:       model.CaptureRequested,
+			RequestedAt: time.Now(),
+		}
+
+		CBLogger.Debugf("Put a packet capture - %v/%v/%v", peer.CladnetID, peer.HostID, captureID)
+		err := cbnetStore.PutPacketCapture(ctx, capture, time.Duration(packetCaptureTTL)*time.Second)
+		if err != nil {
+			CBLogger.Error(err)
+			return captures, status.Errorf(codes.Internal, "error while putting the packet capture: %v", err)
+		}
+
+		CBLogger.Debugf("Put a control command - %v/%v", peer.CladnetID, peer.HostID)
+		err = cbnetStore.PutControlCommandWithParameters(ctx, peer.CladnetID, peer.HostID, cmdtype.CapturePackets, parameters)
+		if err != nil {
+			CBLogger.Error(err)
+			return captures, status.Errorf(codes.Internal, "error while putting the command: %v", err)
+		}
+
+		captures.Captures = append(captures.Captures, packetCaptureToPB(capture))
+	}
+
+	return captures, status.New(codes.OK, "").Err()
+}
+
+func (s *serverSystemManagement) GetPacketCaptureList(ctx context.Context, req *pb.CLADNetRequest) (*pb.PacketCaptures, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	if req.CladnetId == "" {
+		return &pb.PacketCaptures{}, status.Errorf(codes.InvalidArgument, "cladnetId is required")
+	}
+
+	CBLogger.Debugf("Get the packet captures - %v", req.CladnetId)
+	captureList, err := cbnetStore.ListPacketCaptures(ctx, req.CladnetId)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.PacketCaptures{}, status.Errorf(codes.Internal, "error while listing the packet captures: %v", err)
+	}
+
+	// The latest first
+	sort.Slice(captureList, func(i, j int) bool {
+		if !captureList[i].RequestedAt.Equal(captureList[j].RequestedAt) {
+			return captureList[i].RequestedAt.After(captureList[j].RequestedAt)
+		}
+		return captureList[i].HostID < captureList[j].HostID
+	})
+
+	captures := &pb.PacketCaptures{}
+	for _, capture := range captureList {
+		captures.Captures = append(captures.Captures, packetCaptureToPB(capture))
+	}
+
+	return captures, status.New(codes.OK, "").Err()
+}
+
+func (s *serverSystemManagement) GetPacketCaptureFile(ctx context.Context, req *pb.PacketCaptureFileRequest) (*httpbody.HttpBody, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	if req.CladnetId == "" || req.CaptureId == "" || req.HostId == "" {
+		return &httpbody.HttpBody{}, status.Errorf(codes.InvalidArgument, "cladnetId (%+v), captureId (%+v) and hostId (%+v) are required",
+			req.CladnetId, req.CaptureId, req.HostId)
+	}
+
+	layer := model.CaptureInner
+	if req.Layer == pb.CaptureLayer_OUTER {
+		layer = model.CaptureOuter
+	}
+
+	CBLogger.Debugf("Get a pcapng file - %v/%v/%v/%v", req.CladnetId, req.HostId, req.CaptureId, layer)
+	file, err := cbnetStore.GetPacketCaptureFile(ctx, req.CladnetId, req.HostId, req.CaptureId, layer)
+	if errors.Is(err, store.ErrNotFound) {
+		message := fmt.Sprintf("not found the pcapng file by cladnetId (%+v), captureId (%+v), hostId (%+v) and layer (%+v)",
+			req.CladnetId, req.CaptureId, req.HostId, layer)
+		if capture, errCapture := cbnetStore.GetPacketCapture(ctx, req.CladnetId, req.HostId, req.CaptureId); errCapture == nil {
+			message = fmt.Sprintf("%s (the packet capture is %v)", message, capture.State)
+		}
+		return &httpbody.HttpBody{}, status.Error(codes.NotFound, message)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return &httpbody.HttpBody{}, status.Errorf(codes.Internal, "error while getting the pcapng file: %v", err)
+	}
+
+	return &httpbody.HttpBody{ContentType: pcapngContentType, Data: file}, status.New(codes.OK, "").Err()
+}
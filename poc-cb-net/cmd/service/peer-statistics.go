@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func trafficCountersToPB(counters model.TrafficCounters) *pb.TrafficCounters {
+	return &pb.TrafficCounters{
+		TxPackets:     counters.TxPackets,
+		TxBytes:       counters.TxBytes,
+		RxPackets:     counters.RxPackets,
+		RxBytes:       counters.RxBytes,
+		Drops:         counters.Drops,
+		EncryptErrors: counters.EncryptErrors,
+		DecryptErrors: counters.DecryptErrors,
+	}
+}
+
+func peerStatisticsToPB(statistics model.PeerStatistics) *pb.PeerStatistics {
+	peerStatistics := &pb.PeerStatistics{
+		CladnetId: statistics.CladnetID,
+		HostId:    statistics.HostID,
+		Peers:     make(map[string]*pb.TrafficCounters),
+		Drops:     statistics.Drops,
+		Total:     trafficCountersToPB(statistics.Total),
+		Timestamp: statistics.Timestamp.Format(time.RFC3339),
+	}
+	for hostID, counters := range statistics.Peers {
+		peerStatistics.Peers[hostID] = trafficCountersToPB(counters)
+	}
+	return peerStatistics
+}
+
+func (s *serverCloudAdaptiveNetwork) GetPeerStatistics(ctx context.Context, req *pb.PeerRequest) (*pb.PeerStatistics, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	// Get the traffic statistics published by the peer's agent
+	CBLogger.Debugf("Get peer statistics - %v/%v", req.CladnetId, req.HostId)
+	statistics, errStore := cbnetStore.GetPeerStatistics(ctx, req.CladnetId, req.HostId)
+	if errors.Is(errStore, store.ErrNotFound) {
+		return &pb.PeerStatistics{}, status.Errorf(codes.NotFound, "not found the peer's statistics by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+	}
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.PeerStatistics{}, status.Errorf(codes.Internal, "error while getting a peer's statistics: %v", errStore)
+	}
+	CBLogger.Tracef("PeerStatistics: %v", statistics)
+
+	return peerStatisticsToPB(statistics), status.New(codes.OK, "").Err()
+}
+
+func (s *serverCloudAdaptiveNetwork) GetCLADNetStatistics(ctx context.Context, req *pb.CLADNetRequest) (*pb.CLADNetStatistics, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	// Check if the Cloud Adaptive Network exists or not
+	if _, err := s.GetCLADNet(ctx, req); err != nil {
+		return &pb.CLADNetStatistics{}, err
+	}
+
+	// Get the traffic statistics of all peers in the CLADNet
+	CBLogger.Debugf("Get the statistics of the peers - %v", req.CladnetId)
+	statisticsList, errStore := cbnetStore.ListPeerStatistics(ctx, req.CladnetId)
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.CLADNetStatistics{}, status.Errorf(codes.Internal, "error while listing the peers' statistics: %v", errStore)
+	}
+
+	statistics := model.NewCLADNetStatistics(req.CladnetId, statisticsList)
+	sort.Slice(statistics.Peers, func(i, j int) bool {
+		return statistics.Peers[i].HostID < statistics.Peers[j].HostID
+	})
+
+	cladnetStatistics := &pb.CLADNetStatistics{
+		CladnetId: statistics.CladnetID,
+		Drops:     statistics.Drops,
+		Total:     trafficCountersToPB(statistics.Total),
+	}
+	for _, peerStatistics := range statistics.Peers {
+		cladnetStatistics.Peers = append(cladnetStatistics.Peers, peerStatisticsToPB(peerStatistics))
+	}
+
+	return cladnetStatistics, status.New(codes.OK, "").Err()
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	nethelper "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-helper"
+	netstate "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-state"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultReaddressingOverlap is the default time (sec) to keep the previous IP address on each peer while re-addressing
+const defaultReaddressingOverlap = int64(30)
+
+// readdressingTimeout is the time (after the overlap) to wait for the agents to complete re-addressing,
+// after which another re-addressing is allowed (e.g., if an agent has been down)
+const readdressingTimeout = 5 * time.Minute
+
+func readdressingToPB(readdressing model.CLADNetReaddressing) *pb.ReaddressingStatus {
+	readdressingStatus := &pb.ReaddressingStatus{
+		CladnetId:           readdressing.CladnetID,
+		OldIpv4AddressSpace: readdressing.OldIpv4AddressSpace,
+		NewIpv4AddressSpace: readdressing.NewIpv4AddressSpace,
+		IsCompleted:         readdressing.IsCompleted(),
+	}
+	for _, peer := range readdressing.Peers {
+		readdressingStatus.Peers = append(readdressingStatus.Peers, &pb.PeerReaddressing{
+			HostId:      peer.HostID,
+			OldIpv4Cidr: peer.OldIPv4CIDR,
+			NewIpv4Cidr: peer.NewIPv4CIDR,
+			State:       peer.State,
+			Message:     peer.Message,
+		})
+	}
+	return readdressingStatus
+}
+
+func (s *serverCloudAdaptiveNetwork) ReaddressCLADNet(ctx context.Context, req *pb.ReaddressRequest) (*pb.ReaddressingStatus, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := validator.CheckIPv4AddressSpace("ipv4AddressSpace", req.Ipv4AddressSpace); err != nil {
+		return &pb.ReaddressingStatus{}, err
+	}
+	_, newNet, _ := net.ParseCIDR(req.Ipv4AddressSpace)
+	newIpv4AddressSpace := newNet.String()
+
+	overlap := req.OverlapSeconds
+	if overlap <= 0 {
+		overlap = defaultReaddressingOverlap
+	}
+
+	// Acquire a lock (or wait to have it) not to race with the allocation and the updates of peers
+	CBLogger.Debug("Acquire a lock")
+	unlock, err := cbnetStore.AcquireLock(ctx, store.PeerLock, req.CladnetId)
+	if err != nil {
+		CBLogger.Errorf("Could NOT acquire lock for '%v', error: %v", req.CladnetId, err)
+		return &pb.ReaddressingStatus{}, status.Errorf(codes.Internal, "error while acquiring a lock: %v", err)
+	}
+	defer unlock()
+
+	CBLogger.Debugf("Get a CLADNet specification - %v", req.CladnetId)
+	spec, err := cbnetStore.GetSpec(ctx, req.CladnetId)
+	if errors.Is(err, store.ErrNotFound) {
+		return &pb.ReaddressingStatus{}, status.Errorf(codes.NotFound, "could not find a CLADNet by %v", req.CladnetId)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.ReaddressingStatus{}, status.Errorf(codes.Internal, "error while getting a CLADNetSpecification: %v", err)
+	}
+
+	if spec.Ipv4AddressSpace == newIpv4AddressSpace {
+		return &pb.ReaddressingStatus{}, status.Errorf(codes.InvalidArgument, "the CLADNet already has %v", newIpv4AddressSpace)
+	}
+
+	// Map the IP addresses of the reservations to the new address space
+	newSpec := spec
+	newSpec.Ipv4AddressSpace = newIpv4AddressSpace
+	newSpec.Reservations = make([]model.IPReservation, 0, len(spec.Reservations))
+	for _, reservation := range spec.Reservations {
+		newIP, _, err := nethelper.ReaddressIPv4(reservation.IP, spec.Ipv4AddressSpace, newIpv4AddressSpace)
+		if err != nil {
+			return &pb.ReaddressingStatus{}, status.Errorf(codes.FailedPrecondition,
+				"the new address space does not fit the reservation (%v): %v", reservation.HostID, err)
+		}
+		reservation.IP = newIP
+		newSpec.Reservations = append(newSpec.Reservations, reservation)
+	}
+
+	readdressing, err := readdressCLADNet(ctx, spec, newSpec, overlap, req.DryRun)
+	if err != nil {
+		return &pb.ReaddressingStatus{}, err
+	}
+
+	CBLogger.Debug("End.........")
+	return readdressingToPB(readdressing), status.New(codes.OK, "").Err()
+}
+
+// readdressCLADNet changes the specification of a CLADNet to the new one having another IPv4 address space,
+// and maps the IP addresses of the peers to the new address space, which are re-addressed by the agents.
+// It must be called under the lock of the peers of the CLADNet.
+func readdressCLADNet(ctx context.Context, spec model.CLADNetSpecification, newSpec model.CLADNetSpecification,
+	overlap int64, dryRun bool) (model.CLADNetReaddressing, error) {
+
+	// Only one re-addressing at a time
+	prevReaddressing, err := cbnetStore.GetReaddressing(ctx, spec.CladnetID)
+	if err == nil && !prevReaddressing.IsCompleted() &&
+		time.Since(prevReaddressing.StartedAt) < time.Duration(prevReaddressing.OverlapSeconds)*time.Second+readdressingTimeout {
+		return model.CLADNetReaddressing{}, status.Errorf(codes.FailedPrecondition,
+			"re-addressing to %v is in progress", prevReaddressing.NewIpv4AddressSpace)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		CBLogger.Error(err)
+		return model.CLADNetReaddressing{}, status.Errorf(codes.Internal, "error while getting a re-addressing: %v", err)
+	}
+
+	peers, err := cbnetStore.ListPeers(ctx, spec.CladnetID)
+	if err != nil {
+		CBLogger.Error(err)
+		return model.CLADNetReaddressing{}, status.Errorf(codes.Internal, "error while listing peers: %v", err)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].HostID < peers[j].HostID })
+
+	// The new address space must not conflict with the other CLADNets and the underlay networks
+	others, err := cbnetStore.ListSpecs(ctx)
+	if err != nil {
+		CBLogger.Error(err)
+		return model.CLADNetReaddressing{}, status.Errorf(codes.Internal, "error while listing CLADNetSpecifications: %v", err)
+	}
+	if err := validator.CheckConflicts(newSpec, others, peers); err != nil {
+		return model.CLADNetReaddressing{}, err
+	}
+
+	reservedHostIDs := make(map[string]string)
+	for _, reservation := range newSpec.Reservations {
+		reservedHostIDs[reservation.IP] = reservation.HostID
+	}
+
+	// Map the IP addresses of the peers to the new address space
+	now := time.Now()
+	readdressing := model.CLADNetReaddressing{
+		CladnetID:           spec.CladnetID,
+		OldIpv4AddressSpace: spec.Ipv4AddressSpace,
+		NewIpv4AddressSpace: newSpec.Ipv4AddressSpace,
+		OverlapSeconds:      overlap,
+		StartedAt:           now,
+	}
+	newPeers := make([]model.Peer, 0, len(peers))
+	for _, peer := range peers {
+		newIP, newIPv4CIDR, err := nethelper.ReaddressIPv4(peer.IP, spec.Ipv4AddressSpace, newSpec.Ipv4AddressSpace)
+		if err != nil {
+			return model.CLADNetReaddressing{}, status.Errorf(codes.FailedPrecondition,
+				"the new address space does not fit the peer (%v): %v", peer.HostID, err)
+		}
+		if hostID, ok := reservedHostIDs[newIP]; ok && hostID != peer.HostID {
+			return model.CLADNetReaddressing{}, status.Errorf(codes.FailedPrecondition,
+				"the new IP address (%v) of the peer (%v) is reserved for another host (%v)", newIP, peer.HostID, hostID)
+		}
+
+		// A peer which has not configured the interface yet will configure it with the new IP address
+		state := model.ReaddressingAssigned
+		if peer.State != netstate.Tunneling {
+			state = model.ReaddressingCompleted
+		} else {
+			peer.PreviousIPv4CIDR = peer.IPv4CIDR
+			peer.PreviousIPv4CIDROverlap = overlap
+		}
+
+		readdressing.Peers = append(readdressing.Peers, model.PeerReaddressing{
+			HostID:      peer.HostID,
+			OldIPv4CIDR: peer.IPv4CIDR,
+			NewIPv4CIDR: newIPv4CIDR,
+			State:       state,
+			UpdatedAt:   now,
+		})
+
+		peer.IP = newIP
+		peer.IPv4CIDR = newIPv4CIDR
+		newPeers = append(newPeers, peer)
+	}
+
+	if dryRun {
+		return readdressing, nil
+	}
+
+	// Put the specification and the progress first, and then the peers to be re-addressed by the agents.
+	// The values put are undone on a failure, so that the CLADNet is not left half re-addressed.
+	// NOTE - They are not put in a transaction, which is limited in the number of operations (i.e., of the peers).
+	CBLogger.Debugf("Put a CLADNet specification - %v", newSpec.CladnetID)
+	if err := cbnetStore.PutSpec(ctx, newSpec); err != nil {
+		CBLogger.Error(err)
+		return model.CLADNetReaddressing{}, status.Errorf(codes.Internal, "error while putting CLADNetSpecification: %v", err)
+	}
+
+	CBLogger.Debugf("Put a re-addressing - %v", newSpec.CladnetID)
+	if err := cbnetStore.PutReaddressing(ctx, readdressing); err != nil {
+		CBLogger.Error(err)
+		undoReaddressing(spec, prevReaddressing, nil)
+		return model.CLADNetReaddressing{}, status.Errorf(codes.Internal, "error while putting a re-addressing: %v (undone)", err)
+	}
+
+	for i, peer := range newPeers {
+		CBLogger.Debugf("Put a peer - %v/%v (%v)", peer.CladnetID, peer.HostID, peer.IPv4CIDR)
+		if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+			CBLogger.Error(err)
+			undoReaddressing(spec, prevReaddressing, peers[:i])
+			return model.CLADNetReaddressing{}, status.Errorf(codes.Internal, "error while putting a peer: %v (undone)", err)
+		}
+	}
+
+	return readdressing, nil
+}
+
+// undoReaddressing puts back the specification, the previous re-addressing (if any), and the peers already re-addressed.
+// It is called under the lock of the peers, so the values have not been changed by the others in the meantime.
+func undoReaddressing(oldSpec model.CLADNetSpecification, prevReaddressing model.CLADNetReaddressing, oldPeers []model.Peer) {
+	// Undo even if the request has been canceled
+	ctx := context.Background()
+
+	for _, peer := range oldPeers {
+		CBLogger.Debugf("Put back a peer - %v/%v (%v)", peer.CladnetID, peer.HostID, peer.IPv4CIDR)
+		if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+			CBLogger.Errorf("could not undo the re-addressing of the peer (%v/%v): %v", peer.CladnetID, peer.HostID, err)
+		}
+	}
+
+	var err error
+	if prevReaddressing.CladnetID != "" {
+		err = cbnetStore.PutReaddressing(ctx, prevReaddressing)
+	} else {
+		err = cbnetStore.DeleteReaddressing(ctx, oldSpec.CladnetID)
+	}
+	if err != nil {
+		CBLogger.Errorf("could not undo the re-addressing of the CLADNet (%v): %v", oldSpec.CladnetID, err)
+	}
+
+	CBLogger.Debugf("Put back a CLADNet specification - %v (%v)", oldSpec.CladnetID, oldSpec.Ipv4AddressSpace)
+	if err := cbnetStore.PutSpec(ctx, oldSpec); err != nil {
+		CBLogger.Errorf("could not undo the specification of the CLADNet (%v): %v", oldSpec.CladnetID, err)
+	}
+}
+
+func (s *serverCloudAdaptiveNetwork) GetReaddressingStatus(ctx context.Context, req *pb.CLADNetRequest) (*pb.ReaddressingStatus, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	readdressing, err := cbnetStore.GetReaddressing(ctx, req.CladnetId)
+	if errors.Is(err, store.ErrNotFound) {
+		return &pb.ReaddressingStatus{}, status.Errorf(codes.NotFound, "no re-addressing of the CLADNet (%v)", req.CladnetId)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.ReaddressingStatus{}, status.Errorf(codes.Internal, "error while getting a re-addressing: %v", err)
+	}
+
+	return readdressingToPB(readdressing), status.New(codes.OK, "").Err()
+}
+
+func (s *serverAgent) UpdateReaddressingState(ctx context.Context, req *pb.AgentReaddressingState) (*pb.AgentResponse, error) {
+	CBLogger.Debug("Start.........")
+
+	if err := authorizeAgentRequest(ctx, req.CladnetId, req.HostId); err != nil {
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()}, err
+	}
+
+	// Acquire a lock (or wait to have it) to update the progress and the peer
+	CBLogger.Debug("Acquire a lock")
+	unlock, err := cbnetStore.AcquireLock(ctx, store.PeerLock, req.CladnetId)
+	if err != nil {
+		CBLogger.Errorf("Could NOT acquire lock for '%v', error: %v", req.CladnetId, err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while acquiring a lock: %v", err)
+	}
+	defer unlock()
+
+	readdressing, err := cbnetStore.GetReaddressing(ctx, req.CladnetId)
+	if errors.Is(err, store.ErrNotFound) {
+		return &pb.AgentResponse{IsSucceeded: false, Message: "no re-addressing"},
+			status.Errorf(codes.NotFound, "no re-addressing of the CLADNet (%v)", req.CladnetId)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while getting a re-addressing: %v", err)
+	}
+
+	if !readdressing.UpdatePeerState(req.HostId, req.State, req.Message, time.Now()) {
+		return &pb.AgentResponse{IsSucceeded: false, Message: "not being re-addressed"},
+			status.Errorf(codes.NotFound, "the peer (%v) is not being re-addressed", req.HostId)
+	}
+
+	CBLogger.Debugf("Put a re-addressing - %v (%v: %v)", req.CladnetId, req.HostId, req.State)
+	if err := cbnetStore.PutReaddressing(ctx, readdressing); err != nil {
+		CBLogger.Error(err)
+		return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+			status.Errorf(codes.Internal, "error while putting a re-addressing: %v", err)
+	}
+
+	// The previous IP address is not needed anymore
+	if req.State == model.ReaddressingCompleted {
+		peer, err := cbnetStore.GetPeer(ctx, req.CladnetId, req.HostId)
+		if err != nil {
+			CBLogger.Error(err)
+			return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+				status.Errorf(codes.Internal, "error while getting a peer: %v", err)
+		}
+		peer.PreviousIPv4CIDR = ""
+		peer.PreviousIPv4CIDROverlap = 0
+
+		CBLogger.Debugf("Put a peer - %v/%v", req.CladnetId, req.HostId)
+		if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+			CBLogger.Error(err)
+			return &pb.AgentResponse{IsSucceeded: false, Message: err.Error()},
+				status.Errorf(codes.Internal, "error while putting a peer: %v", err)
+		}
+	}
+
+	CBLogger.Debug("End.........")
+	return &pb.AgentResponse{IsSucceeded: true, Message: ""}, status.New(codes.OK, "").Err()
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	netstate "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-state"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errInjected is a failure injected into a store
+var errInjected = errors.New("injected failure")
+
+// failingStore fails on the n-th call of PutPeer (counted from 1)
+type failingStore struct {
+	store.Store
+	failAt int
+	calls  int
+}
+
+func (s *failingStore) PutPeer(ctx context.Context, peer model.Peer) error {
+	s.calls++
+	if s.calls == s.failAt {
+		return errInjected
+	}
+	return s.Store.PutPeer(ctx, peer)
+}
+
+func TestReaddressCLADNetUndo(t *testing.T) {
+	ctx := context.Background()
+	setUpStore(t)
+
+	spec, _ := cbnetStore.GetSpec(ctx, "cladnet-01")
+	spec.Reservations = []model.IPReservation{{HostID: "host-04", IP: "10.0.0.100"}}
+	if err := cbnetStore.PutSpec(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+	oldPeers := map[string]model.Peer{}
+	for _, peer := range []model.Peer{
+		{CladnetID: "cladnet-01", HostID: "host-01", IP: "10.0.0.2", IPv4CIDR: "10.0.0.2/24", State: netstate.Tunneling},
+		{CladnetID: "cladnet-01", HostID: "host-02", IP: "10.0.0.3", IPv4CIDR: "10.0.0.3/24", State: netstate.Tunneling},
+		{CladnetID: "cladnet-01", HostID: "host-03", IP: "10.0.0.4", IPv4CIDR: "10.0.0.4/24", State: netstate.Tunneling},
+	} {
+		if err := cbnetStore.PutPeer(ctx, peer); err != nil {
+			t.Fatal(err)
+		}
+		oldPeers[peer.HostID] = peer
+	}
+
+	// Putting the second peer fails, so the first one has been re-addressed
+	memoryStore := cbnetStore
+	cbnetStore = &failingStore{Store: memoryStore, failAt: 2}
+	req := &pb.ReaddressRequest{CladnetId: "cladnet-01", Ipv4AddressSpace: "10.1.0.0/24"}
+	if _, err := (&serverCloudAdaptiveNetwork{}).ReaddressCLADNet(ctx, req); status.Code(err) != codes.Internal {
+		t.Fatalf("ReaddressCLADNet() error = %v, want Internal", err)
+	}
+	cbnetStore = memoryStore
+
+	// Everything is undone
+	spec, err := cbnetStore.GetSpec(ctx, "cladnet-01")
+	if err != nil || spec.Ipv4AddressSpace != "10.0.0.0/24" || spec.Reservations[0].IP != "10.0.0.100" {
+		t.Errorf("GetSpec() after the undo = %+v, %v, want 10.0.0.0/24 with 10.0.0.100 reserved", spec, err)
+	}
+	if _, err := cbnetStore.GetReaddressing(ctx, "cladnet-01"); !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("GetReaddressing() after the undo error = %v, want ErrNotFound", err)
+	}
+	for hostID, want := range oldPeers {
+		peer, err := cbnetStore.GetPeer(ctx, "cladnet-01", hostID)
+		if err != nil || peer.IPv4CIDR != want.IPv4CIDR || peer.PreviousIPv4CIDR != "" {
+			t.Errorf("GetPeer(%v) after the undo = %+v, %v, want %v", hostID, peer, err, want.IPv4CIDR)
+		}
+	}
+
+	// Re-address again
+	if _, err := (&serverCloudAdaptiveNetwork{}).ReaddressCLADNet(ctx, req); err != nil {
+		t.Fatalf("ReaddressCLADNet() again error = %v", err)
+	}
+	for hostID, want := range map[string]string{"host-01": "10.1.0.2/24", "host-02": "10.1.0.3/24", "host-03": "10.1.0.4/24"} {
+		peer, err := cbnetStore.GetPeer(ctx, "cladnet-01", hostID)
+		if err != nil || peer.IPv4CIDR != want || peer.PreviousIPv4CIDR != oldPeers[hostID].IPv4CIDR {
+			t.Errorf("GetPeer(%v) = %+v, %v, want %v", hostID, peer, err, want)
+		}
+	}
+	if readdressing, err := cbnetStore.GetReaddressing(ctx, "cladnet-01"); err != nil || readdressing.NewIpv4AddressSpace != "10.1.0.0/24" {
+		t.Errorf("GetReaddressing() = %+v, %v", readdressing, err)
+	}
+}
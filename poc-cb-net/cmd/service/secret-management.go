@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
+	secutil "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/secret-util"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lockSecret acquires the distributed lock of the secrets in a CLADNet, and returns a function to release it.
+func lockSecret(ctx context.Context, cladnetID string) (func(), error) {
+
+	// Acquire lock (or wait to have it)
+	CBLogger.Debug("Acquire a lock")
+	// Time trying to acquire a lock
+	start := time.Now()
+	unlock, err := cbnetStore.AcquireLock(ctx, store.SecretLock, cladnetID)
+	if err != nil {
+		CBLogger.Error(err)
+		return nil, status.Errorf(codes.Internal, "error while acquiring a lock: %v", err)
+	}
+	CBLogger.Tracef("Lock acquired for '%s'", cladnetID)
+
+	return func() {
+		// Release lock
+		CBLogger.Debug("Release a lock")
+		unlock()
+		CBLogger.Tracef("Lock released for '%s'", cladnetID)
+		// Elapsed time from the time trying to acquire a lock
+		elapsed := time.Since(start)
+		formatted := fmt.Sprintf("%.3f", elapsed.Seconds())
+		CBLogger.Tracef("Elapsed time for locking (sec): %s", formatted)
+	}, nil
+}
+
+// auditActor returns the name of the API key of a call, or the fallback if the authorization is not used.
+func auditActor(ctx context.Context, fallback string) string {
+	if name := grpcauth.APIKeyNameFromContext(ctx); name != "" {
+		return name
+	}
+	return fallback
+}
+
+// putSecretAuditRecord records a change on the secrets to the etcd and the log.
+func putSecretAuditRecord(ctx context.Context, record model.SecretAuditRecord) {
+	CBLogger.Debug("Start.........")
+
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now()
+	}
+	CBLogger.Infof("[Audit] secret %v - cladnetId: %v, hostId: %v, fingerprint: %v, actor: %v, reason: %v",
+		record.Action, record.CladnetID, record.HostID, record.Fingerprint, record.Actor, record.Reason)
+
+	CBLogger.Debugf("Put a secret audit record - %v/%v", record.CladnetID, record.HostID)
+	if err := cbnetStore.PutSecretAuditRecord(ctx, record); err != nil {
+		CBLogger.Error(err)
+	}
+
+	CBLogger.Debug("End.........")
+}
+
+// putControlCommand puts a control command to a host.
+func putControlCommand(ctx context.Context, cladnetID string, hostID string, commandType string) error {
+	CBLogger.Debugf("Put a control command - %v/%v", cladnetID, hostID)
+	CBLogger.Tracef("Value: %#v", commandType)
+	return cbnetStore.PutControlCommand(ctx, cladnetID, hostID, commandType)
+}
+
+func (s *serverCloudAdaptiveNetwork) RotateSecret(ctx context.Context, req *pb.SecretRequest) (*pb.ControlResponse, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	controlResponse := &pb.ControlResponse{
+		IsSucceeded: false,
+		Message:     "",
+	}
+
+	// Get a peer (or all peers if the host ID is empty) in the Cloud Adaptive Network
+	var peers []model.Peer
+	var err error
+	if req.HostId != "" {
+		CBLogger.Debugf("Get a peer - %v/%v", req.CladnetId, req.HostId)
+		var peer model.Peer
+		peer, err = cbnetStore.GetPeer(ctx, req.CladnetId, req.HostId)
+		if err == nil {
+			peers = append(peers, peer)
+		}
+	} else {
+		CBLogger.Debugf("Get peers - %v", req.CladnetId)
+		peers, err = cbnetStore.ListPeers(ctx, req.CladnetId)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		CBLogger.Error(err)
+		controlResponse.Message = fmt.Sprintf("error while getting peers: %v", err)
+		return controlResponse, status.Error(codes.Internal, controlResponse.Message)
+	}
+
+	if len(peers) == 0 {
+		controlResponse.Message = fmt.Sprintf("not found peers by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+		return controlResponse, status.Error(codes.NotFound, controlResponse.Message)
+	}
+
+	actor := auditActor(ctx, "")
+	for _, peer := range peers {
+		// Request the host to rotate its key
+		if err := putControlCommand(ctx, peer.CladnetID, peer.HostID, cmdtype.RotateKey); err != nil {
+			CBLogger.Error(err)
+			controlResponse.Message = fmt.Sprintf("error while putting the command: %v", err)
+			return controlResponse, status.Error(codes.Internal, controlResponse.Message)
+		}
+
+		putSecretAuditRecord(ctx, model.SecretAuditRecord{
+			CladnetID: peer.CladnetID,
+			HostID:    peer.HostID,
+			Action:    model.SecretActionRotationRequest,
+			Actor:     actor,
+			Reason:    req.Reason,
+		})
+	}
+
+	controlResponse.IsSucceeded = true
+	controlResponse.Message = "The key rotation successfully requested."
+
+	return controlResponse, status.New(codes.OK, "").Err()
+}
+
+func (s *serverCloudAdaptiveNetwork) RevokeSecret(ctx context.Context, req *pb.SecretRequest) (*pb.SecretAuditRecord, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	if req.CladnetId == "" || req.HostId == "" {
+		return &pb.SecretAuditRecord{}, status.Errorf(codes.InvalidArgument, "cladnetId (%+v) and hostId (%+v) are required", req.CladnetId, req.HostId)
+	}
+
+	// The lock prevents the host from putting a secret while revoking
+	unlock, err := lockSecret(ctx, req.CladnetId)
+	if err != nil {
+		return &pb.SecretAuditRecord{}, err
+	}
+	defer unlock()
+
+	// Get the secret to be revoked
+	CBLogger.Debugf("Get a secret - %v/%v", req.CladnetId, req.HostId)
+	secret, err := cbnetStore.GetSecret(ctx, req.CladnetId, req.HostId)
+	if errors.Is(err, store.ErrNotFound) {
+		return &pb.SecretAuditRecord{}, status.Errorf(codes.NotFound, "not found a secret by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.SecretAuditRecord{}, status.Errorf(codes.Internal, "error while getting a secret: %v", err)
+	}
+
+	fingerprint, err := secutil.PublicKeyFingerprint(secret.PublicKey)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.SecretAuditRecord{}, status.Errorf(codes.Internal, "error while fingerprinting a secret: %v", err)
+	}
+
+	revocation := model.SecretRevocation{
+		CladnetID:   req.CladnetId,
+		HostID:      req.HostId,
+		Fingerprint: fingerprint,
+		Reason:      req.Reason,
+		RevokedAt:   time.Now(),
+	}
+
+	// Record the revoked key and delete the secret at once, so that every agent removes it from its keyring
+	CBLogger.Debugf("Revoke a secret - %v/%v (%v)", req.CladnetId, req.HostId, fingerprint)
+	isRevoked, err := cbnetStore.RevokeSecret(ctx, secret, revocation)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.SecretAuditRecord{}, status.Errorf(codes.Internal, "error while revoking a secret: %v", err)
+	}
+	if !isRevoked {
+		return &pb.SecretAuditRecord{}, status.Errorf(codes.Aborted, "the secret has been changed while revoking")
+	}
+
+	// Force the host to re-key without the grace period
+	if err := putControlCommand(ctx, req.CladnetId, req.HostId, cmdtype.RevokeKey); err != nil {
+		CBLogger.Error(err)
+		return &pb.SecretAuditRecord{}, status.Errorf(codes.Internal, "error while putting the command: %v", err)
+	}
+
+	record := model.SecretAuditRecord{
+		CladnetID:   req.CladnetId,
+		HostID:      req.HostId,
+		Action:      model.SecretActionRevoke,
+		Fingerprint: fingerprint,
+		Actor:       auditActor(ctx, ""),
+		Reason:      req.Reason,
+		Timestamp:   revocation.RevokedAt,
+	}
+	putSecretAuditRecord(ctx, record)
+
+	return &pb.SecretAuditRecord{
+		CladnetId:   record.CladnetID,
+		HostId:      record.HostID,
+		Action:      record.Action,
+		Fingerprint: record.Fingerprint,
+		Actor:       record.Actor,
+		Reason:      record.Reason,
+		Timestamp:   record.Timestamp.Format(time.RFC3339),
+	}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverCloudAdaptiveNetwork) GetSecretAuditList(ctx context.Context, req *pb.CLADNetRequest) (*pb.SecretAuditRecords, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	// Get audit records of a Cloud Adaptive Network (sorted by time)
+	CBLogger.Debugf("Get secret audit records - %v", req.CladnetId)
+	tempRecords, err := cbnetStore.ListSecretAuditRecords(ctx, req.CladnetId)
+	if err != nil {
+		CBLogger.Error(err)
+		return nil, status.Errorf(codes.Internal, "error while getting audit records: %v", err)
+	}
+
+	records := &pb.SecretAuditRecords{}
+	for _, record := range tempRecords {
+		records.Records = append(records.Records, &pb.SecretAuditRecord{
+			CladnetId:   record.CladnetID,
+			HostId:      record.HostID,
+			Action:      record.Action,
+			Fingerprint: record.Fingerprint,
+			Actor:       record.Actor,
+			Reason:      record.Reason,
+			Timestamp:   record.Timestamp.Format(time.RFC3339),
+		})
+	}
+
+	return records, status.New(codes.OK, "").Err()
+}
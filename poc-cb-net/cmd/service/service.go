@@ -2,29 +2,38 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
 	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
-	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	etcdclient "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-client"
 	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
 	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
+	jointoken "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/join-token"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/metrics"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/migration"
 	nethelper "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-helper"
 	ruletype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/rule-type"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
 	testtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/test-type"
+	tlsutil "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tls-util"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tracing"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/validator"
 	cblog "github.com/cloud-barista/cb-log"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -32,11 +41,11 @@ import (
 	"github.com/rs/xid"
 	"github.com/sirupsen/logrus"
 	echoSwagger "github.com/swaggo/echo-swagger"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -46,12 +55,23 @@ import (
 // CBLogger represents a logger to show execution processes according to the logging level.
 var CBLogger *logrus.Logger
 var config model.Config
-var etcdClient *clientv3.Client
+var cbnetStore store.Store
 var loggerPrefix = "service"
 var serviceID string
 
-func init() {
-	fmt.Println("\nStart......... init() of cb-network service.go")
+// defaultJoinTokenTTL is the default time to live (sec) of a join token
+const defaultJoinTokenTTL = int64(24 * 60 * 60)
+
+// healthFullMethod is the full method name of health-checking, which is allowed without a token
+const healthFullMethod = "/cbnet.v1.SystemManagementService/health"
+
+// agentServicePrefix stands for all methods of the agent service, which are allowed without a token.
+// The agents are authenticated by the handlers instead (i.e., by a join token and then the bound agent credential).
+const agentServicePrefix = "/cbnet.v1.AgentService/"
+
+// setUp loads the config and sets the logger, which is called by main() (not init(), so that the tests set them on their own)
+func setUp() {
+	fmt.Println("\nStart......... setUp() of cb-network service.go")
 
 	// Load cb-network config from the current directory (usually for the production)
 	ex, err := os.Executable()
@@ -123,7 +143,7 @@ func init() {
 	CBLogger.Debugf("Load %v", configPath)
 	CBLogger.Debugf("Load %v", logConfPath)
 
-	fmt.Println("End......... init() of cb-network service.go")
+	fmt.Println("End......... setUp() of cb-network service.go")
 	fmt.Println("")
 }
 
@@ -152,46 +172,25 @@ func (s *serverSystemManagement) ControlCloudAdaptiveNetwork(ctx context.Context
 	}
 
 	// Get all peers in a Cloud Adaptive Network
-	keyPeers := fmt.Sprint(etcdkey.Peer + "/" + cladnetID)
-	CBLogger.Debugf("Get with prefix - %v", keyPeers)
-	getResp, err := etcdClient.Get(context.TODO(), keyPeers, clientv3.WithPrefix())
+	CBLogger.Debugf("Get peers - %v", cladnetID)
+	peers, err := cbnetStore.ListPeers(ctx, cladnetID)
 	if err != nil {
 		CBLogger.Error(err)
 		controlResponse.Message = fmt.Sprintf("error while getting the networking rule: %v\n", err)
 		return controlResponse, status.Errorf(codes.Internal, err.Error())
 	}
-	CBLogger.Tracef("GetResponse: %#v", getResp)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*getResp)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
-
-	for _, kv := range getResp.Kvs {
-		key := string(kv.Key)
-		CBLogger.Tracef("Key : %v", key)
-		CBLogger.Tracef("The peer: %v", string(kv.Value))
-
-		var peer model.Peer
-		err := json.Unmarshal(kv.Value, &peer)
-		if err != nil {
-			CBLogger.Error(err)
-		}
-
-		// Put the evaluation specification of the CLADNet to the etcd
-		keyControlCommand := fmt.Sprint(etcdkey.ControlCommand + "/" + peer.CladnetID + "/" + peer.HostID)
 
-		CBLogger.Debugf("Put - %v", keyControlCommand)
-		controlCommandBody := cmdtype.BuildCommandMessage(commandType)
-		CBLogger.Tracef("Value: %#v", controlCommandBody)
-
-		size := binary.Size(controlCommandBody)
-		CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+	for _, peer := range peers {
+		CBLogger.Tracef("The peer: %v", peer)
 
-		putResp, err := etcdClient.Put(context.Background(), keyControlCommand, controlCommandBody)
+		// Put the control command to the peer
+		CBLogger.Debugf("Put a control command - %v/%v", peer.CladnetID, peer.HostID)
+		err := cbnetStore.PutControlCommand(ctx, peer.CladnetID, peer.HostID, commandType)
 		if err != nil {
 			CBLogger.Error(err)
 			controlResponse.Message = fmt.Sprintf("error while putting the command: %v\n", err)
 			return controlResponse, status.Errorf(codes.Internal, err.Error())
 		}
-		CBLogger.Debugf("PutResponse: %#v", putResp)
 	}
 
 	controlResponse.IsSucceeded = true
@@ -207,6 +206,88 @@ func (s *serverSystemManagement) TestCloudAdaptiveNetwork(ctx context.Context, r
 
 	CBLogger.Tracef("Received profile: %v", req)
 
+	testResponse := &pb.TestResponse{
+		IsSucceeded: false,
+		Message:     "",
+	}
+
+	timeout, err := testWaitTimeout(req.Timeout)
+	if err != nil {
+		testResponse.Message = status.Convert(err).Message()
+		return testResponse, err
+	}
+
+	testRun, err := requestTest(ctx, req)
+	if err != nil {
+		testResponse.Message = status.Convert(err).Message()
+		return testResponse, err
+	}
+	testResponse.RunId = testRun.RunID
+
+	if !req.Wait {
+		testResponse.IsSucceeded = true
+		testResponse.Message = "The command successfully transferred."
+
+		CBLogger.Debug("End.........")
+		return testResponse, status.New(codes.OK, "").Err()
+	}
+
+	// Wait for the results of the targeted agents
+	timedOut, err := waitForTestResults(ctx, testRun, timeout, func(result model.NetworkStatus) error {
+		testResponse.Results = append(testResponse.Results, testResultToPB(result))
+		return nil
+	})
+	if err != nil {
+		testResponse.Message = status.Convert(err).Message()
+		return testResponse, err
+	}
+
+	sort.Slice(testResponse.Results, func(i, j int) bool {
+		return testResponse.Results[i].HostId < testResponse.Results[j].HostId
+	})
+	testResponse.TimedOutHostIds = timedOut
+	testResponse.IsSucceeded = true
+	testResponse.Message = fmt.Sprintf("%d of %d agents reported the results.", len(testResponse.Results), len(testRun.HostIDs))
+
+	CBLogger.Debug("End.........")
+
+	return testResponse, status.New(codes.OK, "").Err()
+}
+
+func (s *serverSystemManagement) TestCloudAdaptiveNetworkStream(req *pb.TestRequest, stream pb.SystemManagementService_TestCloudAdaptiveNetworkStreamServer) error {
+	CBLogger.Debug("Start.........")
+
+	CBLogger.Tracef("Received profile: %v", req)
+
+	timeout, err := testWaitTimeout(req.Timeout)
+	if err != nil {
+		return err
+	}
+
+	testRun, err := requestTest(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&pb.TestEvent{RunId: testRun.RunID, Run: testRunToPB(testRun)}); err != nil {
+		return err
+	}
+
+	// Stream the results of the targeted agents
+	timedOut, err := waitForTestResults(stream.Context(), testRun, timeout, func(result model.NetworkStatus) error {
+		return stream.Send(&pb.TestEvent{RunId: testRun.RunID, Result: testResultToPB(result)})
+	})
+	if err != nil {
+		return err
+	}
+
+	CBLogger.Debug("End.........")
+
+	return stream.Send(&pb.TestEvent{RunId: testRun.RunID, IsCompleted: true, TimedOutHostIds: timedOut})
+}
+
+// requestTest puts a test run and the test requests to all peers in a CLADNet
+func requestTest(ctx context.Context, req *pb.TestRequest) (model.TestRun, error) {
 	CBLogger.Debugf("CLADNet ID: %#v", req.CladnetId)
 	CBLogger.Debugf("TestType: %#v", req.TestType)
 	CBLogger.Debugf("TestSpec: %#v", req.TestSpec)
@@ -215,11 +296,6 @@ func (s *serverSystemManagement) TestCloudAdaptiveNetwork(ctx context.Context, r
 	testType := req.TestType.String()
 	testSpec := req.TestSpec
 
-	testResponse := &pb.TestResponse{
-		IsSucceeded: false,
-		Message:     "",
-	}
-
 	if testSpec == "" || testSpec == "string" {
 		tempSpec, err := json.Marshal(model.TestSpecification{
 			CladnetID:  cladnetID,
@@ -233,53 +309,38 @@ func (s *serverSystemManagement) TestCloudAdaptiveNetwork(ctx context.Context, r
 	}
 
 	// Get all peers in a Cloud Adaptive Network
-	keyPeersInCLADNet := fmt.Sprint(etcdkey.Peer + "/" + cladnetID)
-	CBLogger.Debugf("Get with prefix - %v", keyPeersInCLADNet)
-	getResp, err := etcdClient.Get(context.TODO(), keyPeersInCLADNet, clientv3.WithPrefix())
+	CBLogger.Debugf("Get peers - %v", cladnetID)
+	peers, err := cbnetStore.ListPeers(ctx, cladnetID)
 	if err != nil {
 		CBLogger.Error(err)
-		testResponse.Message = fmt.Sprintf("error while getting the networking rule: %v\n", err)
-		return testResponse, status.Errorf(codes.Internal, err.Error())
+		return model.TestRun{}, status.Errorf(codes.Internal, "error while getting the networking rule: %v", err)
 	}
-	CBLogger.Tracef("GetResponse: %#v", getResp)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*getResp)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
-
-	for _, kv := range getResp.Kvs {
-		key := string(kv.Key)
-		CBLogger.Tracef("Key : %v", key)
-		CBLogger.Tracef("The peer: %v", string(kv.Value))
 
-		var peer model.Peer
-		err := json.Unmarshal(kv.Value, &peer)
-		if err != nil {
-			CBLogger.Error(err)
-		}
+	// Put the test run, so that the results are retained and compared by the run ID
+	testRun, err := newTestRun(ctx, cladnetID, testType, testSpec, req.Label, peers)
+	if err != nil {
+		CBLogger.Error(err)
+		return testRun, status.Errorf(codes.Internal, "error while putting the test run: %v", err)
+	}
 
-		// Put the evaluation specification of the CLADNet to the etcd
-		keyTestRequest := fmt.Sprint(etcdkey.TestRequest + "/" + peer.CladnetID + "/" + peer.HostID)
+	for _, peer := range peers {
+		CBLogger.Tracef("The peer: %v", peer)
 
-		CBLogger.Debugf("Put - %v", keyTestRequest)
-		testRequestBody := testtype.BuildTestMessage(testType, testSpec)
+		// Put the test request to the peer
+		CBLogger.Debugf("Put a test request - %v/%v", peer.CladnetID, peer.HostID)
+		testRequestBody := testtype.BuildTestMessageWithRunID(testType, testRun.RunID, testSpec)
 		CBLogger.Tracef("Value: %#v", testRequestBody)
 
-		size := binary.Size(testRequestBody)
+		size := binary.Size([]byte(testRequestBody))
 		CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
-		putResp, err := etcdClient.Put(context.Background(), keyTestRequest, testRequestBody)
+		err := cbnetStore.PutTestRequest(ctx, peer.CladnetID, peer.HostID, testRequestBody)
 		if err != nil {
 			CBLogger.Error(err)
-			testResponse.Message = fmt.Sprintf("error while putting the command: %v\n", err)
-			return testResponse, status.Errorf(codes.Internal, err.Error())
+			return testRun, status.Errorf(codes.Internal, "error while putting the command: %v", err)
 		}
-		CBLogger.Debugf("PutResponse: %#v", putResp)
 	}
 
-	testResponse.IsSucceeded = true
-	testResponse.Message = "The command successfully transferred."
-
-	CBLogger.Debug("End.........")
-
-	return testResponse, status.New(codes.OK, "").Err()
+	return testRun, nil
 }
 
 type serverCloudAdaptiveNetwork struct {
@@ -287,67 +348,44 @@ type serverCloudAdaptiveNetwork struct {
 }
 
 func (s *serverCloudAdaptiveNetwork) GetCLADNet(ctx context.Context, req *pb.CLADNetRequest) (*pb.CLADNetSpecification, error) {
-	log.Printf("Received profile: %v", req)
+	CBLogger.Debugf("Received profile: %v", req)
 
 	// Get a specification of the CLADNet
-	keyCLADNetSpecificationOfCLADNet := fmt.Sprint(etcdkey.CLADNetSpecification + "/" + req.CladnetId)
-	CBLogger.Debugf("Get - %v", keyCLADNetSpecificationOfCLADNet)
-	respSpec, errSpec := etcdClient.Get(context.Background(), keyCLADNetSpecificationOfCLADNet)
+	CBLogger.Debugf("Get a CLADNet specification - %v", req.CladnetId)
+	tempCLADNetSpec, errSpec := cbnetStore.GetSpec(ctx, req.CladnetId)
+	if errors.Is(errSpec, store.ErrNotFound) {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.NotFound, "could not find a CLADNet by %v\n", req.CladnetId)
+	}
 	if errSpec != nil {
 		CBLogger.Error(errSpec)
 		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while getting a CLADNetSpecification: %v\n", errSpec)
 	}
-	CBLogger.Tracef("GetResponse: %#v", respSpec)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respSpec)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
-
-	var tempCLADNetSpec model.CLADNetSpecification
-
-	// Unmarshal the specification of the CLADNet if exists
-	CBLogger.Tracef("RespRule.Kvs: %v", respSpec.Kvs)
-	if len(respSpec.Kvs) != 0 {
-		errUnmarshal := json.Unmarshal(respSpec.Kvs[0].Value, &tempCLADNetSpec)
-		if errUnmarshal != nil {
-			CBLogger.Error(errUnmarshal)
-		}
-		CBLogger.Tracef("TempSpec: %v", tempCLADNetSpec)
-
-		spec := &pb.CLADNetSpecification{
-			CladnetId:        tempCLADNetSpec.CladnetID,
-			Name:             tempCLADNetSpec.Name,
-			Ipv4AddressSpace: tempCLADNetSpec.Ipv4AddressSpace,
-			Description:      tempCLADNetSpec.Description,
-			RuleType:         tempCLADNetSpec.RuleType,
-		}
-		return spec, status.New(codes.OK, "").Err()
+	CBLogger.Tracef("TempSpec: %v", tempCLADNetSpec)
+
+	spec := &pb.CLADNetSpecification{
+		CladnetId:        tempCLADNetSpec.CladnetID,
+		Name:             tempCLADNetSpec.Name,
+		Ipv4AddressSpace: tempCLADNetSpec.Ipv4AddressSpace,
+		Description:      tempCLADNetSpec.Description,
+		RuleType:         tempCLADNetSpec.RuleType,
 	}
-	return &pb.CLADNetSpecification{}, status.Errorf(codes.NotFound, "could not find a CLADNet by %v\n", req.CladnetId)
+	return spec, status.New(codes.OK, "").Err()
 }
 
 func (s *serverCloudAdaptiveNetwork) GetCLADNetList(ctx context.Context, in *empty.Empty) (*pb.CLADNetSpecifications, error) {
 	// Get all specification of the CLADNet
-	CBLogger.Debugf("Get with prefix - %v", etcdkey.CLADNetSpecification)
-	respSpecs, errSpec := etcdClient.Get(context.Background(), etcdkey.CLADNetSpecification, clientv3.WithPrefix())
+	CBLogger.Debug("Get CLADNet specifications")
+	tempSpecs, errSpec := cbnetStore.ListSpecs(ctx)
 	if errSpec != nil {
 		CBLogger.Error(errSpec)
 		return nil, status.Errorf(codes.Internal, "error while getting a list of CLADNetSpecifications: %v\n", errSpec)
 	}
-	CBLogger.Tracef("GetResponse: %#v", respSpecs)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respSpecs)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
 
-	// Unmarshal the specification of the CLADNet if exists
-	CBLogger.Tracef("RespRule.Kvs: %v", respSpecs.Kvs)
-	if len(respSpecs.Kvs) != 0 {
+	if len(tempSpecs) != 0 {
 
 		specs := &pb.CLADNetSpecifications{}
 
-		for _, specKv := range respSpecs.Kvs {
-			var tempSpec model.CLADNetSpecification
-			errUnmarshal := json.Unmarshal(specKv.Value, &tempSpec)
-			if errUnmarshal != nil {
-				CBLogger.Error(errUnmarshal)
-			}
+		for _, tempSpec := range tempSpecs {
 			CBLogger.Tracef("TempSpec: %v", tempSpec)
 			specs.CladnetSpecifications = append(specs.CladnetSpecifications, &pb.CLADNetSpecification{
 				CladnetId:        tempSpec.CladnetID,
@@ -364,30 +402,13 @@ func (s *serverCloudAdaptiveNetwork) GetCLADNetList(ctx context.Context, in *emp
 }
 
 func (s *serverCloudAdaptiveNetwork) CreateCLADNet(ctx context.Context, cladnetSpec *pb.CLADNetSpecification) (*pb.CLADNetSpecification, error) {
-	log.Printf("Received profile: %v", cladnetSpec)
+	CBLogger.Debugf("Received profile: %v", cladnetSpec)
 
 	// NOTE - A user can assign the ID of Cloud Adaptive Network. It must be unique one.
 	if cladnetSpec.CladnetId != "" {
-		// Check if the Cloud Adaptive Network exists or not
-
-		// Request body
-		req := &pb.CLADNetRequest{
-			CladnetId: cladnetSpec.CladnetId,
-		}
-
-		// Get a cloud adaptive network
-		_, err := s.GetCLADNet(context.TODO(), req)
-
-		s, ok := status.FromError(err)
-		if !ok {
-			return &pb.CLADNetSpecification{}, err
-		}
-
-		switch s.Code() {
-		case codes.OK: // if OK, already exist.
-			return &pb.CLADNetSpecification{}, status.Errorf(codes.AlreadyExists, "already exists (CladnetID: %s)", cladnetSpec.CladnetId)
-		case codes.Internal:
-			return &pb.CLADNetSpecification{}, err
+		// Check if the ID can be a segment of the etcd keys
+		if err := etcdkey.ValidateID(cladnetSpec.CladnetId); err != nil {
+			return &pb.CLADNetSpecification{}, status.Errorf(codes.InvalidArgument, "invalid cladnetId: %v", err)
 		}
 	}
 
@@ -400,13 +421,6 @@ func (s *serverCloudAdaptiveNetwork) CreateCLADNet(ctx context.Context, cladnetS
 	}
 
 	// Currently assign the 1st IP address for Gateway IP (Not used till now)
-	ipv4Address, _, errParseCIDR := net.ParseCIDR(cladnetSpec.Ipv4AddressSpace)
-	if errParseCIDR != nil {
-		CBLogger.Error(errParseCIDR)
-		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while parsing CIDR: %v\n", errParseCIDR)
-	}
-	CBLogger.Tracef("IPv4Address: %v", ipv4Address)
-
 	// [Keep] Assign gateway IP address
 	// ip := ipv4Address.To4()
 	// gatewayIP := nethelper.IncrementIP(ip, 1)
@@ -427,19 +441,23 @@ func (s *serverCloudAdaptiveNetwork) CreateCLADNet(ctx context.Context, cladnetS
 		RuleType:         ruleType,
 	}
 
-	bytesCLADNetSpec, _ := json.Marshal(spec)
-	CBLogger.Tracef("Value: %#v", spec)
+	// Validate the specification by itself, and against the other CLADNets
+	if err := validateSpecification(ctx, *spec); err != nil {
+		return &pb.CLADNetSpecification{}, err
+	}
 
-	size := binary.Size(bytesCLADNetSpec)
-	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+	CBLogger.Tracef("Value: %#v", spec)
 
-	keyCLADNetSpecification := fmt.Sprint(etcdkey.CLADNetSpecification + "/" + cladnetSpec.CladnetId)
-	putResp, err := etcdClient.Put(context.TODO(), keyCLADNetSpecification, string(bytesCLADNetSpec))
+	// Create the specification only if the CLADNet does not exist (i.e., by compare-and-swap(CAS))
+	CBLogger.Debugf("Create a CLADNet specification - %v", spec.CladnetID)
+	isCreated, err := cbnetStore.CreateSpec(ctx, *spec)
 	if err != nil {
 		CBLogger.Error(err)
 		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while putting CLADNetSpecification: %v", err)
 	}
-	CBLogger.Debugf("PutResponse: %#v", putResp)
+	if !isCreated {
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.AlreadyExists, "already exists (CladnetID: %s)", spec.CladnetID)
+	}
 
 	return &pb.CLADNetSpecification{
 		CladnetId:        cladnetSpec.CladnetId,
@@ -449,159 +467,154 @@ func (s *serverCloudAdaptiveNetwork) CreateCLADNet(ctx context.Context, cladnetS
 }
 
 func (s *serverCloudAdaptiveNetwork) UpdateCLADNet(ctx context.Context, cladnetSpec *pb.CLADNetSpecification) (*pb.CLADNetSpecification, error) {
-	log.Printf("Received: %#v", cladnetSpec)
+	CBLogger.Debugf("Received: %#v", cladnetSpec)
 
 	// Check if the Cloud Adaptive Network exists or not
 	req := &pb.CLADNetRequest{
 		CladnetId: cladnetSpec.CladnetId,
 	}
 
-	if _, err := s.GetCLADNet(context.TODO(), req); err != nil {
+	if _, err := s.GetCLADNet(ctx, req); err != nil {
 		return &pb.CLADNetSpecification{}, err
 	}
 
+	tempSpec, err := cbnetStore.GetSpec(ctx, cladnetSpec.CladnetId)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while getting a CLADNetSpecification: %v", err)
+	}
+
 	// Update the Cloud Adaptive Network
-	tempSpec := model.CLADNetSpecification{
-		CladnetID:        cladnetSpec.CladnetId,
-		Name:             cladnetSpec.Name,
-		Ipv4AddressSpace: cladnetSpec.Ipv4AddressSpace,
-		Description:      cladnetSpec.Description,
-		RuleType:         cladnetSpec.RuleType,
+	// The labels, reservations and policies are kept (see applyCLADNet)
+	newSpec := tempSpec
+	newSpec.Name = cladnetSpec.Name
+	newSpec.Ipv4AddressSpace = cladnetSpec.Ipv4AddressSpace
+	newSpec.Description = cladnetSpec.Description
+	newSpec.RuleType = cladnetSpec.RuleType
+
+	if err := validateSpecification(ctx, newSpec); err != nil {
+		return &pb.CLADNetSpecification{}, err
 	}
 
-	specBytes, _ := json.Marshal(tempSpec)
-	CBLogger.Tracef("Value: %#v", tempSpec)
+	// Not to break the live peers, the IPv4 address space under peers is changed only by readdressCLADNet
+	if cladnetSpec.Ipv4AddressSpace != tempSpec.Ipv4AddressSpace {
+		count, err := cbnetStore.CountPeers(ctx, cladnetSpec.CladnetId)
+		if err != nil {
+			CBLogger.Error(err)
+			return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while counting peers: %v", err)
+		}
+		if count > 0 {
+			return &pb.CLADNetSpecification{}, status.Errorf(codes.FailedPrecondition,
+				"could not change the IPv4 address space under %d peer(s) (use readdressCLADNet)", count)
+		}
+	}
 
-	size := binary.Size(specBytes)
-	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
+	CBLogger.Tracef("Value: %#v", newSpec)
 
-	keyPeerOfCLADNet := fmt.Sprint(etcdkey.CLADNetSpecification + "/" + cladnetSpec.CladnetId)
-	putResp, err := etcdClient.Put(context.Background(), keyPeerOfCLADNet, string(specBytes))
+	CBLogger.Debugf("Put a CLADNet specification - %v", newSpec.CladnetID)
+	err = cbnetStore.PutSpec(ctx, newSpec)
 	if err != nil {
 		CBLogger.Error(err)
 		return &pb.CLADNetSpecification{}, status.Errorf(codes.Internal, "error while updating the peer: %v", err)
 	}
-	CBLogger.Debugf("PutResponse: %#v", putResp)
 
 	// Get and return the updated Cloud Adaptive Network
-	cladnetSpec, err = s.GetCLADNet(context.TODO(), req)
+	cladnetSpec, err = s.GetCLADNet(ctx, req)
 	if err != nil {
 		return &pb.CLADNetSpecification{}, err
 	}
 	return cladnetSpec, status.New(codes.OK, "").Err()
 }
 
+// validateSpecification validates a CLADNet specification by itself, and against the other CLADNets and its peers.
+// It returns an InvalidArgument status with the invalid fields.
+func validateSpecification(ctx context.Context, spec model.CLADNetSpecification) error {
+	if err := validator.CheckSpecification(spec); err != nil {
+		return err
+	}
+
+	others, err := cbnetStore.ListSpecs(ctx)
+	if err != nil {
+		CBLogger.Error(err)
+		return status.Errorf(codes.Internal, "error while listing CLADNetSpecifications: %v", err)
+	}
+
+	var peers []model.Peer
+	if spec.CladnetID != "" {
+		peers, err = cbnetStore.ListPeers(ctx, spec.CladnetID)
+		if err != nil {
+			CBLogger.Error(err)
+			return status.Errorf(codes.Internal, "error while listing peers: %v", err)
+		}
+	}
+
+	return validator.CheckConflicts(spec, others, peers)
+}
+
 func (s *serverCloudAdaptiveNetwork) RecommendAvailableIPv4PrivateAddressSpaces(ctx context.Context, ipv4CIDRs *pb.IPv4CIDRs) (*pb.AvailableIPv4PrivateAddressSpaces, error) {
-	log.Printf("Received: %#v", ipv4CIDRs.Ipv4Cidrs)
+	CBLogger.Debugf("Received: %#v", ipv4CIDRs.Ipv4Cidrs)
+
+	availableSpaces, err := nethelper.GetAvailableIPv4PrivateAddressSpaces(ipv4CIDRs.Ipv4Cidrs,
+		int(ipv4CIDRs.ExpectedHosts), int(ipv4CIDRs.HeadroomPercent))
+	if errors.Is(err, nethelper.ErrNoAvailableIPv4PrivateAddressSpace) {
+		return &pb.AvailableIPv4PrivateAddressSpaces{}, status.Errorf(codes.ResourceExhausted, "%v", err)
+	}
+	if err != nil {
+		return &pb.AvailableIPv4PrivateAddressSpaces{}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
-	availableSpaces := nethelper.GetAvailableIPv4PrivateAddressSpaces(ipv4CIDRs.Ipv4Cidrs)
 	response := &pb.AvailableIPv4PrivateAddressSpaces{
 		RecommendedIpv4PrivateAddressSpace: availableSpaces.RecommendedIPv4PrivateAddressSpace,
 		AddressSpace10S:                    availableSpaces.AddressSpace10s,
 		AddressSpace172S:                   availableSpaces.AddressSpace172s,
 		AddressSpace192S:                   availableSpaces.AddressSpace192s}
+	for _, freeBlock := range availableSpaces.FreeBlocks {
+		response.FreeBlocks = append(response.FreeBlocks, &pb.FreeIPv4Block{
+			Cidr:                freeBlock.CIDR,
+			Size:                freeBlock.Size,
+			PrivateAddressSpace: freeBlock.PrivateAddressSpace,
+		})
+	}
 
 	return response, status.New(codes.OK, "").Err()
 }
 
 func (s *serverCloudAdaptiveNetwork) GetPeer(ctx context.Context, req *pb.PeerRequest) (*pb.Peer, error) {
-	log.Printf("Received: %#v", req)
+	CBLogger.Debugf("Received: %#v", req)
 
 	// Get a peer of the CLADNet
-	keyPeer := fmt.Sprint(etcdkey.Peer + "/" + req.CladnetId + "/" + req.HostId)
-	CBLogger.Debugf("Get - %v", keyPeer)
-	respPeer, errEtcd := etcdClient.Get(context.Background(), keyPeer)
-	if errEtcd != nil {
-		CBLogger.Error(errEtcd)
-		return &pb.Peer{}, status.Errorf(codes.Internal, "error while getting a peer: %v", respPeer)
-	}
-	CBLogger.Tracef("GetResponse: %#v", respPeer)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respPeer)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
-
-	// Unmarshal the peer of the CLADNet if exists
-	CBLogger.Tracef("RespRule.Kvs: %v", respPeer.Kvs)
-	if respPeer.Count != 0 {
-		tempPeer := model.Peer{}
-		errUnmarshal := json.Unmarshal(respPeer.Kvs[0].Value, &tempPeer)
-		if errUnmarshal != nil {
-			CBLogger.Error(errUnmarshal)
-		}
-		CBLogger.Tracef("Peer: %v", tempPeer)
-
-		peer := &pb.Peer{
-			CladnetId:           tempPeer.CladnetID,
-			HostId:              tempPeer.HostID,
-			HostName:            tempPeer.HostName,
-			HostPrivateIpv4Cidr: tempPeer.HostPrivateIPv4CIDR,
-			HostPrivateIp:       tempPeer.HostPrivateIP,
-			HostPublicIp:        tempPeer.HostPublicIP,
-			Ipv4Cidr:            tempPeer.IPv4CIDR,
-			Ip:                  tempPeer.IP,
-			State:               tempPeer.State,
-			Details: &pb.CloudInformation{
-				ProviderName:       tempPeer.Details.ProviderName,
-				RegionId:           tempPeer.Details.RegionID,
-				AvailabilityZoneId: tempPeer.Details.AvailabilityZoneID,
-				VirtualNetworkId:   tempPeer.Details.VirtualNetworkID,
-				SubnetId:           tempPeer.Details.SubnetID,
-			},
-		}
-
-		return peer, status.New(codes.OK, "").Err()
+	CBLogger.Debugf("Get a peer - %v/%v", req.CladnetId, req.HostId)
+	tempPeer, errStore := cbnetStore.GetPeer(ctx, req.CladnetId, req.HostId)
+	if errors.Is(errStore, store.ErrNotFound) {
+		return &pb.Peer{}, status.Errorf(codes.NotFound, "not found a peer by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+	}
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.Peer{}, status.Errorf(codes.Internal, "error while getting a peer: %v", errStore)
 	}
+	CBLogger.Tracef("Peer: %v", tempPeer)
 
-	return &pb.Peer{}, status.Errorf(codes.NotFound, "not found a peer by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+	return peerToPB(tempPeer), status.New(codes.OK, "").Err()
 }
 
 func (s *serverCloudAdaptiveNetwork) GetPeerList(ctx context.Context, req *pb.PeerRequest) (*pb.Peers, error) {
-	log.Printf("Received: %#v", req)
+	CBLogger.Debugf("Received: %#v", req)
 
 	// Get peers in a Cloud Adaptive Network
-	keyPeersInCLADNet := fmt.Sprint(etcdkey.Peer + "/" + req.CladnetId)
-	CBLogger.Debugf("Get with prefix - %v", keyPeersInCLADNet)
-	respPeers, errEtcd := etcdClient.Get(context.TODO(), keyPeersInCLADNet, clientv3.WithPrefix())
-	if errEtcd != nil {
-		CBLogger.Error(errEtcd)
-		return nil, status.Errorf(codes.Internal, "error while getting peers: %v", respPeers)
+	CBLogger.Debugf("Get peers - %v", req.CladnetId)
+	tempPeers, errStore := cbnetStore.ListPeers(ctx, req.CladnetId)
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return nil, status.Errorf(codes.Internal, "error while getting peers: %v", errStore)
 	}
-	CBLogger.Tracef("GetResponse: %#v", respPeers)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respPeers)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
 
-	// Unmarshal peers of the CLADNet if exists
-	CBLogger.Tracef("RespPeers.Kvs: %v", respPeers.Kvs)
-	if respPeers.Count != 0 {
+	if len(tempPeers) != 0 {
 
 		peers := &pb.Peers{}
 
-		for _, peerKv := range respPeers.Kvs {
-
-			tempPeer := model.Peer{}
-			errUnmarshal := json.Unmarshal(peerKv.Value, &tempPeer)
-			if errUnmarshal != nil {
-				CBLogger.Error(errUnmarshal)
-			}
-			CBLogger.Tracef("Peer: %v", peerKv)
-
-			peers.Peers = append(peers.Peers, &pb.Peer{
-				CladnetId:           tempPeer.CladnetID,
-				HostId:              tempPeer.HostID,
-				HostName:            tempPeer.HostName,
-				HostPrivateIpv4Cidr: tempPeer.HostPrivateIPv4CIDR,
-				HostPrivateIp:       tempPeer.HostPrivateIP,
-				HostPublicIp:        tempPeer.HostPublicIP,
-				Ipv4Cidr:            tempPeer.IPv4CIDR,
-				Ip:                  tempPeer.IP,
-				State:               tempPeer.State,
-				Details: &pb.CloudInformation{
-					ProviderName:       tempPeer.Details.ProviderName,
-					RegionId:           tempPeer.Details.RegionID,
-					AvailabilityZoneId: tempPeer.Details.AvailabilityZoneID,
-					VirtualNetworkId:   tempPeer.Details.VirtualNetworkID,
-					SubnetId:           tempPeer.Details.SubnetID,
-				},
-			})
+		for _, tempPeer := range tempPeers {
+			CBLogger.Tracef("Peer: %v", tempPeer)
+			peers.Peers = append(peers.Peers, peerToPB(tempPeer))
 		}
 		return peers, status.New(codes.OK, "").Err()
 	}
@@ -609,8 +622,30 @@ func (s *serverCloudAdaptiveNetwork) GetPeerList(ctx context.Context, req *pb.Pe
 	return &pb.Peers{}, status.Errorf(codes.NotFound, "not found any peer by cladnetId (%+v)", req.CladnetId)
 }
 
+// peerToPB converts a peer to the message of the peer
+func peerToPB(peer model.Peer) *pb.Peer {
+	return &pb.Peer{
+		CladnetId:           peer.CladnetID,
+		HostId:              peer.HostID,
+		HostName:            peer.HostName,
+		HostPrivateIpv4Cidr: peer.HostPrivateIPv4CIDR,
+		HostPrivateIp:       peer.HostPrivateIP,
+		HostPublicIp:        peer.HostPublicIP,
+		Ipv4Cidr:            peer.IPv4CIDR,
+		Ip:                  peer.IP,
+		State:               peer.State,
+		Details: &pb.CloudInformation{
+			ProviderName:       peer.Details.ProviderName,
+			RegionId:           peer.Details.RegionID,
+			AvailabilityZoneId: peer.Details.AvailabilityZoneID,
+			VirtualNetworkId:   peer.Details.VirtualNetworkID,
+			SubnetId:           peer.Details.SubnetID,
+		},
+	}
+}
+
 func (s *serverCloudAdaptiveNetwork) UpdateDetailsOfPeer(ctx context.Context, req *pb.UpdateDetailsRequest) (*pb.Peer, error) {
-	log.Printf("Received: %#v", req)
+	CBLogger.Debugf("Received: %#v", req)
 
 	// Check if the peer exists or not
 	peerReq := &pb.PeerRequest{
@@ -618,7 +653,7 @@ func (s *serverCloudAdaptiveNetwork) UpdateDetailsOfPeer(ctx context.Context, re
 		HostId:    req.HostId,
 	}
 
-	peer, err := s.GetPeer(context.TODO(), peerReq)
+	peer, err := s.GetPeer(ctx, peerReq)
 	if err != nil {
 		CBLogger.Errorf("%#v", err)
 		return &pb.Peer{}, err
@@ -644,23 +679,17 @@ func (s *serverCloudAdaptiveNetwork) UpdateDetailsOfPeer(ctx context.Context, re
 		},
 	}
 
-	peerBytes, _ := json.Marshal(tempPeer)
-	doc := string(peerBytes)
 	CBLogger.Tracef("Value: %#v", tempPeer)
 
-	size := binary.Size(peerBytes)
-	CBLogger.Tracef("PutRequest size (bytes): total_size: %v", size)
-
-	keyPeer := fmt.Sprint(etcdkey.Peer + "/" + peer.CladnetId + "/" + peer.HostId)
-	putResp, err := etcdClient.Put(context.Background(), keyPeer, doc)
+	CBLogger.Debugf("Put a peer - %v/%v", tempPeer.CladnetID, tempPeer.HostID)
+	err = cbnetStore.PutPeer(ctx, tempPeer)
 	if err != nil {
 		CBLogger.Error(err)
 		return &pb.Peer{}, status.Errorf(codes.Internal, "error while updating the peer: %v", err)
 	}
-	CBLogger.Debugf("PutResponse: %#v", putResp)
 
 	// Get and return the updated peer
-	peer, err = s.GetPeer(context.TODO(), peerReq)
+	peer, err = s.GetPeer(ctx, peerReq)
 	if err != nil {
 		return &pb.Peer{}, err
 	}
@@ -668,45 +697,136 @@ func (s *serverCloudAdaptiveNetwork) UpdateDetailsOfPeer(ctx context.Context, re
 }
 
 func (s *serverCloudAdaptiveNetwork) GetPeerNetworkingRule(ctx context.Context, req *pb.PeerRequest) (*pb.NetworkingRule, error) {
-	log.Printf("Received: %#v", req)
+	CBLogger.Debugf("Received: %#v", req)
 
 	// Get a peer's networking rule
-	keyNetworkingRuleOfPeer := fmt.Sprint(etcdkey.NetworkingRule + "/" + req.CladnetId + "/" + req.HostId)
-	CBLogger.Debugf("Get - %v", keyNetworkingRuleOfPeer)
-	respNetworkingRule, errEtcd := etcdClient.Get(context.Background(), keyNetworkingRuleOfPeer)
-	if errEtcd != nil {
-		CBLogger.Error(errEtcd)
-		return &pb.NetworkingRule{}, status.Errorf(codes.Internal, "error while getting a peer's networking rule: %v", errEtcd)
-	}
-	CBLogger.Tracef("GetResponse: %#v", respNetworkingRule)
-	totalSize, headerSize, kvsSize, kvsCount := extractSizes(*respNetworkingRule)
-	CBLogger.Tracef("GetResponse size (bytes): total_size: %v, header_size: %v, kvs_size: %v, kvs_count: %v", totalSize, headerSize, kvsSize, kvsCount)
-
-	// Unmarshal the networking rule if exists
-	CBLogger.Tracef("RespRule.Kvs: %v", respNetworkingRule.Kvs)
-	if respNetworkingRule.Count != 0 {
-		tempNetworkingRule := model.NetworkingRule{}
-		errUnmarshal := json.Unmarshal(respNetworkingRule.Kvs[0].Value, &tempNetworkingRule)
-		if errUnmarshal != nil {
-			CBLogger.Error(errUnmarshal)
-		}
-		CBLogger.Tracef("Peer: %v", tempNetworkingRule)
-
-		networkingRule := &pb.NetworkingRule{
-			CladnetId:  tempNetworkingRule.CladnetID,
-			HostId:     tempNetworkingRule.HostID,
-			HostName:   tempNetworkingRule.HostName,
-			PeerIp:     tempNetworkingRule.PeerIP,
-			SelectedIp: tempNetworkingRule.SelectedIP,
-			PeerScope:  tempNetworkingRule.PeerScope,
-			State:      tempNetworkingRule.State,
-		}
+	CBLogger.Debugf("Get a networking rule - %v/%v", req.CladnetId, req.HostId)
+	tempNetworkingRule, errStore := cbnetStore.GetRule(ctx, req.CladnetId, req.HostId)
+	if errors.Is(errStore, store.ErrNotFound) {
+		return &pb.NetworkingRule{}, status.Errorf(codes.NotFound, "not found the peer's networking rule by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+	}
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.NetworkingRule{}, status.Errorf(codes.Internal, "error while getting a peer's networking rule: %v", errStore)
+	}
+	CBLogger.Tracef("Peer: %v", tempNetworkingRule)
+
+	networkingRule := &pb.NetworkingRule{
+		CladnetId:  tempNetworkingRule.CladnetID,
+		HostId:     tempNetworkingRule.HostID,
+		HostName:   tempNetworkingRule.HostName,
+		PeerIp:     tempNetworkingRule.PeerIP,
+		SelectedIp: tempNetworkingRule.SelectedIP,
+		PeerScope:  tempNetworkingRule.PeerScope,
+		State:      tempNetworkingRule.State,
+	}
+
+	return networkingRule, status.New(codes.OK, "").Err()
+}
+
+func (s *serverCloudAdaptiveNetwork) CreateJoinToken(ctx context.Context, req *pb.JoinTokenRequest) (*pb.JoinToken, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	// Check if the Cloud Adaptive Network exists or not
+	cladnetReq := &pb.CLADNetRequest{
+		CladnetId: req.CladnetId,
+	}
+
+	if _, err := s.GetCLADNet(ctx, cladnetReq); err != nil {
+		return &pb.JoinToken{}, err
+	}
+
+	// Set time to live of the join token (default: 24 hours)
+	ttl := req.TtlSeconds
+	if ttl <= 0 {
+		ttl = defaultJoinTokenTTL
+	}
+
+	// Generate a join token
+	tokenID, secret, token, err := jointoken.Generate()
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.JoinToken{}, status.Errorf(codes.Internal, "error while generating a join token: %v", err)
+	}
+
+	now := time.Now()
+	joinToken := model.JoinToken{
+		TokenID:     tokenID,
+		CladnetID:   req.CladnetId,
+		SecretHash:  jointoken.HashSecret(secret),
+		Description: req.Description,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Duration(ttl) * time.Second),
+	}
+
+	CBLogger.Tracef("Value: %#v", joinToken)
+
+	// Put the join token, which vanishes when it expires
+	CBLogger.Debugf("Put a join token - %v/%v", req.CladnetId, tokenID)
+	err = cbnetStore.PutJoinToken(ctx, joinToken, time.Duration(ttl)*time.Second)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.JoinToken{}, status.Errorf(codes.Internal, "error while putting a join token: %v", err)
+	}
+
+	// The token is only returned on creation
+	return &pb.JoinToken{
+		TokenId:     joinToken.TokenID,
+		CladnetId:   joinToken.CladnetID,
+		Token:       token,
+		Description: joinToken.Description,
+		CreatedAt:   joinToken.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:   joinToken.ExpiresAt.Format(time.RFC3339),
+	}, status.New(codes.OK, "").Err()
+}
+
+func (s *serverCloudAdaptiveNetwork) GetJoinTokenList(ctx context.Context, req *pb.CLADNetRequest) (*pb.JoinTokens, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	// Get join tokens of a Cloud Adaptive Network
+	CBLogger.Debugf("Get join tokens - %v", req.CladnetId)
+	tempJoinTokens, errStore := cbnetStore.ListJoinTokens(ctx, req.CladnetId)
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return nil, status.Errorf(codes.Internal, "error while getting join tokens: %v", errStore)
+	}
+
+	joinTokens := &pb.JoinTokens{}
+
+	for _, tempJoinToken := range tempJoinTokens {
+		joinTokens.JoinTokens = append(joinTokens.JoinTokens, &pb.JoinToken{
+			TokenId:     tempJoinToken.TokenID,
+			CladnetId:   tempJoinToken.CladnetID,
+			Description: tempJoinToken.Description,
+			CreatedAt:   tempJoinToken.CreatedAt.Format(time.RFC3339),
+			ExpiresAt:   tempJoinToken.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+
+	return joinTokens, status.New(codes.OK, "").Err()
+}
 
-		return networkingRule, status.New(codes.OK, "").Err()
+func (s *serverCloudAdaptiveNetwork) RevokeJoinToken(ctx context.Context, req *pb.JoinTokenRequest) (*pb.JoinToken, error) {
+	CBLogger.Debugf("Received: %#v", req)
 
+	// Delete the join token and get the deleted one
+	CBLogger.Debugf("Delete a join token - %v/%v", req.CladnetId, req.TokenId)
+	tempJoinToken, errStore := cbnetStore.DeleteJoinToken(ctx, req.CladnetId, req.TokenId)
+	if errors.Is(errStore, store.ErrNotFound) {
+		return &pb.JoinToken{}, status.Errorf(codes.NotFound, "not found a join token by cladnetId (%+v) and tokenId (%+v)", req.CladnetId, req.TokenId)
+	}
+	if errStore != nil {
+		CBLogger.Error(errStore)
+		return &pb.JoinToken{}, status.Errorf(codes.Internal, "error while revoking a join token: %v", errStore)
 	}
 
-	return &pb.NetworkingRule{}, status.Errorf(codes.NotFound, "not found the peer's networking rule by cladnetId (%+v) and hostId (%+v)", req.CladnetId, req.HostId)
+	return &pb.JoinToken{
+		TokenId:     req.TokenId,
+		CladnetId:   req.CladnetId,
+		Description: tempJoinToken.Description,
+		CreatedAt:   tempJoinToken.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:   tempJoinToken.ExpiresAt.Format(time.RFC3339),
+	}, status.New(codes.OK, "").Err()
 }
 
 // If "Content-Type: application/grpc", use gRPC server handler,
@@ -722,6 +842,15 @@ func grpcHandler(grpcServer *grpc.Server, otherHandler http.Handler) http.Handle
 }
 
 func main() {
+	setUp()
+
+	// Fail closed: refuse to start without TLS and API keys, unless "insecure" is set explicitly
+	if err := grpcauth.CheckServerConfig(config.Service); err != nil {
+		CBLogger.Fatal(err)
+	}
+	if config.Service.Insecure {
+		CBLogger.Warn("The service is insecure (i.e., 'insecure: true'), so it may run without TLS or API keys")
+	}
 
 	// Set web assets path to the current directory (usually for the production)
 	ex, err := os.Executable()
@@ -746,17 +875,15 @@ func main() {
 	}
 
 	//// etcd section
-	etcdClient, err = clientv3.New(clientv3.Config{
-		Endpoints:   config.ETCD.Endpoints,
-		DialTimeout: 5 * time.Second,
-	})
+	etcdClient, err := etcdclient.New(config.ETCD)
 
 	if err != nil {
 		CBLogger.Fatal(err)
 	}
 
+	cbnetStore = store.NewEtcdStore(etcdClient)
 	defer func() {
-		errClose := etcdClient.Close()
+		errClose := cbnetStore.Close()
 		if errClose != nil {
 			CBLogger.Fatal("Can't close the etcd client", errClose)
 		}
@@ -764,6 +891,32 @@ func main() {
 
 	CBLogger.Infoln("The etcdClient is connected.")
 
+	// Check the schema version of the registry, which must be migrated by 'cb-network migrate' if it is older
+	if err := migration.CheckSchemaVersion(context.TODO(), cbnetStore); err != nil {
+		CBLogger.Fatal(err)
+	}
+
+	// Export the spans of the workflows (e.g., registering an agent), if the exporter is configured
+	shutdownTracing, err := tracing.Init(context.TODO(), "cb-network-service", config.Tracing)
+	if err != nil {
+		CBLogger.Fatal(err)
+	}
+	defer func() {
+		if errShutdown := shutdownTracing(context.Background()); errShutdown != nil {
+			CBLogger.Error(errShutdown)
+		}
+	}()
+
+	// Serve the metrics (e.g., RPC latency and etcd request sizes), if the listen address is configured
+	if config.Metrics.ListenAddress != "" {
+		go func() {
+			CBLogger.Infof("Serving the metrics on %v/metrics", config.Metrics.ListenAddress)
+			if err := metrics.ListenAndServe(config.Metrics.ListenAddress); err != nil {
+				CBLogger.Error(err)
+			}
+		}()
+	}
+
 	//// gRPC and REST service section
 
 	// Multiplexer (mux) to handle requests for gRPC, REST, and Swagger dashboards respectively
@@ -772,11 +925,27 @@ func main() {
 	// incoming request against a list of registered patterns and calls the
 	// handler for the pattern that most closely matches the URL.
 
+	// Authenticate tokens (API keys) and authorize access to CLADNets, if API keys are configured
+	// NOTE - The tracing and metrics interceptors come first to observe the RPCs rejected by the authorizer as well
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(), unaryMetricsInterceptor),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(), streamMetricsInterceptor),
+	}
+	if len(config.Service.Auth.APIKeys) > 0 {
+		authorizer := grpcauth.NewAuthorizer(config.Service.Auth.APIKeys, healthFullMethod, agentServicePrefix)
+		serverOptions = append(serverOptions,
+			grpc.ChainUnaryInterceptor(authorizer.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authorizer.StreamServerInterceptor()),
+		)
+		CBLogger.Infof("Token-based authentication is enabled (API keys: %v)", len(config.Service.Auth.APIKeys))
+	}
+
 	// Create a gRPC server object
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(serverOptions...)
 	// Attach the CloudAdaptiveNetwork service to the server
 	pb.RegisterSystemManagementServiceServer(grpcServer, &serverSystemManagement{})
 	pb.RegisterCloudAdaptiveNetworkServiceServer(grpcServer, &serverCloudAdaptiveNetwork{})
+	pb.RegisterAgentServiceServer(grpcServer, &serverAgent{})
 
 	// Create echo server to provide Swagger dashboard
 	e := echo.New()
@@ -818,6 +987,42 @@ func main() {
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	}
 
+	// Configure TLS (or mTLS) of the server and the gRPC Gateway connecting to the server
+	var serverTLSConfig *tls.Config
+	scheme := "http"
+	if config.Service.TLS.Enabled {
+		tlsConfig := config.Service.TLS
+		serverTLSConfig, err = tlsutil.NewServerConfig(tlsConfig.CertFile, tlsConfig.KeyFile, tlsConfig.CAFile, tlsConfig.ClientAuth)
+		if err != nil {
+			CBLogger.Fatalf("Failed to configure TLS: %v", err)
+		}
+
+		// The gRPC Gateway presents the client certificate (or the server certificate) in mTLS
+		certFile, keyFile := "", ""
+		if tlsConfig.ClientAuth {
+			certFile, keyFile = tlsConfig.CertFile, tlsConfig.KeyFile
+			if tlsConfig.ClientCertFile != "" {
+				certFile, keyFile = tlsConfig.ClientCertFile, tlsConfig.ClientKeyFile
+			}
+		}
+
+		serverName := tlsConfig.ServerName
+		if serverName == "" {
+			serverName, _, _ = net.SplitHostPort(config.Service.Endpoint)
+		}
+
+		gatewayTLSConfig, err := tlsutil.NewClientConfig(tlsConfig.CAFile, certFile, keyFile, serverName)
+		if err != nil {
+			CBLogger.Fatalf("Failed to configure TLS of the gateway: %v", err)
+		}
+
+		options = []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(gatewayTLSConfig)),
+		}
+		scheme = "https"
+		CBLogger.Infof("TLS is enabled (mTLS: %v)", tlsConfig.ClientAuth)
+	}
+
 	addr := fmt.Sprintf(":%s", config.Service.Port)
 	err = pb.RegisterSystemManagementServiceHandlerFromEndpoint(context.Background(), gwmux, addr, options)
 	if err != nil {
@@ -833,7 +1038,7 @@ func main() {
 	mux.Handle("/", gwmux)
 
 	// Display API documents (gRPC protocol documentation, REST API documentation by Swagger)
-	swaggerURL := fmt.Sprintf("http://%s/swagger/index.html", config.Service.Endpoint)
+	swaggerURL := fmt.Sprintf("%s://%s/swagger/index.html", scheme, config.Service.Endpoint)
 	grpcDocURL := "https://github.com/cloud-barista/cb-larva/blob/main/poc-cb-net/docs/cloud-barista-network-service.md"
 
 	CBLogger.Infof("Serving gRPC-Gateway(gRPC, REST), Swagger dashboard on %v", addr)
@@ -848,22 +1053,18 @@ func main() {
 	fmt.Println("")
 
 	// Serve gRPC server and gRPC Gateway by "grpcHandler"
-	err = http.ListenAndServe(addr, grpcHandler(grpcServer, mux))
-	if err != nil {
-		CBLogger.Fatalf("Failed to listen and serve: %v", err)
+	server := &http.Server{
+		Addr:      addr,
+		Handler:   grpcHandler(grpcServer, mux),
+		TLSConfig: serverTLSConfig,
 	}
-}
 
-func extractSizes(res clientv3.GetResponse) (totalSize, headerSize, kvsSize, kvsCount int) {
-
-	headerSize = res.Header.Size()
-	kvsCount = int(res.Count)
-	kvsSize = 0
-	for _, kv := range res.Kvs {
-		kvsSize += kv.Size()
+	if serverTLSConfig != nil {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil {
+		CBLogger.Fatalf("Failed to listen and serve: %v", err)
 	}
-
-	totalSize = headerSize + kvsSize
-
-	return totalSize, headerSize, kvsSize, kvsCount
 }
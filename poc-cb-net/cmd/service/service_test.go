@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateCLADNet(t *testing.T) {
+	setUpStore(t)
+	server := &serverCloudAdaptiveNetwork{}
+
+	tests := []struct {
+		name     string
+		spec     *pb.CLADNetSpecification
+		wantCode codes.Code
+	}{
+		{name: "new", spec: &pb.CLADNetSpecification{CladnetId: "cladnet-02", Ipv4AddressSpace: "10.1.0.0/24"}, wantCode: codes.OK},
+		{name: "existing", spec: &pb.CLADNetSpecification{CladnetId: "cladnet-02", Ipv4AddressSpace: "10.2.0.0/24"}, wantCode: codes.AlreadyExists},
+		{name: "existing with the same address space", spec: &pb.CLADNetSpecification{CladnetId: "cladnet-01", Ipv4AddressSpace: "10.0.0.0/24"}, wantCode: codes.AlreadyExists},
+		{name: "invalid ID", spec: &pb.CLADNetSpecification{CladnetId: "cladnet/03", Ipv4AddressSpace: "10.3.0.0/24"}, wantCode: codes.InvalidArgument},
+		{name: "generated ID", spec: &pb.CLADNetSpecification{Ipv4AddressSpace: "10.4.0.0/24"}, wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.CreateCLADNet(context.Background(), tt.spec)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("CreateCLADNet() error = %v, want %v", err, tt.wantCode)
+			}
+		})
+	}
+
+	// The existing one is not overwritten
+	spec, err := cbnetStore.GetSpec(context.Background(), "cladnet-02")
+	if err != nil || spec.Ipv4AddressSpace != "10.1.0.0/24" {
+		t.Errorf("GetSpec() = %+v, %v, want 10.1.0.0/24", spec, err)
+	}
+}
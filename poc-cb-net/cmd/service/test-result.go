@@ -0,0 +1,557 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	nettest "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-test"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	testtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/test-type"
+	"github.com/rs/xid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultTestResultRetention is the period to keep the test runs and their results if it is not configured
+const defaultTestResultRetention = 7 * 24 * time.Hour
+
+// Timeouts to wait for the results of a test run
+const (
+	defaultTestWaitTimeout = 60 * time.Second
+	maxTestWaitTimeout     = 10 * time.Minute
+)
+
+func testResultRetention() time.Duration {
+	if config.Service.TestResultRetention > 0 {
+		return config.Service.TestResultRetention
+	}
+	return defaultTestResultRetention
+}
+
+// newTestRun puts a test run to the peers, which is kept for the retention period
+func newTestRun(ctx context.Context, cladnetID string, testType string, testSpec string, label string, peers []model.Peer) (model.TestRun, error) {
+	testRun := model.TestRun{
+		CladnetID:   cladnetID,
+		RunID:       xid.New().String(),
+		TestType:    testType,
+		TestSpec:    testSpec,
+		Label:       label,
+		HostIDs:     make([]string, 0, len(peers)),
+		RequestedAt: time.Now(),
+	}
+
+	// Record the rule type to compare the runs before and after switching it
+	if spec, err := cbnetStore.GetSpec(ctx, cladnetID); err == nil {
+		testRun.RuleType = spec.RuleType
+	}
+
+	for _, peer := range peers {
+		testRun.HostIDs = append(testRun.HostIDs, peer.HostID)
+	}
+
+	CBLogger.Debugf("Put a test run - %v/%v", cladnetID, testRun.RunID)
+	err := cbnetStore.PutTestRun(ctx, testRun, testResultRetention())
+	return testRun, err
+}
+
+// retainTestResult puts the result of an agent in a test run, which is kept as long as the test run
+func retainTestResult(ctx context.Context, cladnetID string, networkStatus model.NetworkStatus) error {
+	if networkStatus.RunID == "" {
+		return nil
+	}
+
+	testRun, err := cbnetStore.GetTestRun(ctx, cladnetID, networkStatus.RunID)
+	if errors.Is(err, store.ErrNotFound) {
+		CBLogger.Warnf("not found the test run (%v), so the result is not retained", networkStatus.RunID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(testRun.RequestedAt.Add(testResultRetention()))
+	if ttl < time.Second {
+		return nil
+	}
+
+	CBLogger.Debugf("Put a test result - %v/%v/%v", cladnetID, networkStatus.RunID, networkStatus.HostID)
+	return cbnetStore.PutTestResult(ctx, cladnetID, networkStatus, ttl)
+}
+
+func testWaitTimeout(seconds int64) (time.Duration, error) {
+	timeout := time.Duration(seconds) * time.Second
+	if timeout < 0 || timeout > maxTestWaitTimeout {
+		return 0, status.Errorf(codes.InvalidArgument, "timeout (%v) must be between 0 and %v seconds", seconds, maxTestWaitTimeout.Seconds())
+	}
+	if timeout == 0 {
+		timeout = defaultTestWaitTimeout
+	}
+	return timeout, nil
+}
+
+// waitForTestResults calls onResult for the result of each targeted agent in a test run as reported,
+// until all targeted agents report the results or the timeout. It returns the host IDs of the agents timed out.
+func waitForTestResults(ctx context.Context, testRun model.TestRun, timeout time.Duration, onResult func(result model.NetworkStatus) error) ([]string, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pending := make(map[string]bool)
+	for _, hostID := range testRun.HostIDs {
+		pending[hostID] = true
+	}
+
+	report := func(result model.NetworkStatus) error {
+		if !pending[result.HostID] {
+			return nil
+		}
+		delete(pending, result.HostID)
+		return onResult(result)
+	}
+
+	// Watch before listing, so that no result is missed in between
+	CBLogger.Debugf("Watch the test results - %v/%v", testRun.CladnetID, testRun.RunID)
+	watchChan := cbnetStore.WatchTestResults(waitCtx, testRun.CladnetID, testRun.RunID)
+	isListed := false
+
+	for len(pending) > 0 {
+		resp, ok := <-watchChan
+		if !ok {
+			break
+		}
+		if resp.Err != nil {
+			if waitCtx.Err() != nil {
+				break
+			}
+			CBLogger.Error(resp.Err)
+			return nil, status.Errorf(codes.Internal, "error while watching the test results: %v", resp.Err)
+		}
+
+		if !isListed {
+			isListed = true
+			results, err := cbnetStore.ListTestResults(waitCtx, testRun.CladnetID, testRun.RunID)
+			if err != nil {
+				CBLogger.Error(err)
+				return nil, status.Errorf(codes.Internal, "error while listing the test results: %v", err)
+			}
+			for _, result := range results {
+				if err := report(result); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for _, event := range resp.Events {
+			if event.Type != store.EventPut {
+				continue
+			}
+			var result model.NetworkStatus
+			if err := json.Unmarshal(event.Value, &result); err != nil {
+				CBLogger.Error(err)
+				continue
+			}
+			if err := report(result); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The caller (e.g., a client of the stream) is gone
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	timedOut := make([]string, 0, len(pending))
+	for hostID := range pending {
+		timedOut = append(timedOut, hostID)
+	}
+	sort.Strings(timedOut)
+	if len(timedOut) > 0 {
+		CBLogger.Debugf("Timed out the test run - %v/%v: %v", testRun.CladnetID, testRun.RunID, timedOut)
+	}
+
+	return timedOut, nil
+}
+
+func testResultToPB(result model.NetworkStatus) *pb.TestResult {
+	networkStatus, _ := json.Marshal(result)
+	return &pb.TestResult{
+		HostId:        result.HostID,
+		NetworkStatus: string(networkStatus),
+	}
+}
+
+func testRunToPB(testRun model.TestRun) *pb.TestRun {
+	return &pb.TestRun{
+		CladnetId:   testRun.CladnetID,
+		RunId:       testRun.RunID,
+		TestType:    pb.TestType(pb.TestType_value[testRun.TestType]),
+		TestSpec:    testRun.TestSpec,
+		Label:       testRun.Label,
+		RuleType:    testRun.RuleType,
+		HostIds:     testRun.HostIDs,
+		RequestedAt: formatTime(testRun.RequestedAt),
+	}
+}
+
+// getTestRunAndResults gets a test run and the results reported by the agents
+func getTestRunAndResults(ctx context.Context, cladnetID string, runID string) (model.TestRun, []model.NetworkStatus, error) {
+	if cladnetID == "" || runID == "" {
+		return model.TestRun{}, nil, status.Errorf(codes.InvalidArgument, "cladnetId (%+v) and runId (%+v) are required", cladnetID, runID)
+	}
+
+	CBLogger.Debugf("Get a test run - %v/%v", cladnetID, runID)
+	testRun, err := cbnetStore.GetTestRun(ctx, cladnetID, runID)
+	if errors.Is(err, store.ErrNotFound) {
+		return testRun, nil, status.Errorf(codes.NotFound, "not found the test run by cladnetId (%+v) and runId (%+v)", cladnetID, runID)
+	}
+	if err != nil {
+		CBLogger.Error(err)
+		return testRun, nil, status.Errorf(codes.Internal, "error while getting the test run: %v", err)
+	}
+
+	CBLogger.Debugf("Get the test results - %v/%v", cladnetID, runID)
+	results, err := cbnetStore.ListTestResults(ctx, cladnetID, runID)
+	if err != nil {
+		CBLogger.Error(err)
+		return testRun, nil, status.Errorf(codes.Internal, "error while listing the test results: %v", err)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].HostID < results[j].HostID
+	})
+
+	return testRun, results, nil
+}
+
+// pairMetrics represents the metrics from a source to a destination in a test result
+type pairMetrics struct {
+	model.HostPair
+	metrics map[string]float64
+	err     string
+}
+
+// metricsOf flattens the typed results of a network status into the metrics of each host pair.
+// The metric names are the JSON field names of the results (e.g., "averageRTT"),
+// and "open/{port}" (1 or 0) and "latency/{port}" for PORT_REACHABILITY.
+func metricsOf(networkStatus model.NetworkStatus) []pairMetrics {
+	var pairs []pairMetrics
+
+	switch networkStatus.TestType {
+	case testtype.Throughput:
+		for _, result := range networkStatus.Throughput {
+			pair := pairMetrics{HostPair: result.HostPair, err: result.Error, metrics: map[string]float64{
+				"bitsPerSecond": result.BitsPerSecond,
+				"bytesReceived": float64(result.BytesReceived),
+			}}
+			if result.Protocol == "udp" {
+				pair.metrics["packetLoss"] = float64(result.PacketsLoss)
+			}
+			pairs = append(pairs, pair)
+		}
+
+	case testtype.PathMTU:
+		for _, result := range networkStatus.PathMTU {
+			pairs = append(pairs, pairMetrics{HostPair: result.HostPair, err: result.Error, metrics: map[string]float64{
+				"pathMtu": float64(result.PathMTU),
+			}})
+		}
+
+	case testtype.Jitter:
+		for _, result := range networkStatus.Jitter {
+			pairs = append(pairs, pairMetrics{HostPair: result.HostPair, err: result.Error, metrics: map[string]float64{
+				"jitter":     result.Jitter,
+				"packetLoss": float64(result.PacketsLoss),
+				"outOfOrder": float64(result.OutOfOrder),
+			}})
+		}
+
+	case testtype.PortReachability:
+		// The ports of a host pair are merged
+		index := make(map[string]int)
+		for _, result := range networkStatus.PortReachability {
+			i, ok := index[result.DestinationIP]
+			if !ok {
+				i = len(pairs)
+				index[result.DestinationIP] = i
+				pairs = append(pairs, pairMetrics{HostPair: result.HostPair, metrics: make(map[string]float64)})
+			}
+
+			port := strconv.Itoa(result.Port)
+			if result.Error != "" {
+				pairs[i].err = fmt.Sprintf("port %v: %v", port, result.Error)
+				continue
+			}
+			open := 0.0
+			if result.State == nettest.PortOpen {
+				open = 1
+			}
+			pairs[i].metrics["open/"+port] = open
+			pairs[i].metrics["latency/"+port] = result.Latency
+		}
+
+	case testtype.UnderlayOverlay:
+		for _, result := range networkStatus.UnderlayOverlay {
+			pairs = append(pairs, pairMetrics{HostPair: result.HostPair, err: result.Error, metrics: map[string]float64{
+				"underlayAverageRTT": result.UnderlayAverageRTT,
+				"underlayPacketLoss": float64(result.UnderlayPacketLoss),
+				"overlayAverageRTT":  result.OverlayAverageRTT,
+				"overlayPacketLoss":  float64(result.OverlayPacketLoss),
+				"overheadRTT":        result.OverheadRTT,
+			}})
+		}
+
+	default: // CONNECTIVITY
+		for _, result := range networkStatus.InterHostNetworkStatus {
+			pairs = append(pairs, pairMetrics{
+				HostPair: model.HostPair{
+					SourceIP:        result.SourceIP,
+					SourceName:      result.SourceName,
+					DestinationIP:   result.DestinationIP,
+					DestinationName: result.DestinationName,
+				},
+				metrics: map[string]float64{
+					"minimunRTT": result.MininumRTT,
+					"averageRTT": result.AverageRTT,
+					"maximumRTT": result.MaximumRTT,
+					"stddevRTT":  result.StdDevRTT,
+					"packetLoss": float64(result.PacketsLoss),
+				},
+			})
+		}
+	}
+
+	return pairs
+}
+
+// buildTestResultMatrix builds an N x N matrix of the hosts, i.e., the targeted agents and the destinations in the results
+func buildTestResultMatrix(testRun model.TestRun, results []model.NetworkStatus, peers []model.Peer) *pb.TestResultMatrix {
+	matrix := &pb.TestResultMatrix{Run: testRunToPB(testRun)}
+
+	hostIndex := make(map[string]int)
+	addHost := func(ip string, name string) int {
+		if i, ok := hostIndex[ip]; ok {
+			return i
+		}
+		hostIndex[ip] = len(matrix.HostIps)
+		matrix.HostIps = append(matrix.HostIps, ip)
+		matrix.HostNames = append(matrix.HostNames, name)
+		matrix.Rows = append(matrix.Rows, &pb.TestResultRow{SourceIp: ip, SourceName: name})
+		return hostIndex[ip]
+	}
+
+	// The sources first (by the results, or by the peers if not reported yet)
+	reported := make(map[string][]pairMetrics)
+	for _, result := range results {
+		pairs := metricsOf(result)
+		reported[result.HostID] = pairs
+
+		ip, name := "", result.HostID
+		if len(pairs) > 0 {
+			ip, name = pairs[0].SourceIP, pairs[0].SourceName
+		} else {
+			for _, peer := range peers {
+				if peer.HostID == result.HostID {
+					ip, name = peer.IP, peer.HostName
+				}
+			}
+		}
+
+		row := matrix.Rows[addHost(ip, name)]
+		row.HostId = result.HostID
+		row.IsEncrypted = result.IsEncrypted
+		row.ReportedAt = formatTime(result.ReportedAt)
+	}
+
+	for _, hostID := range testRun.HostIDs {
+		if _, ok := reported[hostID]; ok {
+			continue
+		}
+		matrix.PendingHostIds = append(matrix.PendingHostIds, hostID)
+		for _, peer := range peers {
+			if peer.HostID == hostID && peer.IP != "" {
+				matrix.Rows[addHost(peer.IP, peer.HostName)].HostId = hostID
+			}
+		}
+	}
+
+	// The destinations which are not the sources (e.g., the peers joined after the run)
+	for _, result := range results {
+		for _, pair := range reported[result.HostID] {
+			addHost(pair.DestinationIP, pair.DestinationName)
+		}
+	}
+
+	for _, row := range matrix.Rows {
+		row.Cells = make([]*pb.TestResultCell, len(matrix.HostIps))
+		for i, ip := range matrix.HostIps {
+			row.Cells[i] = &pb.TestResultCell{DestinationIp: ip, DestinationName: matrix.HostNames[i]}
+		}
+	}
+	for _, result := range results {
+		for _, pair := range reported[result.HostID] {
+			cell := matrix.Rows[hostIndex[pair.SourceIP]].Cells[hostIndex[pair.DestinationIP]]
+			cell.Metrics = pair.metrics
+			cell.Error = pair.err
+		}
+	}
+
+	return matrix
+}
+
+// compareTestResults compares the metrics of the host pairs in both test runs (except the pairs with an error)
+func compareTestResults(baseResults []model.NetworkStatus, targetResults []model.NetworkStatus) ([]*pb.MetricSummary, []*pb.MetricComparison) {
+	type pairKey struct {
+		source      string
+		destination string
+	}
+
+	targetPairs := make(map[pairKey]pairMetrics)
+	for _, result := range targetResults {
+		for _, pair := range metricsOf(result) {
+			if pair.err == "" {
+				targetPairs[pairKey{pair.SourceIP, pair.DestinationIP}] = pair
+			}
+		}
+	}
+
+	var comparisons []*pb.MetricComparison
+	summaries := make(map[string]*pb.MetricSummary)
+	for _, result := range baseResults {
+		for _, base := range metricsOf(result) {
+			target, ok := targetPairs[pairKey{base.SourceIP, base.DestinationIP}]
+			if base.err != "" || !ok {
+				continue
+			}
+
+			names := make([]string, 0, len(base.metrics))
+			for name := range base.metrics {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				targetValue, ok := target.metrics[name]
+				if !ok {
+					continue
+				}
+				baseValue := base.metrics[name]
+
+				comparison := &pb.MetricComparison{
+					SourceIp:        base.SourceIP,
+					SourceName:      base.SourceName,
+					DestinationIp:   base.DestinationIP,
+					DestinationName: base.DestinationName,
+					Metric:          name,
+					Base:            baseValue,
+					Target:          targetValue,
+					Delta:           targetValue - baseValue,
+				}
+				if baseValue != 0 {
+					comparison.DeltaRatio = comparison.Delta / baseValue
+				}
+				comparisons = append(comparisons, comparison)
+
+				summary, ok := summaries[name]
+				if !ok {
+					summary = &pb.MetricSummary{Metric: name}
+					summaries[name] = summary
+				}
+				summary.Pairs++
+				summary.BaseMean += baseValue
+				summary.TargetMean += targetValue
+			}
+		}
+	}
+
+	summaryList := make([]*pb.MetricSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		summary.BaseMean /= float64(summary.Pairs)
+		summary.TargetMean /= float64(summary.Pairs)
+		summary.Delta = summary.TargetMean - summary.BaseMean
+		summaryList = append(summaryList, summary)
+	}
+	sort.Slice(summaryList, func(i, j int) bool {
+		return summaryList[i].Metric < summaryList[j].Metric
+	})
+
+	return summaryList, comparisons
+}
+
+func (s *serverSystemManagement) GetTestRunList(ctx context.Context, req *pb.CLADNetRequest) (*pb.TestRuns, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	if req.CladnetId == "" {
+		return &pb.TestRuns{}, status.Errorf(codes.InvalidArgument, "cladnetId is required")
+	}
+
+	CBLogger.Debugf("Get the test runs - %v", req.CladnetId)
+	testRunList, err := cbnetStore.ListTestRuns(ctx, req.CladnetId)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.TestRuns{}, status.Errorf(codes.Internal, "error while listing the test runs: %v", err)
+	}
+
+	// The latest first
+	sort.Slice(testRunList, func(i, j int) bool {
+		return testRunList[i].RequestedAt.After(testRunList[j].RequestedAt)
+	})
+
+	testRuns := &pb.TestRuns{}
+	for _, testRun := range testRunList {
+		testRuns.Runs = append(testRuns.Runs, testRunToPB(testRun))
+	}
+
+	return testRuns, status.New(codes.OK, "").Err()
+}
+
+func (s *serverSystemManagement) GetTestResultMatrix(ctx context.Context, req *pb.TestRunRequest) (*pb.TestResultMatrix, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	testRun, results, err := getTestRunAndResults(ctx, req.CladnetId, req.RunId)
+	if err != nil {
+		return &pb.TestResultMatrix{}, err
+	}
+
+	// The peers to show the targeted agents which have not reported yet
+	CBLogger.Debugf("Get peers - %v", req.CladnetId)
+	peers, err := cbnetStore.ListPeers(ctx, req.CladnetId)
+	if err != nil {
+		CBLogger.Error(err)
+		return &pb.TestResultMatrix{}, status.Errorf(codes.Internal, "error while getting the peers: %v", err)
+	}
+
+	return buildTestResultMatrix(testRun, results, peers), status.New(codes.OK, "").Err()
+}
+
+func (s *serverSystemManagement) CompareTestRuns(ctx context.Context, req *pb.TestRunComparisonRequest) (*pb.TestRunComparison, error) {
+	CBLogger.Debugf("Received: %#v", req)
+
+	baseRun, baseResults, err := getTestRunAndResults(ctx, req.CladnetId, req.BaseRunId)
+	if err != nil {
+		return &pb.TestRunComparison{}, err
+	}
+	targetRun, targetResults, err := getTestRunAndResults(ctx, req.CladnetId, req.TargetRunId)
+	if err != nil {
+		return &pb.TestRunComparison{}, err
+	}
+
+	if baseRun.TestType != targetRun.TestType {
+		return &pb.TestRunComparison{}, status.Errorf(codes.InvalidArgument, "the test types of the runs are different (%v and %v)",
+			baseRun.TestType, targetRun.TestType)
+	}
+
+	comparison := &pb.TestRunComparison{
+		Base:   testRunToPB(baseRun),
+		Target: testRunToPB(targetRun),
+	}
+	comparison.Summaries, comparison.Comparisons = compareTestResults(baseResults, targetResults)
+
+	return comparison, status.New(codes.OK, "").Err()
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	testtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/test-type"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// connectivityResult returns a CONNECTIVITY result of a host, having the average RTT to each destination IP
+func connectivityResult(hostID string, sourceIP string, averageRTT map[string]float64) model.NetworkStatus {
+	destinations := make([]string, 0, len(averageRTT))
+	for destinationIP := range averageRTT {
+		destinations = append(destinations, destinationIP)
+	}
+	sort.Strings(destinations)
+
+	result := model.NetworkStatus{TestType: testtype.Connectivity, HostID: hostID}
+	for _, destinationIP := range destinations {
+		result.InterHostNetworkStatus = append(result.InterHostNetworkStatus, model.InterHostNetworkStatus{
+			SourceIP:      sourceIP,
+			SourceName:    hostID,
+			DestinationIP: destinationIP,
+			AverageRTT:    averageRTT[destinationIP],
+		})
+	}
+	return result
+}
+
+func TestBuildTestResultMatrix(t *testing.T) {
+	testRun := model.TestRun{CladnetID: "cladnet-01", RunID: "run-01", TestType: testtype.Connectivity,
+		HostIDs: []string{"host-01", "host-02", "host-03"}}
+	peers := []model.Peer{
+		{HostID: "host-01", HostName: "host-01", IP: "10.0.0.2"},
+		{HostID: "host-02", HostName: "host-02", IP: "10.0.0.3"},
+		{HostID: "host-03", HostName: "host-03", IP: "10.0.0.4"},
+		{HostID: "host-04", HostName: "host-04", IP: "10.0.0.5"}, // Joined after the run
+	}
+
+	tests := []struct {
+		name        string
+		results     []model.NetworkStatus
+		wantHostIPs []string
+		wantPending []string
+		wantRTT     map[string]float64 // by "source>destination", and the others have no metrics
+	}{
+		{
+			name: "all reported",
+			results: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 1, "10.0.0.4": 2}),
+				connectivityResult("host-02", "10.0.0.3", map[string]float64{"10.0.0.2": 3, "10.0.0.4": 4}),
+				connectivityResult("host-03", "10.0.0.4", map[string]float64{"10.0.0.2": 5, "10.0.0.3": 6}),
+			},
+			wantHostIPs: []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"},
+			wantRTT: map[string]float64{
+				"10.0.0.2>10.0.0.3": 1, "10.0.0.2>10.0.0.4": 2,
+				"10.0.0.3>10.0.0.2": 3, "10.0.0.3>10.0.0.4": 4,
+				"10.0.0.4>10.0.0.2": 5, "10.0.0.4>10.0.0.3": 6,
+			},
+		},
+		{
+			name: "missing pairs and pending hosts",
+			results: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 1}),
+			},
+			wantHostIPs: []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"},
+			wantPending: []string{"host-02", "host-03"},
+			wantRTT:     map[string]float64{"10.0.0.2>10.0.0.3": 1},
+		},
+		{
+			name:        "nothing reported",
+			wantHostIPs: []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"},
+			wantPending: []string{"host-01", "host-02", "host-03"},
+		},
+		{
+			name: "reported without pairs",
+			results: []model.NetworkStatus{
+				connectivityResult("host-02", "", nil),
+			},
+			wantHostIPs: []string{"10.0.0.3", "10.0.0.2", "10.0.0.4"},
+			wantPending: []string{"host-01", "host-03"},
+		},
+		{
+			name: "destination not targeted",
+			results: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.5": 7}),
+			},
+			wantHostIPs: []string{"10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5"},
+			wantPending: []string{"host-02", "host-03"},
+			wantRTT:     map[string]float64{"10.0.0.2>10.0.0.5": 7},
+		},
+		{
+			name: "duplicate results of a host",
+			results: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 1}),
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 8}),
+			},
+			wantHostIPs: []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"},
+			wantPending: []string{"host-02", "host-03"},
+			wantRTT:     map[string]float64{"10.0.0.2>10.0.0.3": 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matrix := buildTestResultMatrix(testRun, tt.results, peers)
+
+			if !reflect.DeepEqual(matrix.HostIps, tt.wantHostIPs) {
+				t.Fatalf("HostIps = %v, want %v", matrix.HostIps, tt.wantHostIPs)
+			}
+			if !reflect.DeepEqual(matrix.PendingHostIds, tt.wantPending) {
+				t.Errorf("PendingHostIds = %v, want %v", matrix.PendingHostIds, tt.wantPending)
+			}
+			if len(matrix.Rows) != len(tt.wantHostIPs) {
+				t.Fatalf("%d rows, want %d", len(matrix.Rows), len(tt.wantHostIPs))
+			}
+
+			for i, row := range matrix.Rows {
+				if row.SourceIp != tt.wantHostIPs[i] || len(row.Cells) != len(tt.wantHostIPs) {
+					t.Fatalf("row %d = %v with %d cells, want %v with %d cells", i, row.SourceIp, len(row.Cells), tt.wantHostIPs[i], len(tt.wantHostIPs))
+				}
+				for j, cell := range row.Cells {
+					key := row.SourceIp + ">" + cell.DestinationIp
+					wantRTT, ok := tt.wantRTT[key]
+					if !ok {
+						if len(cell.Metrics) != 0 {
+							t.Errorf("the metrics of %v = %v, want none", key, cell.Metrics)
+						}
+						continue
+					}
+					if cell.DestinationIp != tt.wantHostIPs[j] || cell.Metrics["averageRTT"] != wantRTT {
+						t.Errorf("the average RTT of %v = %v, want %v", key, cell.Metrics["averageRTT"], wantRTT)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCompareTestResults(t *testing.T) {
+	type comparison struct {
+		base, target, delta, deltaRatio float64
+	}
+	type summary struct {
+		pairs                       int32
+		baseMean, targetMean, delta float64
+	}
+
+	baseResults := []model.NetworkStatus{
+		connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 10, "10.0.0.4": 20}),
+		connectivityResult("host-02", "10.0.0.3", map[string]float64{"10.0.0.2": 30}),
+	}
+
+	tests := []struct {
+		name            string
+		base            []model.NetworkStatus
+		target          []model.NetworkStatus
+		wantComparisons map[string]comparison // The average RTTs by "source>destination"
+		wantSummary     *summary              // The average RTTs (nil if no pair is compared)
+	}{
+		{
+			name: "regression and improvement",
+			base: baseResults,
+			target: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 15, "10.0.0.4": 10}),
+				connectivityResult("host-02", "10.0.0.3", map[string]float64{"10.0.0.2": 30}),
+			},
+			wantComparisons: map[string]comparison{
+				"10.0.0.2>10.0.0.3": {base: 10, target: 15, delta: 5, deltaRatio: 0.5},
+				"10.0.0.2>10.0.0.4": {base: 20, target: 10, delta: -10, deltaRatio: -0.5},
+				"10.0.0.3>10.0.0.2": {base: 30, target: 30},
+			},
+			wantSummary: &summary{pairs: 3, baseMean: 20, targetMean: 55.0 / 3, delta: 55.0/3 - 20},
+		},
+		{
+			name: "missing pairs",
+			base: baseResults,
+			target: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 20, "10.0.0.5": 40}),
+			},
+			wantComparisons: map[string]comparison{
+				"10.0.0.2>10.0.0.3": {base: 10, target: 20, delta: 10, deltaRatio: 1},
+			},
+			wantSummary: &summary{pairs: 1, baseMean: 10, targetMean: 20, delta: 10},
+		},
+		{
+			name:            "no target results",
+			base:            baseResults,
+			wantComparisons: map[string]comparison{},
+		},
+		{
+			name:   "duplicate runs",
+			base:   baseResults,
+			target: baseResults,
+			wantComparisons: map[string]comparison{
+				"10.0.0.2>10.0.0.3": {base: 10, target: 10},
+				"10.0.0.2>10.0.0.4": {base: 20, target: 20},
+				"10.0.0.3>10.0.0.2": {base: 30, target: 30},
+			},
+			wantSummary: &summary{pairs: 3, baseMean: 20, targetMean: 20},
+		},
+		{
+			name: "zero base",
+			base: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 0}),
+			},
+			target: []model.NetworkStatus{
+				connectivityResult("host-01", "10.0.0.2", map[string]float64{"10.0.0.3": 5}),
+			},
+			wantComparisons: map[string]comparison{
+				"10.0.0.2>10.0.0.3": {base: 0, target: 5, delta: 5}, // No ratio
+			},
+			wantSummary: &summary{pairs: 1, baseMean: 0, targetMean: 5, delta: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summaries, comparisons := compareTestResults(tt.base, tt.target)
+
+			gotComparisons := make(map[string]comparison)
+			for _, c := range comparisons {
+				if c.Metric == "averageRTT" {
+					gotComparisons[c.SourceIp+">"+c.DestinationIp] = comparison{c.Base, c.Target, c.Delta, c.DeltaRatio}
+				}
+			}
+			if !reflect.DeepEqual(gotComparisons, tt.wantComparisons) {
+				t.Errorf("comparisons = %+v, want %+v", gotComparisons, tt.wantComparisons)
+			}
+
+			var gotSummary *summary
+			for _, s := range summaries {
+				if s.Metric == "averageRTT" {
+					gotSummary = &summary{s.Pairs, s.BaseMean, s.TargetMean, s.Delta}
+				}
+			}
+			if (gotSummary == nil) != (tt.wantSummary == nil) {
+				t.Fatalf("summary = %+v, want %+v", gotSummary, tt.wantSummary)
+			}
+			if gotSummary != nil && (gotSummary.pairs != tt.wantSummary.pairs ||
+				math.Abs(gotSummary.baseMean-tt.wantSummary.baseMean) > 1e-9 ||
+				math.Abs(gotSummary.targetMean-tt.wantSummary.targetMean) > 1e-9 ||
+				math.Abs(gotSummary.delta-tt.wantSummary.delta) > 1e-9) {
+				t.Errorf("summary = %+v, want %+v", *gotSummary, *tt.wantSummary)
+			}
+
+			// The summaries are sorted by the metric names
+			if !sort.SliceIsSorted(summaries, func(i, j int) bool { return summaries[i].Metric < summaries[j].Metric }) {
+				t.Errorf("the summaries are not sorted")
+			}
+		})
+	}
+
+	// The pairs with an error are not compared
+	pathMTU := func(mtu int, err string) []model.NetworkStatus {
+		return []model.NetworkStatus{{TestType: testtype.PathMTU, HostID: "host-01", PathMTU: []model.PathMTUStatus{
+			{HostPair: model.HostPair{SourceIP: "10.0.0.2", DestinationIP: "10.0.0.3"}, PathMTU: mtu, Error: err},
+		}}}
+	}
+	for _, tt := range []struct{ base, target []model.NetworkStatus }{
+		{pathMTU(1500, "timeout"), pathMTU(1400, "")},
+		{pathMTU(1500, ""), pathMTU(0, "timeout")},
+	} {
+		if summaries, comparisons := compareTestResults(tt.base, tt.target); len(summaries) != 0 || len(comparisons) != 0 {
+			t.Errorf("compareTestResults() with an error = %v, %v, want none", summaries, comparisons)
+		}
+	}
+	if _, comparisons := compareTestResults(pathMTU(1500, ""), pathMTU(1400, "")); len(comparisons) != 1 || comparisons[0].Delta != -100 {
+		t.Errorf("compareTestResults() of the path MTUs = %v, want a delta of -100", comparisons)
+	}
+}
+
+func TestWaitForTestResults(t *testing.T) {
+	testRun := model.TestRun{CladnetID: "cladnet-01", RunID: "run-01", HostIDs: []string{"host-01", "host-02", "host-03"}}
+
+	// putResult puts the result of a host in the test run
+	putResult := func(t *testing.T, runID string, hostID string) {
+		t.Helper()
+		err := cbnetStore.PutTestResult(context.Background(), "cladnet-01", model.NetworkStatus{RunID: runID, HostID: hostID}, time.Minute)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	t.Run("completion", func(t *testing.T) {
+		setUpStore(t)
+		putResult(t, "run-01", "host-01") // Reported before waiting
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			putResult(t, "run-02", "host-02") // Another run
+			putResult(t, "run-01", "host-04") // Not targeted
+			putResult(t, "run-01", "host-02")
+			putResult(t, "run-01", "host-02") // Reported again
+			putResult(t, "run-01", "host-03")
+		}()
+
+		var reported []string
+		timedOut, err := waitForTestResults(context.Background(), testRun, 10*time.Second, func(result model.NetworkStatus) error {
+			reported = append(reported, result.HostID)
+			return nil
+		})
+		if err != nil || len(timedOut) != 0 {
+			t.Fatalf("waitForTestResults() = %v, %v, want none timed out", timedOut, err)
+		}
+		if want := []string{"host-01", "host-02", "host-03"}; !reflect.DeepEqual(reported, want) {
+			t.Errorf("reported = %v, want %v (once each)", reported, want)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		setUpStore(t)
+		putResult(t, "run-01", "host-02")
+
+		start := time.Now()
+		timedOut, err := waitForTestResults(context.Background(), testRun, 100*time.Millisecond, func(result model.NetworkStatus) error {
+			return nil
+		})
+		if err != nil || !reflect.DeepEqual(timedOut, []string{"host-01", "host-03"}) {
+			t.Errorf("waitForTestResults() = %v, %v, want host-01 and host-03 timed out", timedOut, err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("waitForTestResults() took %v", elapsed)
+		}
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		setUpStore(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+
+		timedOut, err := waitForTestResults(ctx, testRun, 10*time.Second, func(result model.NetworkStatus) error {
+			return nil
+		})
+		if status.Code(err) != codes.Canceled || timedOut != nil {
+			t.Errorf("waitForTestResults() = %v, %v, want Canceled", timedOut, err)
+		}
+	})
+
+	t.Run("error of the callback", func(t *testing.T) {
+		setUpStore(t)
+		putResult(t, "run-01", "host-01")
+
+		errSend := errors.New("send error")
+		_, err := waitForTestResults(context.Background(), testRun, 10*time.Second, func(result model.NetworkStatus) error {
+			return errSend
+		})
+		if !errors.Is(err, errSend) {
+			t.Errorf("waitForTestResults() error = %v, want the error of the callback", err)
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,11 +17,12 @@ import (
 	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
 	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
 	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
 	nethelper "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-helper"
+	tlsutil "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tls-util"
 	resty "github.com/go-resty/resty/v2"
 	"github.com/tidwall/gjson"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var config model.Config
@@ -204,6 +206,12 @@ func main() {
 
 	log.Printf("Struct: %#v\n", cladnetSpec)
 
+	// Create a join token by which the cb-network agents join the cloud adaptive network
+	joinToken, err := createJoinToken(gRPCServiceEndpoint, cladnetSpec.CladnetID, "It's a join token for the demo")
+	if err != nil {
+		log.Printf("Could not create a join token: %v\n", err)
+	}
+
 	fmt.Println("##### End ---------- Step 4: Create a cloud adaptive network and get an ID of it")
 	fmt.Println("Sleep 5 sec ( _ _ )zZ")
 	time.Sleep(5 * time.Second)
@@ -212,15 +220,10 @@ func main() {
 	fmt.Println("\n\n##### Start ---------- Step 5: Install the cb-network agent by sending a command to specified MCIS")
 	pressEnterKeyToContinue(isOn)
 
-	placeHolderCommand := `wget https://raw.githubusercontent.com/cloud-barista/cb-larva/develop/poc-cb-net/scripts/1.deploy-cb-network-agent.sh -O ~/1.deploy-cb-network-agent.sh; chmod +x ~/1.deploy-cb-network-agent.sh; source ~/1.deploy-cb-network-agent.sh '%s' %s`
+	placeHolderCommand := `wget https://raw.githubusercontent.com/cloud-barista/cb-larva/develop/poc-cb-net/scripts/1.deploy-cb-network-agent.sh -O ~/1.deploy-cb-network-agent.sh; chmod +x ~/1.deploy-cb-network-agent.sh; source ~/1.deploy-cb-network-agent.sh %s %s '' %s`
 
-	etcdEndpointsJSON, _ := json.Marshal(config.ETCD.Endpoints)
-	etcdEndpointsString := string(etcdEndpointsJSON)
-	//fmt.Printf("etcdEndpointsString: %#v\n", etcdEndpointsString)
-	additionalEncodedString := strings.Replace(etcdEndpointsString, "\"", "\\\"", -1)
-	//fmt.Printf("additionalEncodedString: %#v\n", additionalEncodedString)
-
-	command := fmt.Sprintf(placeHolderCommand, additionalEncodedString, cladnetSpec.CladnetID)
+	// The cb-network agents access the cb-network service instead of the etcd
+	command := fmt.Sprintf(placeHolderCommand, config.Service.Endpoint, cladnetSpec.CladnetID, joinToken)
 	fmt.Printf("command: %#v\n", command)
 
 	body := fmt.Sprintf(placeHolderBody, command)
@@ -359,6 +362,34 @@ func askYesOrNoQuestion(isOn bool, question string) string {
 	return ""
 }
 
+// newServiceRESTClient creates a REST client of the cb-network service with TLS (or mTLS) and a token in the config
+func newServiceRESTClient() (*resty.Client, string, error) {
+
+	client := resty.New()
+	scheme := "http"
+
+	tlsConfig := config.Service.TLS
+	if tlsConfig.Enabled {
+		serverName := tlsConfig.ServerName
+		if serverName == "" {
+			serverName, _, _ = net.SplitHostPort(config.Service.Endpoint)
+		}
+
+		clientTLSConfig, err := tlsutil.NewClientConfig(tlsConfig.CAFile, tlsConfig.ClientCertFile, tlsConfig.ClientKeyFile, serverName)
+		if err != nil {
+			return nil, "", err
+		}
+		client.SetTLSClientConfig(clientTLSConfig)
+		scheme = "https"
+	}
+
+	if config.Service.Auth.Token != "" {
+		client.SetAuthToken(config.Service.Auth.Token)
+	}
+
+	return client, scheme, nil
+}
+
 func createProperCloudAdaptiveNetwork(gRPCServiceEndpoint string, ipCIDRs []string, cladnetName string, cladnetDescription string) (model.CLADNetSpecification, error) {
 
 	var cladnetSpec *pb.CLADNetSpecification
@@ -370,9 +401,11 @@ func createProperCloudAdaptiveNetwork(gRPCServiceEndpoint string, ipCIDRs []stri
 
 		ipNets := &pb.IPv4CIDRs{Ipv4Cidrs: ipCIDRs}
 		// Connect to the gRPC server
-		// Register CloudAdaptiveNetwork handler to gwmux
-		options := []grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// with TLS (or mTLS) and a token in the config
+		options, err := grpcauth.DialOptions(config.Service)
+		if err != nil {
+			log.Printf("Cannot configure credentials: %v\n", err)
+			return model.CLADNetSpecification{}, err
 		}
 
 		grpcConn, err := grpc.Dial(gRPCServiceEndpoint, options...)
@@ -424,15 +457,18 @@ func createProperCloudAdaptiveNetwork(gRPCServiceEndpoint string, ipCIDRs []stri
 		ipv4CIDRsString := fmt.Sprintf(ipv4CIDRsHolder, string(tempJSON))
 		log.Printf("%#v\n", ipv4CIDRsString)
 
-		client := resty.New()
-		// client.SetBasicAuth("default", "default")
+		client, scheme, err := newServiceRESTClient()
+		if err != nil {
+			log.Printf("Cannot configure credentials: %v\n", err)
+			return model.CLADNetSpecification{}, err
+		}
 
 		// Request a recommendation of available IPv4 private address spaces.
 		resp, err := client.R().
 			SetHeader("Content-Type", "application/json").
 			SetHeader("Accept", "application/json").
 			SetBody(ipv4CIDRsString).
-			Post(fmt.Sprintf("http://%s/v1/cladnet/availableIPv4AddressSpaces", gRPCServiceEndpoint))
+			Post(fmt.Sprintf("%s://%s/v1/cladnet/availableIPv4AddressSpaces", scheme, gRPCServiceEndpoint))
 		// Output print
 		log.Printf("\nError: %v\n", err)
 		log.Printf("Time: %v\n", resp.Time())
@@ -472,7 +508,7 @@ func createProperCloudAdaptiveNetwork(gRPCServiceEndpoint string, ipCIDRs []stri
 			SetHeader("Content-Type", "application/json").
 			SetHeader("Accept", "application/json").
 			SetBody(cladnetSpecString).
-			Post(fmt.Sprintf("http://%s/v1/cladnet", gRPCServiceEndpoint))
+			Post(fmt.Sprintf("%s://%s/v1/cladnet", scheme, gRPCServiceEndpoint))
 		// Output print
 		log.Printf("\nError: %v\n", err)
 		log.Printf("Time: %v\n", resp.Time())
@@ -493,6 +529,88 @@ func createProperCloudAdaptiveNetwork(gRPCServiceEndpoint string, ipCIDRs []stri
 	return spec, nil
 }
 
+func createJoinToken(gRPCServiceEndpoint string, cladnetID string, description string) (string, error) {
+
+	var token string
+
+	log.Printf("Service Call Method: %s", config.ServiceCallMethod)
+	switch config.ServiceCallMethod {
+	case "grpc":
+
+		// Connect to the gRPC server
+		// with TLS (or mTLS) and a token in the config
+		options, err := grpcauth.DialOptions(config.Service)
+		if err != nil {
+			log.Printf("Cannot configure credentials: %v\n", err)
+			return "", err
+		}
+
+		grpcConn, err := grpc.Dial(gRPCServiceEndpoint, options...)
+		if err != nil {
+			log.Printf("Cannot connect: %v\n", err)
+			return "", err
+		}
+		defer grpcConn.Close()
+
+		// Create a stub of Cloud AdaptiveNetwork
+		cladnetClient := pb.NewCloudAdaptiveNetworkServiceClient(grpcConn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// Call rpc, CreateJoinToken(ctx, joinTokenReq)
+		joinTokenReq := &pb.JoinTokenRequest{
+			CladnetId:   cladnetID,
+			Description: description,
+		}
+
+		joinToken, err := cladnetClient.CreateJoinToken(ctx, joinTokenReq)
+		if err != nil {
+			log.Printf("Could not request: %v", err)
+			return "", err
+		}
+		log.Printf("TokenId: %#v, ExpiresAt: %#v", joinToken.TokenId, joinToken.ExpiresAt)
+
+		token = joinToken.Token
+
+	case "rest":
+
+		client, scheme, err := newServiceRESTClient()
+		if err != nil {
+			log.Printf("Cannot configure credentials: %v\n", err)
+			return "", err
+		}
+
+		joinTokenReqHolder := `{"description": "%s"}`
+		joinTokenReqString := fmt.Sprintf(joinTokenReqHolder, description)
+
+		// Request to create a join token
+		resp, err := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetHeader("Accept", "application/json").
+			SetPathParams(map[string]string{
+				"cladnetId": cladnetID,
+			}).
+			SetBody(joinTokenReqString).
+			Post(fmt.Sprintf("%s://%s/v1/cladnet/{cladnetId}/joinToken", scheme, gRPCServiceEndpoint))
+		// Output print
+		log.Printf("\nError: %v\n", err)
+		log.Printf("Time: %v\n", resp.Time())
+
+		if err != nil {
+			log.Printf("Could not request: %v\n", err)
+			return "", err
+		}
+
+		token = gjson.Get(resp.String(), "token").String()
+
+	default:
+		log.Printf("Unknown service call method: %v\n", config.ServiceCallMethod)
+	}
+
+	return token, nil
+}
+
 func interactWithMasterVM(nsID string, mcisID string, masterVMID string) {
 	isOn := true
 	client := resty.New()
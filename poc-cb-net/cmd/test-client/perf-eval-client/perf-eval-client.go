@@ -22,13 +22,14 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	pb "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/api/gen/go"
 	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
 	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	etcdclient "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-client"
 	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
 	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	grpcauth "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/grpc-auth"
 	ruletype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/rule-type"
 	cblog "github.com/cloud-barista/cb-log"
 
@@ -245,10 +246,7 @@ func watchStatusInformation(ctx context.Context, wg *sync.WaitGroup) {
 
 	// etcd Section
 	// Connect to the etcd cluster
-	etcdClient, etcdErr := clientv3.New(clientv3.Config{
-		Endpoints:   endpointEtcd,
-		DialTimeout: 5 * time.Second,
-	})
+	etcdClient, etcdErr := etcdclient.New(config.ETCD)
 
 	if etcdErr != nil {
 		CBLogger.Error(etcdErr)
@@ -316,8 +314,11 @@ func watchStatusInformation(ctx context.Context, wg *sync.WaitGroup) {
 	for watchResponse := range watchChan1 {
 		for _, event := range watchResponse.Events {
 			CBLogger.Tracef("Pushed - %s %q : %q", event.Type, event.Kv.Key, event.Kv.Value)
-			slicedKeys := strings.Split(string(event.Kv.Key), "/")
-			parsedHostID := slicedKeys[len(slicedKeys)-1]
+			_, parsedHostID, errParse := etcdkey.ParseStatusInformationKey(string(event.Kv.Key))
+			if errParse != nil {
+				CBLogger.Error(errParse)
+				continue
+			}
 			CBLogger.Tracef("ParsedHostID: %v", parsedHostID)
 
 			status := string(event.Kv.Value)
@@ -401,10 +402,7 @@ func watchHostNetworkInformation(ctx context.Context, wg *sync.WaitGroup) {
 
 	// etcd Section
 	// Connect to the etcd cluster
-	etcdClient, etcdErr := clientv3.New(clientv3.Config{
-		Endpoints:   endpointEtcd,
-		DialTimeout: 5 * time.Second,
-	})
+	etcdClient, etcdErr := etcdclient.New(config.ETCD)
 
 	if etcdErr != nil {
 		CBLogger.Error(etcdErr)
@@ -969,8 +967,9 @@ func testPerformance(ruleType string, encryptionCommand string) {
 	//// Initialize cb-network service
 	// Connect to the gRPC server
 	// Register CloudAdaptiveNetwork handler to gwmux
-	options := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	options, err := grpcauth.DialOptions(config.Service)
+	if err != nil {
+		CBLogger.Errorf("Cannot configure credentials: %v", err)
 	}
 
 	grpcConn, err := grpc.Dial(endpointNetworkService, options...)
@@ -1086,8 +1085,9 @@ func setRuleType(ruleType string) {
 	//// Initialize cb-network service
 	// Connect to the gRPC server
 	// Register CloudAdaptiveNetwork handler to gwmux
-	options := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	options, err := grpcauth.DialOptions(config.Service)
+	if err != nil {
+		CBLogger.Errorf("Cannot configure credentials: %v", err)
 	}
 
 	grpcConn, err := grpc.Dial(endpointNetworkService, options...)
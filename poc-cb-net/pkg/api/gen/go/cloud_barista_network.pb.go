@@ -21,6 +21,7 @@ package cb_larva
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -36,7 +37,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-//*
+// *
 // It represents an enumerator for commands to the control cb-network system.
 type CommandType int32
 
@@ -93,16 +94,31 @@ func (CommandType) EnumDescriptor() ([]byte, []int) {
 type TestType int32
 
 const (
-	TestType_CONNECTIVITY TestType = 0
+	TestType_CONNECTIVITY      TestType = 0
+	TestType_THROUGHPUT        TestType = 1
+	TestType_PATH_MTU          TestType = 2
+	TestType_JITTER            TestType = 3
+	TestType_PORT_REACHABILITY TestType = 4
+	TestType_UNDERLAY_OVERLAY  TestType = 5
 )
 
 // Enum value maps for TestType.
 var (
 	TestType_name = map[int32]string{
 		0: "CONNECTIVITY",
+		1: "THROUGHPUT",
+		2: "PATH_MTU",
+		3: "JITTER",
+		4: "PORT_REACHABILITY",
+		5: "UNDERLAY_OVERLAY",
 	}
 	TestType_value = map[string]int32{
-		"CONNECTIVITY": 0,
+		"CONNECTIVITY":      0,
+		"THROUGHPUT":        1,
+		"PATH_MTU":          2,
+		"JITTER":            3,
+		"PORT_REACHABILITY": 4,
+		"UNDERLAY_OVERLAY":  5,
 	}
 )
 
@@ -133,7 +149,158 @@ func (TestType) EnumDescriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{1}
 }
 
-//*
+type Component int32
+
+const (
+	Component_SERVICE    Component = 0
+	Component_CONTROLLER Component = 1
+	Component_AGENT      Component = 2
+)
+
+// Enum value maps for Component.
+var (
+	Component_name = map[int32]string{
+		0: "SERVICE",
+		1: "CONTROLLER",
+		2: "AGENT",
+	}
+	Component_value = map[string]int32{
+		"SERVICE":    0,
+		"CONTROLLER": 1,
+		"AGENT":      2,
+	}
+)
+
+func (x Component) Enum() *Component {
+	p := new(Component)
+	*p = x
+	return p
+}
+
+func (x Component) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Component) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_barista_network_proto_enumTypes[2].Descriptor()
+}
+
+func (Component) Type() protoreflect.EnumType {
+	return &file_cloud_barista_network_proto_enumTypes[2]
+}
+
+func (x Component) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Component.Descriptor instead.
+func (Component) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{2}
+}
+
+// *
+// It represents a layer of the captured packets.
+type CaptureLayer int32
+
+const (
+	CaptureLayer_INNER CaptureLayer = 0 // Inner packets read from and written to the network interface (e.g., cbnet0)
+	CaptureLayer_OUTER CaptureLayer = 1 // Outer packets sent and received through the UDP socket (encapsulated)
+)
+
+// Enum value maps for CaptureLayer.
+var (
+	CaptureLayer_name = map[int32]string{
+		0: "INNER",
+		1: "OUTER",
+	}
+	CaptureLayer_value = map[string]int32{
+		"INNER": 0,
+		"OUTER": 1,
+	}
+)
+
+func (x CaptureLayer) Enum() *CaptureLayer {
+	p := new(CaptureLayer)
+	*p = x
+	return p
+}
+
+func (x CaptureLayer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CaptureLayer) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_barista_network_proto_enumTypes[3].Descriptor()
+}
+
+func (CaptureLayer) Type() protoreflect.EnumType {
+	return &file_cloud_barista_network_proto_enumTypes[3]
+}
+
+func (x CaptureLayer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CaptureLayer.Descriptor instead.
+func (CaptureLayer) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{3}
+}
+
+// *
+// It represents an enumerator for event types watched by an agent.
+type AgentEventType int32
+
+const (
+	AgentEventType_PEER            AgentEventType = 0
+	AgentEventType_SECRET          AgentEventType = 1
+	AgentEventType_CONTROL_COMMAND AgentEventType = 2
+	AgentEventType_TEST_REQUEST    AgentEventType = 3
+)
+
+// Enum value maps for AgentEventType.
+var (
+	AgentEventType_name = map[int32]string{
+		0: "PEER",
+		1: "SECRET",
+		2: "CONTROL_COMMAND",
+		3: "TEST_REQUEST",
+	}
+	AgentEventType_value = map[string]int32{
+		"PEER":            0,
+		"SECRET":          1,
+		"CONTROL_COMMAND": 2,
+		"TEST_REQUEST":    3,
+	}
+)
+
+func (x AgentEventType) Enum() *AgentEventType {
+	p := new(AgentEventType)
+	*p = x
+	return p
+}
+
+func (x AgentEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgentEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cloud_barista_network_proto_enumTypes[4].Descriptor()
+}
+
+func (AgentEventType) Type() protoreflect.EnumType {
+	return &file_cloud_barista_network_proto_enumTypes[4]
+}
+
+func (x AgentEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgentEventType.Descriptor instead.
+func (AgentEventType) EnumDescriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{4}
+}
+
+// *
 // It represents a command to control the cb-network system.
 type ControlRequest struct {
 	state         protoimpl.MessageState
@@ -190,7 +357,7 @@ func (x *ControlRequest) GetCommandType() CommandType {
 	return CommandType_UP
 }
 
-//*
+// *
 // It represents a result of the command to control the cb-network system.
 type ControlResponse struct {
 	state         protoimpl.MessageState
@@ -247,7 +414,7 @@ func (x *ControlResponse) GetMessage() string {
 	return ""
 }
 
-//*
+// *
 // It represents a result of the command to control the cb-network system.
 type TestRequest struct {
 	state         protoimpl.MessageState
@@ -257,6 +424,9 @@ type TestRequest struct {
 	CladnetId string   `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
 	TestType  TestType `protobuf:"varint,2,opt,name=test_type,json=testType,proto3,enum=cbnet.v1.TestType" json:"test_type,omitempty"`
 	TestSpec  string   `protobuf:"bytes,3,opt,name=test_spec,json=testSpec,proto3" json:"test_spec,omitempty"`
+	Label     string   `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`      // Label of the test run to compare runs, e.g., "encryption enabled"
+	Wait      bool     `protobuf:"varint,5,opt,name=wait,proto3" json:"wait,omitempty"`       // If true, wait until all targeted agents report the results or the timeout
+	Timeout   int64    `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"` // Seconds to wait for the results (60 by default, up to 600)
 }
 
 func (x *TestRequest) Reset() {
@@ -312,15 +482,39 @@ func (x *TestRequest) GetTestSpec() string {
 	return ""
 }
 
-//*
+func (x *TestRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TestRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *TestRequest) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// *
 // It represents a result of the command to control the cb-network system.
 type TestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsSucceeded bool   `protobuf:"varint,1,opt,name=is_succeeded,json=isSucceeded,proto3" json:"is_succeeded,omitempty"` // Success or failure
-	Message     string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                             // Message
+	IsSucceeded     bool          `protobuf:"varint,1,opt,name=is_succeeded,json=isSucceeded,proto3" json:"is_succeeded,omitempty"`                // Success or failure
+	Message         string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                            // Message
+	RunId           string        `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`                                   // ID of the test run
+	Results         []*TestResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`                                            // Results reported by the agents (if waited)
+	TimedOutHostIds []string      `protobuf:"bytes,5,rep,name=timed_out_host_ids,json=timedOutHostIds,proto3" json:"timed_out_host_ids,omitempty"` // Host IDs of the agents which have not reported until the timeout (if waited)
 }
 
 func (x *TestResponse) Reset() {
@@ -369,22 +563,40 @@ func (x *TestResponse) GetMessage() string {
 	return ""
 }
 
-//*
-// It represents a specification of Cloud Adaptive Network.
-type CLADNetSpecification struct {
+func (x *TestResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *TestResponse) GetResults() []*TestResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *TestResponse) GetTimedOutHostIds() []string {
+	if x != nil {
+		return x.TimedOutHostIds
+	}
+	return nil
+}
+
+// *
+// It represents a result reported by an agent in a test run.
+type TestResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CladnetId        string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                        // ID of Cloud Adaptive Network
-	Name             string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                   // Name of Cloud Adaptive Network
-	Ipv4AddressSpace string `protobuf:"bytes,3,opt,name=ipv4_address_space,json=ipv4AddressSpace,proto3" json:"ipv4_address_space,omitempty"` // IPv4 address space (e.g., 192.168.0.0/24) of Cloud Adaptive Network
-	Description      string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                     // Description of Cloud Adaptive Network
-	RuleType         string `protobuf:"bytes,5,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`                           // Rule type of Cloud Adaptive Network (e.g, basic, cost-prioritized)
+	HostId        string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	NetworkStatus string `protobuf:"bytes,2,opt,name=network_status,json=networkStatus,proto3" json:"network_status,omitempty"` // JSON of the network status
 }
 
-func (x *CLADNetSpecification) Reset() {
-	*x = CLADNetSpecification{}
+func (x *TestResult) Reset() {
+	*x = TestResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -392,13 +604,13 @@ func (x *CLADNetSpecification) Reset() {
 	}
 }
 
-func (x *CLADNetSpecification) String() string {
+func (x *TestResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CLADNetSpecification) ProtoMessage() {}
+func (*TestResult) ProtoMessage() {}
 
-func (x *CLADNetSpecification) ProtoReflect() protoreflect.Message {
+func (x *TestResult) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -410,58 +622,41 @@ func (x *CLADNetSpecification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CLADNetSpecification.ProtoReflect.Descriptor instead.
-func (*CLADNetSpecification) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CLADNetSpecification) GetCladnetId() string {
-	if x != nil {
-		return x.CladnetId
-	}
-	return ""
-}
-
-func (x *CLADNetSpecification) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CLADNetSpecification) GetIpv4AddressSpace() string {
-	if x != nil {
-		return x.Ipv4AddressSpace
-	}
-	return ""
-}
-
-func (x *CLADNetSpecification) GetDescription() string {
+func (x *TestResult) GetHostId() string {
 	if x != nil {
-		return x.Description
+		return x.HostId
 	}
 	return ""
 }
 
-func (x *CLADNetSpecification) GetRuleType() string {
+func (x *TestResult) GetNetworkStatus() string {
 	if x != nil {
-		return x.RuleType
+		return x.NetworkStatus
 	}
 	return ""
 }
 
-//*
-// It represents a list of Cloud Adaptive Network specifications.
-type CLADNetSpecifications struct {
+// *
+// It represents an event of a test run, which is streamed until all targeted agents report the results or the timeout.
+type TestEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CladnetSpecifications []*CLADNetSpecification `protobuf:"bytes,1,rep,name=cladnet_specifications,json=cladnetSpecifications,proto3" json:"cladnet_specifications,omitempty"` // A list of Cloud Adaptive Network specification
+	RunId           string      `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Run             *TestRun    `protobuf:"bytes,2,opt,name=run,proto3" json:"run,omitempty"`                                                    // The test run (the first event only)
+	Result          *TestResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`                                              // A result reported by an agent
+	IsCompleted     bool        `protobuf:"varint,4,opt,name=is_completed,json=isCompleted,proto3" json:"is_completed,omitempty"`                // True for the last event
+	TimedOutHostIds []string    `protobuf:"bytes,5,rep,name=timed_out_host_ids,json=timedOutHostIds,proto3" json:"timed_out_host_ids,omitempty"` // Host IDs of the agents which have not reported until the timeout (the last event only)
 }
 
-func (x *CLADNetSpecifications) Reset() {
-	*x = CLADNetSpecifications{}
+func (x *TestEvent) Reset() {
+	*x = TestEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -469,13 +664,13 @@ func (x *CLADNetSpecifications) Reset() {
 	}
 }
 
-func (x *CLADNetSpecifications) String() string {
+func (x *TestEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CLADNetSpecifications) ProtoMessage() {}
+func (*TestEvent) ProtoMessage() {}
 
-func (x *CLADNetSpecifications) ProtoReflect() protoreflect.Message {
+func (x *TestEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,30 +682,65 @@ func (x *CLADNetSpecifications) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CLADNetSpecifications.ProtoReflect.Descriptor instead.
-func (*CLADNetSpecifications) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestEvent.ProtoReflect.Descriptor instead.
+func (*TestEvent) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CLADNetSpecifications) GetCladnetSpecifications() []*CLADNetSpecification {
+func (x *TestEvent) GetRunId() string {
 	if x != nil {
-		return x.CladnetSpecifications
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *TestEvent) GetRun() *TestRun {
+	if x != nil {
+		return x.Run
 	}
 	return nil
 }
 
-//*
-// It represents a request of Cloud Adaptive Network.
-type CLADNetRequest struct {
+func (x *TestEvent) GetResult() *TestResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *TestEvent) GetIsCompleted() bool {
+	if x != nil {
+		return x.IsCompleted
+	}
+	return false
+}
+
+func (x *TestEvent) GetTimedOutHostIds() []string {
+	if x != nil {
+		return x.TimedOutHostIds
+	}
+	return nil
+}
+
+// *
+// It represents a test run, i.e., a test requested to the agents in a CLADNet at once.
+type TestRun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	CladnetId   string   `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	RunId       string   `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TestType    TestType `protobuf:"varint,3,opt,name=test_type,json=testType,proto3,enum=cbnet.v1.TestType" json:"test_type,omitempty"`
+	TestSpec    string   `protobuf:"bytes,4,opt,name=test_spec,json=testSpec,proto3" json:"test_spec,omitempty"`
+	Label       string   `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	RuleType    string   `protobuf:"bytes,6,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`          // Rule type of the CLADNet when the test was requested
+	HostIds     []string `protobuf:"bytes,7,rep,name=host_ids,json=hostIds,proto3" json:"host_ids,omitempty"`             // Host IDs of the targeted agents
+	RequestedAt string   `protobuf:"bytes,8,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // RFC 3339
 }
 
-func (x *CLADNetRequest) Reset() {
-	*x = CLADNetRequest{}
+func (x *TestRun) Reset() {
+	*x = TestRun{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -518,13 +748,13 @@ func (x *CLADNetRequest) Reset() {
 	}
 }
 
-func (x *CLADNetRequest) String() string {
+func (x *TestRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CLADNetRequest) ProtoMessage() {}
+func (*TestRun) ProtoMessage() {}
 
-func (x *CLADNetRequest) ProtoReflect() protoreflect.Message {
+func (x *TestRun) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,30 +766,79 @@ func (x *CLADNetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CLADNetRequest.ProtoReflect.Descriptor instead.
-func (*CLADNetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestRun.ProtoReflect.Descriptor instead.
+func (*TestRun) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CLADNetRequest) GetCladnetId() string {
+func (x *TestRun) GetCladnetId() string {
 	if x != nil {
 		return x.CladnetId
 	}
 	return ""
 }
 
-//*
-// It represents a list of IP networks (e.g., 10.10.5.2/16).
-type IPv4CIDRs struct {
+func (x *TestRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *TestRun) GetTestType() TestType {
+	if x != nil {
+		return x.TestType
+	}
+	return TestType_CONNECTIVITY
+}
+
+func (x *TestRun) GetTestSpec() string {
+	if x != nil {
+		return x.TestSpec
+	}
+	return ""
+}
+
+func (x *TestRun) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TestRun) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *TestRun) GetHostIds() []string {
+	if x != nil {
+		return x.HostIds
+	}
+	return nil
+}
+
+func (x *TestRun) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+// *
+// It represents a list of test runs.
+type TestRuns struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ipv4Cidrs []string `protobuf:"bytes,1,rep,name=ipv4_cidrs,json=ipv4Cidrs,proto3" json:"ipv4_cidrs,omitempty"`
+	Runs []*TestRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
 }
 
-func (x *IPv4CIDRs) Reset() {
-	*x = IPv4CIDRs{}
+func (x *TestRuns) Reset() {
+	*x = TestRuns{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,13 +846,13 @@ func (x *IPv4CIDRs) Reset() {
 	}
 }
 
-func (x *IPv4CIDRs) String() string {
+func (x *TestRuns) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPv4CIDRs) ProtoMessage() {}
+func (*TestRuns) ProtoMessage() {}
 
-func (x *IPv4CIDRs) ProtoReflect() protoreflect.Message {
+func (x *TestRuns) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,34 +864,31 @@ func (x *IPv4CIDRs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPv4CIDRs.ProtoReflect.Descriptor instead.
-func (*IPv4CIDRs) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestRuns.ProtoReflect.Descriptor instead.
+func (*TestRuns) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *IPv4CIDRs) GetIpv4Cidrs() []string {
+func (x *TestRuns) GetRuns() []*TestRun {
 	if x != nil {
-		return x.Ipv4Cidrs
+		return x.Runs
 	}
 	return nil
 }
 
-//*
-// It represents available IPv4 private address spaces
-// (also known as CIDR block, CIDR range, IP address range).
-type AvailableIPv4PrivateAddressSpaces struct {
+// *
+// It represents a request for a test run.
+type TestRunRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecommendedIpv4PrivateAddressSpace string   `protobuf:"bytes,1,opt,name=recommended_ipv4_private_address_space,json=recommendedIpv4PrivateAddressSpace,proto3" json:"recommended_ipv4_private_address_space,omitempty"` // A recommended IPv4 address space
-	AddressSpace10S                    []string `protobuf:"bytes,2,rep,name=address_space10s,json=addressSpace10s,proto3" json:"address_space10s,omitempty"`                                                                // All available Ipv4 address space in 10.0.0.0/8
-	AddressSpace172S                   []string `protobuf:"bytes,3,rep,name=address_space172s,json=addressSpace172s,proto3" json:"address_space172s,omitempty"`                                                             // All available Ipv4 address space in 172.16.0.0/12
-	AddressSpace192S                   []string `protobuf:"bytes,4,rep,name=address_space192s,json=addressSpace192s,proto3" json:"address_space192s,omitempty"`                                                             // All available Ipv4 address space in 192.168.0.0/16
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	RunId     string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
-func (x *AvailableIPv4PrivateAddressSpaces) Reset() {
-	*x = AvailableIPv4PrivateAddressSpaces{}
+func (x *TestRunRequest) Reset() {
+	*x = TestRunRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -620,13 +896,13 @@ func (x *AvailableIPv4PrivateAddressSpaces) Reset() {
 	}
 }
 
-func (x *AvailableIPv4PrivateAddressSpaces) String() string {
+func (x *TestRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailableIPv4PrivateAddressSpaces) ProtoMessage() {}
+func (*TestRunRequest) ProtoMessage() {}
 
-func (x *AvailableIPv4PrivateAddressSpaces) ProtoReflect() protoreflect.Message {
+func (x *TestRunRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -638,53 +914,40 @@ func (x *AvailableIPv4PrivateAddressSpaces) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailableIPv4PrivateAddressSpaces.ProtoReflect.Descriptor instead.
-func (*AvailableIPv4PrivateAddressSpaces) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestRunRequest.ProtoReflect.Descriptor instead.
+func (*TestRunRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AvailableIPv4PrivateAddressSpaces) GetRecommendedIpv4PrivateAddressSpace() string {
+func (x *TestRunRequest) GetCladnetId() string {
 	if x != nil {
-		return x.RecommendedIpv4PrivateAddressSpace
+		return x.CladnetId
 	}
 	return ""
 }
 
-func (x *AvailableIPv4PrivateAddressSpaces) GetAddressSpace10S() []string {
-	if x != nil {
-		return x.AddressSpace10S
-	}
-	return nil
-}
-
-func (x *AvailableIPv4PrivateAddressSpaces) GetAddressSpace172S() []string {
-	if x != nil {
-		return x.AddressSpace172S
-	}
-	return nil
-}
-
-func (x *AvailableIPv4PrivateAddressSpaces) GetAddressSpace192S() []string {
+func (x *TestRunRequest) GetRunId() string {
 	if x != nil {
-		return x.AddressSpace192S
+		return x.RunId
 	}
-	return nil
+	return ""
 }
 
-//*
-// It represents a result of attempt to delete a Cloud Adaptive Network.
-type DeletionResult struct {
+// *
+// It represents the metrics from a source to a destination in a test run, e.g., "averageRTT" of CONNECTIVITY.
+type TestResultCell struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsSucceeded          bool                  `protobuf:"varint,1,opt,name=is_succeeded,json=isSucceeded,proto3" json:"is_succeeded,omitempty"`                           // Success or failure
-	Message              string                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                                       // Message
-	CladnetSpecification *CLADNetSpecification `protobuf:"bytes,3,opt,name=cladnet_specification,json=cladnetSpecification,proto3" json:"cladnet_specification,omitempty"` // A specification of the target Cloud Adaptive Network
+	DestinationIp   string             `protobuf:"bytes,1,opt,name=destination_ip,json=destinationIp,proto3" json:"destination_ip,omitempty"`
+	DestinationName string             `protobuf:"bytes,2,opt,name=destination_name,json=destinationName,proto3" json:"destination_name,omitempty"`
+	Metrics         map[string]float64 `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"` // Empty if there is no result
+	Error           string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *DeletionResult) Reset() {
-	*x = DeletionResult{}
+func (x *TestResultCell) Reset() {
+	*x = TestResultCell{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,13 +955,13 @@ func (x *DeletionResult) Reset() {
 	}
 }
 
-func (x *DeletionResult) String() string {
+func (x *TestResultCell) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletionResult) ProtoMessage() {}
+func (*TestResultCell) ProtoMessage() {}
 
-func (x *DeletionResult) ProtoReflect() protoreflect.Message {
+func (x *TestResultCell) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,53 +973,56 @@ func (x *DeletionResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletionResult.ProtoReflect.Descriptor instead.
-func (*DeletionResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestResultCell.ProtoReflect.Descriptor instead.
+func (*TestResultCell) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeletionResult) GetIsSucceeded() bool {
+func (x *TestResultCell) GetDestinationIp() string {
 	if x != nil {
-		return x.IsSucceeded
+		return x.DestinationIp
 	}
-	return false
+	return ""
 }
 
-func (x *DeletionResult) GetMessage() string {
+func (x *TestResultCell) GetDestinationName() string {
 	if x != nil {
-		return x.Message
+		return x.DestinationName
 	}
 	return ""
 }
 
-func (x *DeletionResult) GetCladnetSpecification() *CLADNetSpecification {
+func (x *TestResultCell) GetMetrics() map[string]float64 {
 	if x != nil {
-		return x.CladnetSpecification
+		return x.Metrics
 	}
 	return nil
 }
 
-//*
-// It represents a peer in a Cloud Adaptive Network.
-type Peer struct {
+func (x *TestResultCell) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// *
+// It represents the results reported by an agent (i.e., a source) in a test run.
+type TestResultRow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CladnetId           string            `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
-	HostId              string            `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	HostName            string            `protobuf:"bytes,3,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
-	HostPrivateIpv4Cidr string            `protobuf:"bytes,4,opt,name=host_private_ipv4_cidr,json=hostPrivateIpv4Cidr,proto3" json:"host_private_ipv4_cidr,omitempty"`
-	HostPrivateIp       string            `protobuf:"bytes,5,opt,name=host_private_ip,json=hostPrivateIp,proto3" json:"host_private_ip,omitempty"`
-	HostPublicIp        string            `protobuf:"bytes,6,opt,name=host_public_ip,json=hostPublicIp,proto3" json:"host_public_ip,omitempty"`
-	Ipv4Cidr            string            `protobuf:"bytes,7,opt,name=ipv4_cidr,json=ipv4Cidr,proto3" json:"ipv4_cidr,omitempty"`
-	Ip                  string            `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip,omitempty"`
-	State               string            `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`
-	Details             *CloudInformation `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+	HostId      string            `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	SourceIp    string            `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourceName  string            `protobuf:"bytes,3,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	IsEncrypted bool              `protobuf:"varint,4,opt,name=is_encrypted,json=isEncrypted,proto3" json:"is_encrypted,omitempty"`
+	ReportedAt  string            `protobuf:"bytes,5,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"` // RFC 3339
+	Cells       []*TestResultCell `protobuf:"bytes,6,rep,name=cells,proto3" json:"cells,omitempty"`                             // In the order of the hosts of the matrix
 }
 
-func (x *Peer) Reset() {
-	*x = Peer{}
+func (x *TestResultRow) Reset() {
+	*x = TestResultRow{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -764,13 +1030,13 @@ func (x *Peer) Reset() {
 	}
 }
 
-func (x *Peer) String() string {
+func (x *TestResultRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Peer) ProtoMessage() {}
+func (*TestResultRow) ProtoMessage() {}
 
-func (x *Peer) ProtoReflect() protoreflect.Message {
+func (x *TestResultRow) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -782,97 +1048,69 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
-func (*Peer) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestResultRow.ProtoReflect.Descriptor instead.
+func (*TestResultRow) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Peer) GetCladnetId() string {
-	if x != nil {
-		return x.CladnetId
-	}
-	return ""
-}
-
-func (x *Peer) GetHostId() string {
+func (x *TestResultRow) GetHostId() string {
 	if x != nil {
 		return x.HostId
 	}
 	return ""
 }
 
-func (x *Peer) GetHostName() string {
-	if x != nil {
-		return x.HostName
-	}
-	return ""
-}
-
-func (x *Peer) GetHostPrivateIpv4Cidr() string {
-	if x != nil {
-		return x.HostPrivateIpv4Cidr
-	}
-	return ""
-}
-
-func (x *Peer) GetHostPrivateIp() string {
-	if x != nil {
-		return x.HostPrivateIp
-	}
-	return ""
-}
-
-func (x *Peer) GetHostPublicIp() string {
+func (x *TestResultRow) GetSourceIp() string {
 	if x != nil {
-		return x.HostPublicIp
+		return x.SourceIp
 	}
 	return ""
 }
 
-func (x *Peer) GetIpv4Cidr() string {
+func (x *TestResultRow) GetSourceName() string {
 	if x != nil {
-		return x.Ipv4Cidr
+		return x.SourceName
 	}
 	return ""
 }
 
-func (x *Peer) GetIp() string {
+func (x *TestResultRow) GetIsEncrypted() bool {
 	if x != nil {
-		return x.Ip
+		return x.IsEncrypted
 	}
-	return ""
+	return false
 }
 
-func (x *Peer) GetState() string {
+func (x *TestResultRow) GetReportedAt() string {
 	if x != nil {
-		return x.State
+		return x.ReportedAt
 	}
 	return ""
 }
 
-func (x *Peer) GetDetails() *CloudInformation {
+func (x *TestResultRow) GetCells() []*TestResultCell {
 	if x != nil {
-		return x.Details
+		return x.Cells
 	}
 	return nil
 }
 
-//*
-// It represents cloud information for a peer as details.
-type CloudInformation struct {
+// *
+// It represents an N x N matrix of the results in a test run.
+type TestResultMatrix struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProviderName       string `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
-	RegionId           string `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
-	AvailabilityZoneId string `protobuf:"bytes,3,opt,name=availability_zone_id,json=availabilityZoneId,proto3" json:"availability_zone_id,omitempty"`
-	VirtualNetworkId   string `protobuf:"bytes,4,opt,name=virtual_network_id,json=virtualNetworkId,proto3" json:"virtual_network_id,omitempty"`
-	SubnetId           string `protobuf:"bytes,5,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	Run            *TestRun         `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	HostIps        []string         `protobuf:"bytes,2,rep,name=host_ips,json=hostIps,proto3" json:"host_ips,omitempty"` // IP addresses of the hosts (the rows and the columns)
+	HostNames      []string         `protobuf:"bytes,3,rep,name=host_names,json=hostNames,proto3" json:"host_names,omitempty"`
+	Rows           []*TestResultRow `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`                                             // In the order of the hosts (a row without the host ID if not reported)
+	PendingHostIds []string         `protobuf:"bytes,5,rep,name=pending_host_ids,json=pendingHostIds,proto3" json:"pending_host_ids,omitempty"` // Targeted agents which have not reported yet
 }
 
-func (x *CloudInformation) Reset() {
-	*x = CloudInformation{}
+func (x *TestResultMatrix) Reset() {
+	*x = TestResultMatrix{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -880,13 +1118,13 @@ func (x *CloudInformation) Reset() {
 	}
 }
 
-func (x *CloudInformation) String() string {
+func (x *TestResultMatrix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloudInformation) ProtoMessage() {}
+func (*TestResultMatrix) ProtoMessage() {}
 
-func (x *CloudInformation) ProtoReflect() protoreflect.Message {
+func (x *TestResultMatrix) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -898,58 +1136,60 @@ func (x *CloudInformation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloudInformation.ProtoReflect.Descriptor instead.
-func (*CloudInformation) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestResultMatrix.ProtoReflect.Descriptor instead.
+func (*TestResultMatrix) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CloudInformation) GetProviderName() string {
+func (x *TestResultMatrix) GetRun() *TestRun {
 	if x != nil {
-		return x.ProviderName
+		return x.Run
 	}
-	return ""
+	return nil
 }
 
-func (x *CloudInformation) GetRegionId() string {
+func (x *TestResultMatrix) GetHostIps() []string {
 	if x != nil {
-		return x.RegionId
+		return x.HostIps
 	}
-	return ""
+	return nil
 }
 
-func (x *CloudInformation) GetAvailabilityZoneId() string {
+func (x *TestResultMatrix) GetHostNames() []string {
 	if x != nil {
-		return x.AvailabilityZoneId
+		return x.HostNames
 	}
-	return ""
+	return nil
 }
 
-func (x *CloudInformation) GetVirtualNetworkId() string {
+func (x *TestResultMatrix) GetRows() []*TestResultRow {
 	if x != nil {
-		return x.VirtualNetworkId
+		return x.Rows
 	}
-	return ""
+	return nil
 }
 
-func (x *CloudInformation) GetSubnetId() string {
+func (x *TestResultMatrix) GetPendingHostIds() []string {
 	if x != nil {
-		return x.SubnetId
+		return x.PendingHostIds
 	}
-	return ""
+	return nil
 }
 
-//*
-// It represents a list of peers.
-type Peers struct {
+// *
+// It represents a request to compare 2 test runs of the same test type.
+type TestRunComparisonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"` // A list of peers
+	CladnetId   string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	BaseRunId   string `protobuf:"bytes,2,opt,name=base_run_id,json=baseRunId,proto3" json:"base_run_id,omitempty"`
+	TargetRunId string `protobuf:"bytes,3,opt,name=target_run_id,json=targetRunId,proto3" json:"target_run_id,omitempty"`
 }
 
-func (x *Peers) Reset() {
-	*x = Peers{}
+func (x *TestRunComparisonRequest) Reset() {
+	*x = TestRunComparisonRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -957,13 +1197,13 @@ func (x *Peers) Reset() {
 	}
 }
 
-func (x *Peers) String() string {
+func (x *TestRunComparisonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Peers) ProtoMessage() {}
+func (*TestRunComparisonRequest) ProtoMessage() {}
 
-func (x *Peers) ProtoReflect() protoreflect.Message {
+func (x *TestRunComparisonRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,31 +1215,52 @@ func (x *Peers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Peers.ProtoReflect.Descriptor instead.
-func (*Peers) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestRunComparisonRequest.ProtoReflect.Descriptor instead.
+func (*TestRunComparisonRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Peers) GetPeers() []*Peer {
+func (x *TestRunComparisonRequest) GetCladnetId() string {
 	if x != nil {
-		return x.Peers
+		return x.CladnetId
 	}
-	return nil
+	return ""
 }
 
-//*
-// It represents a request of peer.
-type PeerRequest struct {
+func (x *TestRunComparisonRequest) GetBaseRunId() string {
+	if x != nil {
+		return x.BaseRunId
+	}
+	return ""
+}
+
+func (x *TestRunComparisonRequest) GetTargetRunId() string {
+	if x != nil {
+		return x.TargetRunId
+	}
+	return ""
+}
+
+// *
+// It represents a metric from a source to a destination in 2 test runs.
+type MetricComparison struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
-	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	SourceIp        string  `protobuf:"bytes,1,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourceName      string  `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	DestinationIp   string  `protobuf:"bytes,3,opt,name=destination_ip,json=destinationIp,proto3" json:"destination_ip,omitempty"`
+	DestinationName string  `protobuf:"bytes,4,opt,name=destination_name,json=destinationName,proto3" json:"destination_name,omitempty"`
+	Metric          string  `protobuf:"bytes,5,opt,name=metric,proto3" json:"metric,omitempty"`
+	Base            float64 `protobuf:"fixed64,6,opt,name=base,proto3" json:"base,omitempty"`
+	Target          float64 `protobuf:"fixed64,7,opt,name=target,proto3" json:"target,omitempty"`
+	Delta           float64 `protobuf:"fixed64,8,opt,name=delta,proto3" json:"delta,omitempty"`                             // target - base
+	DeltaRatio      float64 `protobuf:"fixed64,9,opt,name=delta_ratio,json=deltaRatio,proto3" json:"delta_ratio,omitempty"` // delta / base (0 if base is 0)
 }
 
-func (x *PeerRequest) Reset() {
-	*x = PeerRequest{}
+func (x *MetricComparison) Reset() {
+	*x = MetricComparison{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,13 +1268,13 @@ func (x *PeerRequest) Reset() {
 	}
 }
 
-func (x *PeerRequest) String() string {
+func (x *MetricComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeerRequest) ProtoMessage() {}
+func (*MetricComparison) ProtoMessage() {}
 
-func (x *PeerRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricComparison) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,39 +1286,90 @@ func (x *PeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeerRequest.ProtoReflect.Descriptor instead.
-func (*PeerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricComparison.ProtoReflect.Descriptor instead.
+func (*MetricComparison) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PeerRequest) GetCladnetId() string {
+func (x *MetricComparison) GetSourceIp() string {
 	if x != nil {
-		return x.CladnetId
+		return x.SourceIp
 	}
 	return ""
 }
 
-func (x *PeerRequest) GetHostId() string {
+func (x *MetricComparison) GetSourceName() string {
 	if x != nil {
-		return x.HostId
+		return x.SourceName
 	}
 	return ""
 }
 
-//*
-// It represents a request of peer.
-type UpdateDetailsRequest struct {
+func (x *MetricComparison) GetDestinationIp() string {
+	if x != nil {
+		return x.DestinationIp
+	}
+	return ""
+}
+
+func (x *MetricComparison) GetDestinationName() string {
+	if x != nil {
+		return x.DestinationName
+	}
+	return ""
+}
+
+func (x *MetricComparison) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MetricComparison) GetBase() float64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *MetricComparison) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *MetricComparison) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *MetricComparison) GetDeltaRatio() float64 {
+	if x != nil {
+		return x.DeltaRatio
+	}
+	return 0
+}
+
+// *
+// It represents the mean of a metric over the host pairs in both test runs.
+type MetricSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CladnetId        string            `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
-	HostId           string            `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	CloudInformation *CloudInformation `protobuf:"bytes,3,opt,name=cloud_information,json=cloudInformation,proto3" json:"cloud_information,omitempty"`
+	Metric     string  `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Pairs      int32   `protobuf:"varint,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	BaseMean   float64 `protobuf:"fixed64,3,opt,name=base_mean,json=baseMean,proto3" json:"base_mean,omitempty"`
+	TargetMean float64 `protobuf:"fixed64,4,opt,name=target_mean,json=targetMean,proto3" json:"target_mean,omitempty"`
+	Delta      float64 `protobuf:"fixed64,5,opt,name=delta,proto3" json:"delta,omitempty"` // target_mean - base_mean
 }
 
-func (x *UpdateDetailsRequest) Reset() {
-	*x = UpdateDetailsRequest{}
+func (x *MetricSummary) Reset() {
+	*x = MetricSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1065,13 +1377,13 @@ func (x *UpdateDetailsRequest) Reset() {
 	}
 }
 
-func (x *UpdateDetailsRequest) String() string {
+func (x *MetricSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDetailsRequest) ProtoMessage() {}
+func (*MetricSummary) ProtoMessage() {}
 
-func (x *UpdateDetailsRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1083,50 +1395,61 @@ func (x *UpdateDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDetailsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDetailsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricSummary.ProtoReflect.Descriptor instead.
+func (*MetricSummary) Descriptor() ([]byte, []int) {
 	return file_cloud_barista_network_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateDetailsRequest) GetCladnetId() string {
+func (x *MetricSummary) GetMetric() string {
 	if x != nil {
-		return x.CladnetId
+		return x.Metric
 	}
 	return ""
 }
 
-func (x *UpdateDetailsRequest) GetHostId() string {
+func (x *MetricSummary) GetPairs() int32 {
 	if x != nil {
-		return x.HostId
+		return x.Pairs
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateDetailsRequest) GetCloudInformation() *CloudInformation {
+func (x *MetricSummary) GetBaseMean() float64 {
 	if x != nil {
-		return x.CloudInformation
+		return x.BaseMean
 	}
-	return nil
+	return 0
 }
 
-//*
-// It represents a networking rule.
-type NetworkingRule struct {
+func (x *MetricSummary) GetTargetMean() float64 {
+	if x != nil {
+		return x.TargetMean
+	}
+	return 0
+}
+
+func (x *MetricSummary) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+// *
+// It represents a comparison between 2 test runs, e.g., before and after enabling the encryption.
+type TestRunComparison struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CladnetId  string   `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
-	HostId     []string `protobuf:"bytes,2,rep,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	HostName   []string `protobuf:"bytes,3,rep,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
-	PeerIp     []string `protobuf:"bytes,4,rep,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
-	SelectedIp []string `protobuf:"bytes,5,rep,name=selected_ip,json=selectedIp,proto3" json:"selected_ip,omitempty"`
-	PeerScope  []string `protobuf:"bytes,6,rep,name=peer_scope,json=peerScope,proto3" json:"peer_scope,omitempty"`
-	State      []string `protobuf:"bytes,7,rep,name=state,proto3" json:"state,omitempty"`
+	Base        *TestRun            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Target      *TestRun            `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Summaries   []*MetricSummary    `protobuf:"bytes,3,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	Comparisons []*MetricComparison `protobuf:"bytes,4,rep,name=comparisons,proto3" json:"comparisons,omitempty"`
 }
 
-func (x *NetworkingRule) Reset() {
-	*x = NetworkingRule{}
+func (x *TestRunComparison) Reset() {
+	*x = TestRunComparison{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_barista_network_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,451 +1457,5484 @@ func (x *NetworkingRule) Reset() {
 	}
 }
 
-func (x *NetworkingRule) String() string {
+func (x *TestRunComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkingRule) ProtoMessage() {}
+func (*TestRunComparison) ProtoMessage() {}
 
-func (x *NetworkingRule) ProtoReflect() protoreflect.Message {
+func (x *TestRunComparison) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_barista_network_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NetworkingRule.ProtoReflect.Descriptor instead.
-func (*NetworkingRule) Descriptor() ([]byte, []int) {
-	return file_cloud_barista_network_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *NetworkingRule) GetCladnetId() string {
-	if x != nil {
-		return x.CladnetId
-	}
-	return ""
-}
-
-func (x *NetworkingRule) GetHostId() []string {
-	if x != nil {
-		return x.HostId
-	}
-	return nil
-}
-
-func (x *NetworkingRule) GetHostName() []string {
-	if x != nil {
-		return x.HostName
-	}
-	return nil
-}
-
-func (x *NetworkingRule) GetPeerIp() []string {
-	if x != nil {
-		return x.PeerIp
-	}
-	return nil
-}
-
-func (x *NetworkingRule) GetSelectedIp() []string {
-	if x != nil {
-		return x.SelectedIp
-	}
-	return nil
-}
-
-func (x *NetworkingRule) GetPeerScope() []string {
-	if x != nil {
-		return x.PeerScope
-	}
-	return nil
-}
-
-func (x *NetworkingRule) GetState() []string {
-	if x != nil {
-		return x.State
-	}
-	return nil
-}
-
-var File_cloud_barista_network_proto protoreflect.FileDescriptor
-
-var file_cloud_barista_network_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x5f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63,
-	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x69, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0x4e, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65,
-	0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a,
-	0x0b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70, 0x65, 0x63, 0x22, 0x4b, 0x0a, 0x0c, 0x54, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x43, 0x4c, 0x41, 0x44, 0x4e,
-	0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x69, 0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x6e, 0x0a, 0x15, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x16, 0x63, 0x6c, 0x61, 0x64,
-	0x6e, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
-	0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x2f, 0x0a, 0x0e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64,
-	0x22, 0x2a, 0x0a, 0x09, 0x49, 0x50, 0x76, 0x34, 0x43, 0x49, 0x44, 0x52, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x69, 0x70, 0x76, 0x34, 0x43, 0x69, 0x64, 0x72, 0x73, 0x22, 0xfc, 0x01, 0x0a,
-	0x21, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x50, 0x76, 0x34, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
-	0x65, 0x73, 0x12, 0x52, 0x0a, 0x26, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x49,
-	0x70, 0x76, 0x34, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x31, 0x30, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x31, 0x30,
-	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x31, 0x37, 0x32, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x31, 0x37, 0x32, 0x73, 0x12, 0x2b,
-	0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x31,
-	0x39, 0x32, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x31, 0x39, 0x32, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x53, 0x0a, 0x15, 0x63,
-	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x62, 0x6e,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x63, 0x6c, 0x61, 0x64,
-	0x6e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xd7, 0x02, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61,
-	0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33,
-	0x0a, 0x16, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69,
-	0x70, 0x76, 0x34, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
-	0x68, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x76, 0x34, 0x43,
-	0x69, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x6f,
-	0x73, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x68,
-	0x6f, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
-	0x70, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x76, 0x34, 0x43, 0x69, 0x64, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e,
-	0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x22, 0x2d,
-	0x0a, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a,
-	0x0b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68,
-	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f,
-	0x73, 0x74, 0x49, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x11, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd4,
-	0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69,
-	0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x70,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x4e, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45,
-	0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a,
-	0x12, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x1c, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
-	0x59, 0x10, 0x00, 0x32, 0x87, 0x03, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x12, 0x93, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x62, 0x6e,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e,
-	0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x18, 0x74, 0x65, 0x73, 0x74, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x62, 0x6e,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x65, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c,
-	0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x7b,
-	0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x32, 0xa1, 0x09,
-	0x0a, 0x1b, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a,
-	0x0a, 0x67, 0x65, 0x74, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x62,
-	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64,
-	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5e, 0x0a, 0x0e, 0x67, 0x65, 0x74, 0x43, 0x4c,
-	0x41, 0x44, 0x4e, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41,
-	0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x12, 0x67, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
-	0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x3a, 0x01, 0x2a,
-	0x12, 0x65, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65,
-	0x74, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41,
-	0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x62,
-	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64,
-	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
-	0x1a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63,
-	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa1, 0x01,
-	0x0a, 0x2a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x49, 0x50, 0x76, 0x34, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x63,
-	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x76, 0x34, 0x43, 0x49, 0x44, 0x52,
-	0x73, 0x1a, 0x2b, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x50, 0x76, 0x34, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x31,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64,
-	0x6e, 0x65, 0x74, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x50, 0x76,
-	0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73, 0x3a, 0x01,
-	0x2a, 0x12, 0x61, 0x0a, 0x07, 0x67, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x63,
-	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5c, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x62, 0x6e,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0x25, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74,
-	0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65,
-	0x65, 0x72, 0x12, 0x81, 0x01, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x4f, 0x66, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x63, 0x62, 0x6e,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x62, 0x6e,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x34, 0x1a, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f,
-	0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65, 0x65,
-	0x72, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x50, 0x65,
-	0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65,
-	0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c,
-	0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c,
-	0x65, 0x42, 0x8c, 0x03, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x2f, 0x63,
-	0x62, 0x2d, 0x6c, 0x61, 0x72, 0x76, 0x61, 0x92, 0x41, 0xe5, 0x02, 0x12, 0xe2, 0x02, 0x0a, 0x2a,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x20, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x28, 0x63, 0x62, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x29, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x4e, 0x6f, 0x74, 0x65,
-	0x20, 0x2d, 0x20, 0x60, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74,
-	0x61, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65,
-	0x72, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x60, 0x20, 0x69, 0x73, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x60, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x76, 0x32, 0x60, 0x22, 0x69, 0x0a, 0x1a, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x42, 0x61, 0x72,
-	0x69, 0x73, 0x74, 0x61, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x62, 0x61, 0x72, 0x69,
-	0x73, 0x74, 0x61, 0x1a, 0x29, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2d, 0x74, 0x6f, 0x2d,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x40, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x59,
-	0x0a, 0x1a, 0x41, 0x70, 0x61, 0x63, 0x68, 0x65, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x32, 0x2e, 0x30, 0x12, 0x3b, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x2f, 0x63,
-	0x62, 0x2d, 0x6c, 0x61, 0x72, 0x76, 0x61, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69,
-	0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x3a, 0x20,
-	0x0a, 0x15, 0x78, 0x2d, 0x73, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x6f,
-	0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x07, 0x1a, 0x05, 0x79, 0x61, 0x64, 0x64, 0x61,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_cloud_barista_network_proto_rawDescOnce sync.Once
-	file_cloud_barista_network_proto_rawDescData = file_cloud_barista_network_proto_rawDesc
-)
-
-func file_cloud_barista_network_proto_rawDescGZIP() []byte {
-	file_cloud_barista_network_proto_rawDescOnce.Do(func() {
-		file_cloud_barista_network_proto_rawDescData = protoimpl.X.CompressGZIP(file_cloud_barista_network_proto_rawDescData)
-	})
-	return file_cloud_barista_network_proto_rawDescData
-}
-
-var file_cloud_barista_network_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cloud_barista_network_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_cloud_barista_network_proto_goTypes = []interface{}{
-	(CommandType)(0),                          // 0: cbnet.v1.CommandType
-	(TestType)(0),                             // 1: cbnet.v1.TestType
-	(*ControlRequest)(nil),                    // 2: cbnet.v1.ControlRequest
-	(*ControlResponse)(nil),                   // 3: cbnet.v1.ControlResponse
-	(*TestRequest)(nil),                       // 4: cbnet.v1.TestRequest
-	(*TestResponse)(nil),                      // 5: cbnet.v1.TestResponse
-	(*CLADNetSpecification)(nil),              // 6: cbnet.v1.CLADNetSpecification
-	(*CLADNetSpecifications)(nil),             // 7: cbnet.v1.CLADNetSpecifications
-	(*CLADNetRequest)(nil),                    // 8: cbnet.v1.CLADNetRequest
-	(*IPv4CIDRs)(nil),                         // 9: cbnet.v1.IPv4CIDRs
-	(*AvailableIPv4PrivateAddressSpaces)(nil), // 10: cbnet.v1.AvailableIPv4PrivateAddressSpaces
-	(*DeletionResult)(nil),                    // 11: cbnet.v1.DeletionResult
-	(*Peer)(nil),                              // 12: cbnet.v1.Peer
-	(*CloudInformation)(nil),                  // 13: cbnet.v1.CloudInformation
-	(*Peers)(nil),                             // 14: cbnet.v1.Peers
-	(*PeerRequest)(nil),                       // 15: cbnet.v1.PeerRequest
-	(*UpdateDetailsRequest)(nil),              // 16: cbnet.v1.UpdateDetailsRequest
-	(*NetworkingRule)(nil),                    // 17: cbnet.v1.NetworkingRule
-	(*emptypb.Empty)(nil),                     // 18: google.protobuf.Empty
-	(*wrapperspb.StringValue)(nil),            // 19: google.protobuf.StringValue
-}
-var file_cloud_barista_network_proto_depIdxs = []int32{
-	0,  // 0: cbnet.v1.ControlRequest.command_type:type_name -> cbnet.v1.CommandType
-	1,  // 1: cbnet.v1.TestRequest.test_type:type_name -> cbnet.v1.TestType
-	6,  // 2: cbnet.v1.CLADNetSpecifications.cladnet_specifications:type_name -> cbnet.v1.CLADNetSpecification
-	6,  // 3: cbnet.v1.DeletionResult.cladnet_specification:type_name -> cbnet.v1.CLADNetSpecification
-	13, // 4: cbnet.v1.Peer.details:type_name -> cbnet.v1.CloudInformation
-	12, // 5: cbnet.v1.Peers.peers:type_name -> cbnet.v1.Peer
-	13, // 6: cbnet.v1.UpdateDetailsRequest.cloud_information:type_name -> cbnet.v1.CloudInformation
-	18, // 7: cbnet.v1.SystemManagementService.health:input_type -> google.protobuf.Empty
-	2,  // 8: cbnet.v1.SystemManagementService.controlCloudAdaptiveNetwork:input_type -> cbnet.v1.ControlRequest
-	4,  // 9: cbnet.v1.SystemManagementService.testCloudAdaptiveNetwork:input_type -> cbnet.v1.TestRequest
-	8,  // 10: cbnet.v1.CloudAdaptiveNetworkService.getCLADNet:input_type -> cbnet.v1.CLADNetRequest
-	18, // 11: cbnet.v1.CloudAdaptiveNetworkService.getCLADNetList:input_type -> google.protobuf.Empty
-	6,  // 12: cbnet.v1.CloudAdaptiveNetworkService.createCLADNet:input_type -> cbnet.v1.CLADNetSpecification
-	8,  // 13: cbnet.v1.CloudAdaptiveNetworkService.deleteCLADNet:input_type -> cbnet.v1.CLADNetRequest
-	6,  // 14: cbnet.v1.CloudAdaptiveNetworkService.updateCLADNet:input_type -> cbnet.v1.CLADNetSpecification
-	9,  // 15: cbnet.v1.CloudAdaptiveNetworkService.recommendAvailableIPv4PrivateAddressSpaces:input_type -> cbnet.v1.IPv4CIDRs
-	15, // 16: cbnet.v1.CloudAdaptiveNetworkService.getPeer:input_type -> cbnet.v1.PeerRequest
-	15, // 17: cbnet.v1.CloudAdaptiveNetworkService.getPeerList:input_type -> cbnet.v1.PeerRequest
-	16, // 18: cbnet.v1.CloudAdaptiveNetworkService.updateDetailsOfPeer:input_type -> cbnet.v1.UpdateDetailsRequest
-	15, // 19: cbnet.v1.CloudAdaptiveNetworkService.getPeerNetworkingRule:input_type -> cbnet.v1.PeerRequest
-	19, // 20: cbnet.v1.SystemManagementService.health:output_type -> google.protobuf.StringValue
-	3,  // 21: cbnet.v1.SystemManagementService.controlCloudAdaptiveNetwork:output_type -> cbnet.v1.ControlResponse
-	5,  // 22: cbnet.v1.SystemManagementService.testCloudAdaptiveNetwork:output_type -> cbnet.v1.TestResponse
-	6,  // 23: cbnet.v1.CloudAdaptiveNetworkService.getCLADNet:output_type -> cbnet.v1.CLADNetSpecification
-	7,  // 24: cbnet.v1.CloudAdaptiveNetworkService.getCLADNetList:output_type -> cbnet.v1.CLADNetSpecifications
-	6,  // 25: cbnet.v1.CloudAdaptiveNetworkService.createCLADNet:output_type -> cbnet.v1.CLADNetSpecification
-	11, // 26: cbnet.v1.CloudAdaptiveNetworkService.deleteCLADNet:output_type -> cbnet.v1.DeletionResult
-	6,  // 27: cbnet.v1.CloudAdaptiveNetworkService.updateCLADNet:output_type -> cbnet.v1.CLADNetSpecification
-	10, // 28: cbnet.v1.CloudAdaptiveNetworkService.recommendAvailableIPv4PrivateAddressSpaces:output_type -> cbnet.v1.AvailableIPv4PrivateAddressSpaces
-	12, // 29: cbnet.v1.CloudAdaptiveNetworkService.getPeer:output_type -> cbnet.v1.Peer
-	14, // 30: cbnet.v1.CloudAdaptiveNetworkService.getPeerList:output_type -> cbnet.v1.Peers
-	12, // 31: cbnet.v1.CloudAdaptiveNetworkService.updateDetailsOfPeer:output_type -> cbnet.v1.Peer
-	17, // 32: cbnet.v1.CloudAdaptiveNetworkService.getPeerNetworkingRule:output_type -> cbnet.v1.NetworkingRule
-	20, // [20:33] is the sub-list for method output_type
-	7,  // [7:20] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
-}
-
-func init() { file_cloud_barista_network_proto_init() }
-func file_cloud_barista_network_proto_init() {
-	if File_cloud_barista_network_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_cloud_barista_network_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRunComparison.ProtoReflect.Descriptor instead.
+func (*TestRunComparison) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TestRunComparison) GetBase() *TestRun {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *TestRunComparison) GetTarget() *TestRun {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *TestRunComparison) GetSummaries() []*MetricSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+func (x *TestRunComparison) GetComparisons() []*MetricComparison {
+	if x != nil {
+		return x.Comparisons
+	}
+	return nil
+}
+
+// *
+// It represents a request to change the log level of a component at runtime.
+type LogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component Component `protobuf:"varint,1,opt,name=component,proto3,enum=cbnet.v1.Component" json:"component,omitempty"` // Component whose log level is changed
+	Level     string    `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`                                  // Log level, i.e., "trace", "debug", "info", "warn" or "error"
+	CladnetId string    `protobuf:"bytes,3,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`         // ID of a Cloud Adaptive Network (required for the agents)
+	HostId    string    `protobuf:"bytes,4,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                  // ID of a host (all hosts in the CLADNet if empty)
+}
+
+func (x *LogLevelRequest) Reset() {
+	*x = LogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevelRequest) ProtoMessage() {}
+
+func (x *LogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevelRequest.ProtoReflect.Descriptor instead.
+func (*LogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LogLevelRequest) GetComponent() Component {
+	if x != nil {
+		return x.Component
+	}
+	return Component_SERVICE
+}
+
+func (x *LogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogLevelRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *LogLevelRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+// *
+// It represents a request to trace the packets through the tunnels of the agents for a bounded period.
+type PacketTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // ID of a host whose agent traces the packets (all hosts if empty)
+	Peer      string `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`                   // ID of a remote peer whose packets are traced (all peers if empty)
+	Duration  int64  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`          // Period of the tracing in seconds (60 if 0, up to 600)
+}
+
+func (x *PacketTraceRequest) Reset() {
+	*x = PacketTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketTraceRequest) ProtoMessage() {}
+
+func (x *PacketTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketTraceRequest.ProtoReflect.Descriptor instead.
+func (*PacketTraceRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PacketTraceRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *PacketTraceRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *PacketTraceRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *PacketTraceRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// *
+// It represents a request to capture the packets through the tunnels of the agents into the pcapng files.
+type PacketCaptureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`        // ID of a host whose agent captures the packets (all hosts if empty)
+	Filter    string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`                      // BPF-like filter on the inner packets, e.g., "peer host-b and (tcp port 22 or icmp)" (all packets if empty)
+	Duration  int64  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`                 // Period of the capture in seconds (60 if 0, up to 600)
+	MaxBytes  int64  `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"` // Size cap of the inner and outer files in total (262144 if 0, up to 1048576)
+}
+
+func (x *PacketCaptureRequest) Reset() {
+	*x = PacketCaptureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCaptureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCaptureRequest) ProtoMessage() {}
+
+func (x *PacketCaptureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCaptureRequest.ProtoReflect.Descriptor instead.
+func (*PacketCaptureRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PacketCaptureRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *PacketCaptureRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *PacketCaptureRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *PacketCaptureRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PacketCaptureRequest) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+// *
+// It represents a packet capture of an agent.
+type PacketCapture struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId    string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId       string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	CaptureId    string `protobuf:"bytes,3,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`            // ID of the packet capture
+	Filter       string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                                   // Filter on the inner packets
+	Duration     int64  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`                              // Period of the capture in seconds
+	MaxBytes     int64  `protobuf:"varint,6,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`              // Size cap of the inner and outer files in total
+	State        string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`                                     // State of the capture (requested, completed or failed)
+	Message      string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`                                 // Message (e.g., the reason why the capture stopped or failed)
+	InnerPackets int64  `protobuf:"varint,9,opt,name=inner_packets,json=innerPackets,proto3" json:"inner_packets,omitempty"`  // Number of the inner packets
+	OuterPackets int64  `protobuf:"varint,10,opt,name=outer_packets,json=outerPackets,proto3" json:"outer_packets,omitempty"` // Number of the outer packets
+	InnerBytes   int64  `protobuf:"varint,11,opt,name=inner_bytes,json=innerBytes,proto3" json:"inner_bytes,omitempty"`       // Size of the inner file
+	OuterBytes   int64  `protobuf:"varint,12,opt,name=outer_bytes,json=outerBytes,proto3" json:"outer_bytes,omitempty"`       // Size of the outer file
+	RequestedAt  string `protobuf:"bytes,13,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`     // Time requested (RFC3339)
+	StartedAt    string `protobuf:"bytes,14,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`           // Time started by the agent (RFC3339)
+	StoppedAt    string `protobuf:"bytes,15,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`           // Time stopped by the agent (RFC3339)
+}
+
+func (x *PacketCapture) Reset() {
+	*x = PacketCapture{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCapture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCapture) ProtoMessage() {}
+
+func (x *PacketCapture) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCapture.ProtoReflect.Descriptor instead.
+func (*PacketCapture) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PacketCapture) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetCaptureId() string {
+	if x != nil {
+		return x.CaptureId
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PacketCapture) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *PacketCapture) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetInnerPackets() int64 {
+	if x != nil {
+		return x.InnerPackets
+	}
+	return 0
+}
+
+func (x *PacketCapture) GetOuterPackets() int64 {
+	if x != nil {
+		return x.OuterPackets
+	}
+	return 0
+}
+
+func (x *PacketCapture) GetInnerBytes() int64 {
+	if x != nil {
+		return x.InnerBytes
+	}
+	return 0
+}
+
+func (x *PacketCapture) GetOuterBytes() int64 {
+	if x != nil {
+		return x.OuterBytes
+	}
+	return 0
+}
+
+func (x *PacketCapture) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetStoppedAt() string {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return ""
+}
+
+// *
+// It represents a list of packet captures.
+type PacketCaptures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Captures []*PacketCapture `protobuf:"bytes,1,rep,name=captures,proto3" json:"captures,omitempty"`
+}
+
+func (x *PacketCaptures) Reset() {
+	*x = PacketCaptures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCaptures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCaptures) ProtoMessage() {}
+
+func (x *PacketCaptures) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCaptures.ProtoReflect.Descriptor instead.
+func (*PacketCaptures) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PacketCaptures) GetCaptures() []*PacketCapture {
+	if x != nil {
+		return x.Captures
+	}
+	return nil
+}
+
+// *
+// It represents a request of a pcapng file of a packet capture.
+type PacketCaptureFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string       `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	CaptureId string       `protobuf:"bytes,2,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`
+	HostId    string       `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Layer     CaptureLayer `protobuf:"varint,4,opt,name=layer,proto3,enum=cbnet.v1.CaptureLayer" json:"layer,omitempty"`
+}
+
+func (x *PacketCaptureFileRequest) Reset() {
+	*x = PacketCaptureFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCaptureFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCaptureFileRequest) ProtoMessage() {}
+
+func (x *PacketCaptureFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCaptureFileRequest.ProtoReflect.Descriptor instead.
+func (*PacketCaptureFileRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PacketCaptureFileRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *PacketCaptureFileRequest) GetCaptureId() string {
+	if x != nil {
+		return x.CaptureId
+	}
+	return ""
+}
+
+func (x *PacketCaptureFileRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *PacketCaptureFileRequest) GetLayer() CaptureLayer {
+	if x != nil {
+		return x.Layer
+	}
+	return CaptureLayer_INNER
+}
+
+// *
+// It represents a specification of Cloud Adaptive Network.
+type CLADNetSpecification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId        string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                        // ID of Cloud Adaptive Network
+	Name             string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                   // Name of Cloud Adaptive Network
+	Ipv4AddressSpace string `protobuf:"bytes,3,opt,name=ipv4_address_space,json=ipv4AddressSpace,proto3" json:"ipv4_address_space,omitempty"` // IPv4 address space (e.g., 192.168.0.0/24) of Cloud Adaptive Network
+	Description      string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                     // Description of Cloud Adaptive Network
+	RuleType         string `protobuf:"bytes,5,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`                           // Rule type of Cloud Adaptive Network (e.g, basic, cost-prioritized)
+}
+
+func (x *CLADNetSpecification) Reset() {
+	*x = CLADNetSpecification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CLADNetSpecification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CLADNetSpecification) ProtoMessage() {}
+
+func (x *CLADNetSpecification) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CLADNetSpecification.ProtoReflect.Descriptor instead.
+func (*CLADNetSpecification) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CLADNetSpecification) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *CLADNetSpecification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CLADNetSpecification) GetIpv4AddressSpace() string {
+	if x != nil {
+		return x.Ipv4AddressSpace
+	}
+	return ""
+}
+
+func (x *CLADNetSpecification) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CLADNetSpecification) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+// *
+// It represents a list of Cloud Adaptive Network specifications.
+type CLADNetSpecifications struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetSpecifications []*CLADNetSpecification `protobuf:"bytes,1,rep,name=cladnet_specifications,json=cladnetSpecifications,proto3" json:"cladnet_specifications,omitempty"` // A list of Cloud Adaptive Network specification
+}
+
+func (x *CLADNetSpecifications) Reset() {
+	*x = CLADNetSpecifications{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CLADNetSpecifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CLADNetSpecifications) ProtoMessage() {}
+
+func (x *CLADNetSpecifications) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CLADNetSpecifications.ProtoReflect.Descriptor instead.
+func (*CLADNetSpecifications) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CLADNetSpecifications) GetCladnetSpecifications() []*CLADNetSpecification {
+	if x != nil {
+		return x.CladnetSpecifications
+	}
+	return nil
+}
+
+// *
+// It represents a request of Cloud Adaptive Network.
+type CLADNetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+}
+
+func (x *CLADNetRequest) Reset() {
+	*x = CLADNetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CLADNetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CLADNetRequest) ProtoMessage() {}
+
+func (x *CLADNetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CLADNetRequest.ProtoReflect.Descriptor instead.
+func (*CLADNetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CLADNetRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+// *
+// It represents a list of IP networks (e.g., 10.10.5.2/16).
+type IPv4CIDRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ipv4Cidrs       []string `protobuf:"bytes,1,rep,name=ipv4_cidrs,json=ipv4Cidrs,proto3" json:"ipv4_cidrs,omitempty"`
+	ExpectedHosts   uint32   `protobuf:"varint,2,opt,name=expected_hosts,json=expectedHosts,proto3" json:"expected_hosts,omitempty"`       // The number of hosts expected (default: the number of IP networks)
+	HeadroomPercent uint32   `protobuf:"varint,3,opt,name=headroom_percent,json=headroomPercent,proto3" json:"headroom_percent,omitempty"` // The growth headroom (%) of the expected hosts
+}
+
+func (x *IPv4CIDRs) Reset() {
+	*x = IPv4CIDRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPv4CIDRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPv4CIDRs) ProtoMessage() {}
+
+func (x *IPv4CIDRs) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPv4CIDRs.ProtoReflect.Descriptor instead.
+func (*IPv4CIDRs) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IPv4CIDRs) GetIpv4Cidrs() []string {
+	if x != nil {
+		return x.Ipv4Cidrs
+	}
+	return nil
+}
+
+func (x *IPv4CIDRs) GetExpectedHosts() uint32 {
+	if x != nil {
+		return x.ExpectedHosts
+	}
+	return 0
+}
+
+func (x *IPv4CIDRs) GetHeadroomPercent() uint32 {
+	if x != nil {
+		return x.HeadroomPercent
+	}
+	return 0
+}
+
+// *
+// It represents a free IPv4 address block (CIDR block) in an IPv4 private address space.
+type FreeIPv4Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cidr                string `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`                                                            // A free CIDR block (e.g., 192.168.4.0/22)
+	Size                uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                                           // The number of IP addresses in the block
+	PrivateAddressSpace string `protobuf:"bytes,3,opt,name=private_address_space,json=privateAddressSpace,proto3" json:"private_address_space,omitempty"` // The private address space of the block (e.g., 192.168.0.0/16)
+}
+
+func (x *FreeIPv4Block) Reset() {
+	*x = FreeIPv4Block{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreeIPv4Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeIPv4Block) ProtoMessage() {}
+
+func (x *FreeIPv4Block) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeIPv4Block.ProtoReflect.Descriptor instead.
+func (*FreeIPv4Block) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FreeIPv4Block) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *FreeIPv4Block) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FreeIPv4Block) GetPrivateAddressSpace() string {
+	if x != nil {
+		return x.PrivateAddressSpace
+	}
+	return ""
+}
+
+// *
+// It represents available IPv4 private address spaces
+// (also known as CIDR block, CIDR range, IP address range).
+type AvailableIPv4PrivateAddressSpaces struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecommendedIpv4PrivateAddressSpace string           `protobuf:"bytes,1,opt,name=recommended_ipv4_private_address_space,json=recommendedIpv4PrivateAddressSpace,proto3" json:"recommended_ipv4_private_address_space,omitempty"` // A recommended IPv4 address space
+	AddressSpace10S                    []string         `protobuf:"bytes,2,rep,name=address_space10s,json=addressSpace10s,proto3" json:"address_space10s,omitempty"`                                                                // All available Ipv4 address space in 10.0.0.0/8
+	AddressSpace172S                   []string         `protobuf:"bytes,3,rep,name=address_space172s,json=addressSpace172s,proto3" json:"address_space172s,omitempty"`                                                             // All available Ipv4 address space in 172.16.0.0/12
+	AddressSpace192S                   []string         `protobuf:"bytes,4,rep,name=address_space192s,json=addressSpace192s,proto3" json:"address_space192s,omitempty"`                                                             // All available Ipv4 address space in 192.168.0.0/16
+	FreeBlocks                         []*FreeIPv4Block `protobuf:"bytes,5,rep,name=free_blocks,json=freeBlocks,proto3" json:"free_blocks,omitempty"`                                                                               // All free blocks with the sizes
+}
+
+func (x *AvailableIPv4PrivateAddressSpaces) Reset() {
+	*x = AvailableIPv4PrivateAddressSpaces{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailableIPv4PrivateAddressSpaces) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableIPv4PrivateAddressSpaces) ProtoMessage() {}
+
+func (x *AvailableIPv4PrivateAddressSpaces) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableIPv4PrivateAddressSpaces.ProtoReflect.Descriptor instead.
+func (*AvailableIPv4PrivateAddressSpaces) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AvailableIPv4PrivateAddressSpaces) GetRecommendedIpv4PrivateAddressSpace() string {
+	if x != nil {
+		return x.RecommendedIpv4PrivateAddressSpace
+	}
+	return ""
+}
+
+func (x *AvailableIPv4PrivateAddressSpaces) GetAddressSpace10S() []string {
+	if x != nil {
+		return x.AddressSpace10S
+	}
+	return nil
+}
+
+func (x *AvailableIPv4PrivateAddressSpaces) GetAddressSpace172S() []string {
+	if x != nil {
+		return x.AddressSpace172S
+	}
+	return nil
+}
+
+func (x *AvailableIPv4PrivateAddressSpaces) GetAddressSpace192S() []string {
+	if x != nil {
+		return x.AddressSpace192S
+	}
+	return nil
+}
+
+func (x *AvailableIPv4PrivateAddressSpaces) GetFreeBlocks() []*FreeIPv4Block {
+	if x != nil {
+		return x.FreeBlocks
+	}
+	return nil
+}
+
+// *
+// It represents a result of attempt to delete a Cloud Adaptive Network.
+type DeletionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsSucceeded          bool                  `protobuf:"varint,1,opt,name=is_succeeded,json=isSucceeded,proto3" json:"is_succeeded,omitempty"`                           // Success or failure
+	Message              string                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                                       // Message
+	CladnetSpecification *CLADNetSpecification `protobuf:"bytes,3,opt,name=cladnet_specification,json=cladnetSpecification,proto3" json:"cladnet_specification,omitempty"` // A specification of the target Cloud Adaptive Network
+}
+
+func (x *DeletionResult) Reset() {
+	*x = DeletionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletionResult) ProtoMessage() {}
+
+func (x *DeletionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletionResult.ProtoReflect.Descriptor instead.
+func (*DeletionResult) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeletionResult) GetIsSucceeded() bool {
+	if x != nil {
+		return x.IsSucceeded
+	}
+	return false
+}
+
+func (x *DeletionResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeletionResult) GetCladnetSpecification() *CLADNetSpecification {
+	if x != nil {
+		return x.CladnetSpecification
+	}
+	return nil
+}
+
+// *
+// It represents a peer in a Cloud Adaptive Network.
+type Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId           string            `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId              string            `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	HostName            string            `protobuf:"bytes,3,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	HostPrivateIpv4Cidr string            `protobuf:"bytes,4,opt,name=host_private_ipv4_cidr,json=hostPrivateIpv4Cidr,proto3" json:"host_private_ipv4_cidr,omitempty"`
+	HostPrivateIp       string            `protobuf:"bytes,5,opt,name=host_private_ip,json=hostPrivateIp,proto3" json:"host_private_ip,omitempty"`
+	HostPublicIp        string            `protobuf:"bytes,6,opt,name=host_public_ip,json=hostPublicIp,proto3" json:"host_public_ip,omitempty"`
+	Ipv4Cidr            string            `protobuf:"bytes,7,opt,name=ipv4_cidr,json=ipv4Cidr,proto3" json:"ipv4_cidr,omitempty"`
+	Ip                  string            `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip,omitempty"`
+	State               string            `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`
+	Details             *CloudInformation `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *Peer) Reset() {
+	*x = Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peer) ProtoMessage() {}
+
+func (x *Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
+func (*Peer) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Peer) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *Peer) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *Peer) GetHostName() string {
+	if x != nil {
+		return x.HostName
+	}
+	return ""
+}
+
+func (x *Peer) GetHostPrivateIpv4Cidr() string {
+	if x != nil {
+		return x.HostPrivateIpv4Cidr
+	}
+	return ""
+}
+
+func (x *Peer) GetHostPrivateIp() string {
+	if x != nil {
+		return x.HostPrivateIp
+	}
+	return ""
+}
+
+func (x *Peer) GetHostPublicIp() string {
+	if x != nil {
+		return x.HostPublicIp
+	}
+	return ""
+}
+
+func (x *Peer) GetIpv4Cidr() string {
+	if x != nil {
+		return x.Ipv4Cidr
+	}
+	return ""
+}
+
+func (x *Peer) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Peer) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Peer) GetDetails() *CloudInformation {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// *
+// It represents cloud information for a peer as details.
+type CloudInformation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderName       string `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	RegionId           string `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	AvailabilityZoneId string `protobuf:"bytes,3,opt,name=availability_zone_id,json=availabilityZoneId,proto3" json:"availability_zone_id,omitempty"`
+	VirtualNetworkId   string `protobuf:"bytes,4,opt,name=virtual_network_id,json=virtualNetworkId,proto3" json:"virtual_network_id,omitempty"`
+	SubnetId           string `protobuf:"bytes,5,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+}
+
+func (x *CloudInformation) Reset() {
+	*x = CloudInformation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudInformation) ProtoMessage() {}
+
+func (x *CloudInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudInformation.ProtoReflect.Descriptor instead.
+func (*CloudInformation) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CloudInformation) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *CloudInformation) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *CloudInformation) GetAvailabilityZoneId() string {
+	if x != nil {
+		return x.AvailabilityZoneId
+	}
+	return ""
+}
+
+func (x *CloudInformation) GetVirtualNetworkId() string {
+	if x != nil {
+		return x.VirtualNetworkId
+	}
+	return ""
+}
+
+func (x *CloudInformation) GetSubnetId() string {
+	if x != nil {
+		return x.SubnetId
+	}
+	return ""
+}
+
+// *
+// It represents a list of peers.
+type Peers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"` // A list of peers
+}
+
+func (x *Peers) Reset() {
+	*x = Peers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Peers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peers) ProtoMessage() {}
+
+func (x *Peers) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Peers.ProtoReflect.Descriptor instead.
+func (*Peers) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Peers) GetPeers() []*Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+// *
+// It represents a request of peer.
+type PeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+}
+
+func (x *PeerRequest) Reset() {
+	*x = PeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerRequest) ProtoMessage() {}
+
+func (x *PeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerRequest.ProtoReflect.Descriptor instead.
+func (*PeerRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PeerRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *PeerRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+// *
+// It represents a request of peer.
+type UpdateDetailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId        string            `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId           string            `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	CloudInformation *CloudInformation `protobuf:"bytes,3,opt,name=cloud_information,json=cloudInformation,proto3" json:"cloud_information,omitempty"`
+}
+
+func (x *UpdateDetailsRequest) Reset() {
+	*x = UpdateDetailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDetailsRequest) ProtoMessage() {}
+
+func (x *UpdateDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDetailsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateDetailsRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *UpdateDetailsRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *UpdateDetailsRequest) GetCloudInformation() *CloudInformation {
+	if x != nil {
+		return x.CloudInformation
+	}
+	return nil
+}
+
+// *
+// It represents a networking rule.
+type NetworkingRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId  string   `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId     []string `protobuf:"bytes,2,rep,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	HostName   []string `protobuf:"bytes,3,rep,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	PeerIp     []string `protobuf:"bytes,4,rep,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	SelectedIp []string `protobuf:"bytes,5,rep,name=selected_ip,json=selectedIp,proto3" json:"selected_ip,omitempty"`
+	PeerScope  []string `protobuf:"bytes,6,rep,name=peer_scope,json=peerScope,proto3" json:"peer_scope,omitempty"`
+	State      []string `protobuf:"bytes,7,rep,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *NetworkingRule) Reset() {
+	*x = NetworkingRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkingRule) ProtoMessage() {}
+
+func (x *NetworkingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkingRule.ProtoReflect.Descriptor instead.
+func (*NetworkingRule) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *NetworkingRule) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *NetworkingRule) GetHostId() []string {
+	if x != nil {
+		return x.HostId
+	}
+	return nil
+}
+
+func (x *NetworkingRule) GetHostName() []string {
+	if x != nil {
+		return x.HostName
+	}
+	return nil
+}
+
+func (x *NetworkingRule) GetPeerIp() []string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return nil
+}
+
+func (x *NetworkingRule) GetSelectedIp() []string {
+	if x != nil {
+		return x.SelectedIp
+	}
+	return nil
+}
+
+func (x *NetworkingRule) GetPeerScope() []string {
+	if x != nil {
+		return x.PeerScope
+	}
+	return nil
+}
+
+func (x *NetworkingRule) GetState() []string {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// *
+// It represents a request of join token.
+type JoinTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId   string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	TokenId     string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                  // Description of the join token (used on creation)
+	TtlSeconds  int64  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Time to live in seconds (used on creation, default: 86400)
+}
+
+func (x *JoinTokenRequest) Reset() {
+	*x = JoinTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokenRequest) ProtoMessage() {}
+
+func (x *JoinTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokenRequest.ProtoReflect.Descriptor instead.
+func (*JoinTokenRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *JoinTokenRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *JoinTokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *JoinTokenRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JoinTokenRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// *
+// It represents a join token to authenticate an agent joining a Cloud Adaptive Network.
+type JoinToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId     string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`       // ID of the join token
+	CladnetId   string `protobuf:"bytes,2,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	Token       string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`                          // The join token (only returned on creation)
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`              // Description of the join token
+	CreatedAt   string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Creation time (RFC3339)
+	ExpiresAt   string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Expiration time (RFC3339)
+}
+
+func (x *JoinToken) Reset() {
+	*x = JoinToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinToken) ProtoMessage() {}
+
+func (x *JoinToken) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinToken.ProtoReflect.Descriptor instead.
+func (*JoinToken) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *JoinToken) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *JoinToken) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *JoinToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinToken) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JoinToken) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JoinToken) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// *
+// It represents a list of join tokens.
+type JoinTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JoinTokens []*JoinToken `protobuf:"bytes,1,rep,name=join_tokens,json=joinTokens,proto3" json:"join_tokens,omitempty"` // A list of join tokens
+}
+
+func (x *JoinTokens) Reset() {
+	*x = JoinTokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTokens) ProtoMessage() {}
+
+func (x *JoinTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTokens.ProtoReflect.Descriptor instead.
+func (*JoinTokens) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *JoinTokens) GetJoinTokens() []*JoinToken {
+	if x != nil {
+		return x.JoinTokens
+	}
+	return nil
+}
+
+// *
+// It represents a request to rotate or revoke secrets (RSA public keys) of hosts.
+type SecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"` // ID of a host (all hosts if empty on rotation)
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`               // Reason of the request (audited)
+}
+
+func (x *SecretRequest) Reset() {
+	*x = SecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretRequest) ProtoMessage() {}
+
+func (x *SecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
+func (*SecretRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SecretRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *SecretRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *SecretRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// *
+// It represents an audit record of a change on the secrets (RSA public keys).
+type SecretAuditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId   string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	HostId      string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`          // ID of the host
+	Action      string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                        // i.e., register, rotate, rotation-request, revoke, reject
+	Fingerprint string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`              // SHA-256 fingerprint of the public key
+	Actor       string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`                          // Name of the API key (or host ID) performing the action
+	Reason      string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                        // Reason of the action
+	Timestamp   string `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                  // Time of the action (RFC3339)
+}
+
+func (x *SecretAuditRecord) Reset() {
+	*x = SecretAuditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretAuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretAuditRecord) ProtoMessage() {}
+
+func (x *SecretAuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretAuditRecord.ProtoReflect.Descriptor instead.
+func (*SecretAuditRecord) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SecretAuditRecord) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *SecretAuditRecord) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *SecretAuditRecord) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SecretAuditRecord) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *SecretAuditRecord) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SecretAuditRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SecretAuditRecord) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// *
+// It represents a list of audit records of the secrets.
+type SecretAuditRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*SecretAuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"` // A list of audit records
+}
+
+func (x *SecretAuditRecords) Reset() {
+	*x = SecretAuditRecords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretAuditRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretAuditRecords) ProtoMessage() {}
+
+func (x *SecretAuditRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretAuditRecords.ProtoReflect.Descriptor instead.
+func (*SecretAuditRecords) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SecretAuditRecords) GetRecords() []*SecretAuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// *
+// It represents an archive of the state of a Cloud Adaptive Network.
+type CLADNetArchive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	Archive   string `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`                      // The versioned archive (JSON) of the specification, peers, networking rules and secrets
+}
+
+func (x *CLADNetArchive) Reset() {
+	*x = CLADNetArchive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CLADNetArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CLADNetArchive) ProtoMessage() {}
+
+func (x *CLADNetArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CLADNetArchive.ProtoReflect.Descriptor instead.
+func (*CLADNetArchive) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CLADNetArchive) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *CLADNetArchive) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+// *
+// It represents a request to import a Cloud Adaptive Network from an archive.
+type ImportCLADNetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Archive        string `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`                                        // The archive (JSON) exported by exportCLADNet
+	NewCladnetId   string `protobuf:"bytes,2,opt,name=new_cladnet_id,json=newCladnetId,proto3" json:"new_cladnet_id,omitempty"`        // ID to import the Cloud Adaptive Network as (the archived ID if empty)
+	DropStalePeers bool   `protobuf:"varint,3,opt,name=drop_stale_peers,json=dropStalePeers,proto3" json:"drop_stale_peers,omitempty"` // Drop the peers whose agents were not alive on export
+	IgnoreOriginal bool   `protobuf:"varint,4,opt,name=ignore_original,json=ignoreOriginal,proto3" json:"ignore_original,omitempty"`   // Ignore the conflicts with the archived CLADNet (e.g., to restore a copy under a new ID while the original exists)
+}
+
+func (x *ImportCLADNetRequest) Reset() {
+	*x = ImportCLADNetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCLADNetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCLADNetRequest) ProtoMessage() {}
+
+func (x *ImportCLADNetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCLADNetRequest.ProtoReflect.Descriptor instead.
+func (*ImportCLADNetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ImportCLADNetRequest) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+func (x *ImportCLADNetRequest) GetNewCladnetId() string {
+	if x != nil {
+		return x.NewCladnetId
+	}
+	return ""
+}
+
+func (x *ImportCLADNetRequest) GetDropStalePeers() bool {
+	if x != nil {
+		return x.DropStalePeers
+	}
+	return false
+}
+
+func (x *ImportCLADNetRequest) GetIgnoreOriginal() bool {
+	if x != nil {
+		return x.IgnoreOriginal
+	}
+	return false
+}
+
+// *
+// It represents a request to apply a manifest (YAML) of a Cloud Adaptive Network.
+type ApplyCLADNetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`            // The manifest (YAML) of the specification, reservations, policies and labels
+	DryRun   bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Show the changes without applying them (i.e., diff)
+	Force    bool   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // Apply the unsafe changes (e.g., the IPv4 address space under peers)
+}
+
+func (x *ApplyCLADNetRequest) Reset() {
+	*x = ApplyCLADNetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyCLADNetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCLADNetRequest) ProtoMessage() {}
+
+func (x *ApplyCLADNetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCLADNetRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCLADNetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApplyCLADNetRequest) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ApplyCLADNetRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ApplyCLADNetRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// *
+// It represents a change of a Cloud Adaptive Network by a manifest.
+type CLADNetChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                       // Field to change (e.g., ipv4AddressSpace, labels.env)
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // Current value (empty if added)
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // Desired value (empty if removed)
+	Unsafe   bool   `protobuf:"varint,4,opt,name=unsafe,proto3" json:"unsafe,omitempty"`                    // Whether the change is unsafe or not
+	Reason   string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                     // Reason why the change is unsafe
+}
+
+func (x *CLADNetChange) Reset() {
+	*x = CLADNetChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CLADNetChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CLADNetChange) ProtoMessage() {}
+
+func (x *CLADNetChange) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CLADNetChange.ProtoReflect.Descriptor instead.
+func (*CLADNetChange) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CLADNetChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *CLADNetChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *CLADNetChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *CLADNetChange) GetUnsafe() bool {
+	if x != nil {
+		return x.Unsafe
+	}
+	return false
+}
+
+func (x *CLADNetChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// *
+// It represents a request to change the IPv4 address space of a Cloud Adaptive Network (i.e., re-addressing).
+type ReaddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId        string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                        // ID of Cloud Adaptive Network
+	Ipv4AddressSpace string `protobuf:"bytes,2,opt,name=ipv4_address_space,json=ipv4AddressSpace,proto3" json:"ipv4_address_space,omitempty"` // New IPv4 address space
+	OverlapSeconds   int64  `protobuf:"varint,3,opt,name=overlap_seconds,json=overlapSeconds,proto3" json:"overlap_seconds,omitempty"`        // Seconds to keep the previous IP address on each peer (default: 30)
+	DryRun           bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                // Show the new IP addresses without applying them
+}
+
+func (x *ReaddressRequest) Reset() {
+	*x = ReaddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReaddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReaddressRequest) ProtoMessage() {}
+
+func (x *ReaddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReaddressRequest.ProtoReflect.Descriptor instead.
+func (*ReaddressRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReaddressRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *ReaddressRequest) GetIpv4AddressSpace() string {
+	if x != nil {
+		return x.Ipv4AddressSpace
+	}
+	return ""
+}
+
+func (x *ReaddressRequest) GetOverlapSeconds() int64 {
+	if x != nil {
+		return x.OverlapSeconds
+	}
+	return 0
+}
+
+func (x *ReaddressRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// *
+// It represents the progress of re-addressing a peer.
+type PeerReaddressing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostId      string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                  // ID of the host
+	OldIpv4Cidr string `protobuf:"bytes,2,opt,name=old_ipv4_cidr,json=oldIpv4Cidr,proto3" json:"old_ipv4_cidr,omitempty"` // Previous IPv4 CIDR of the peer
+	NewIpv4Cidr string `protobuf:"bytes,3,opt,name=new_ipv4_cidr,json=newIpv4Cidr,proto3" json:"new_ipv4_cidr,omitempty"` // New IPv4 CIDR of the peer
+	State       string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`                                  // State (assigned, overlapping, completed)
+	Message     string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                              // Message reported by the agent
+}
+
+func (x *PeerReaddressing) Reset() {
+	*x = PeerReaddressing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReaddressing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReaddressing) ProtoMessage() {}
+
+func (x *PeerReaddressing) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReaddressing.ProtoReflect.Descriptor instead.
+func (*PeerReaddressing) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PeerReaddressing) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *PeerReaddressing) GetOldIpv4Cidr() string {
+	if x != nil {
+		return x.OldIpv4Cidr
+	}
+	return ""
+}
+
+func (x *PeerReaddressing) GetNewIpv4Cidr() string {
+	if x != nil {
+		return x.NewIpv4Cidr
+	}
+	return ""
+}
+
+func (x *PeerReaddressing) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PeerReaddressing) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// *
+// It represents the progress of re-addressing a Cloud Adaptive Network.
+type ReaddressingStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId           string              `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                                   // ID of Cloud Adaptive Network
+	OldIpv4AddressSpace string              `protobuf:"bytes,2,opt,name=old_ipv4_address_space,json=oldIpv4AddressSpace,proto3" json:"old_ipv4_address_space,omitempty"` // Previous IPv4 address space
+	NewIpv4AddressSpace string              `protobuf:"bytes,3,opt,name=new_ipv4_address_space,json=newIpv4AddressSpace,proto3" json:"new_ipv4_address_space,omitempty"` // New IPv4 address space
+	Peers               []*PeerReaddressing `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`                                                            // Progress of each peer
+	IsCompleted         bool                `protobuf:"varint,5,opt,name=is_completed,json=isCompleted,proto3" json:"is_completed,omitempty"`                            // Whether all peers are completed or not
+}
+
+func (x *ReaddressingStatus) Reset() {
+	*x = ReaddressingStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReaddressingStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReaddressingStatus) ProtoMessage() {}
+
+func (x *ReaddressingStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReaddressingStatus.ProtoReflect.Descriptor instead.
+func (*ReaddressingStatus) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReaddressingStatus) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *ReaddressingStatus) GetOldIpv4AddressSpace() string {
+	if x != nil {
+		return x.OldIpv4AddressSpace
+	}
+	return ""
+}
+
+func (x *ReaddressingStatus) GetNewIpv4AddressSpace() string {
+	if x != nil {
+		return x.NewIpv4AddressSpace
+	}
+	return ""
+}
+
+func (x *ReaddressingStatus) GetPeers() []*PeerReaddressing {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *ReaddressingStatus) GetIsCompleted() bool {
+	if x != nil {
+		return x.IsCompleted
+	}
+	return false
+}
+
+// *
+// It represents a result of applying a manifest of a Cloud Adaptive Network.
+type ApplyCLADNetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string           `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	Changes   []*CLADNetChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`                      // Changes between the registry and the manifest
+	Applied   bool             `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`                     // Whether the changes are applied or not
+}
+
+func (x *ApplyCLADNetResponse) Reset() {
+	*x = ApplyCLADNetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyCLADNetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCLADNetResponse) ProtoMessage() {}
+
+func (x *ApplyCLADNetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCLADNetResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCLADNetResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ApplyCLADNetResponse) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *ApplyCLADNetResponse) GetChanges() []*CLADNetChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ApplyCLADNetResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// *
+// It represents the counters of the traffic through the tunnel.
+type TrafficCounters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxPackets     uint64 `protobuf:"varint,1,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`             // Packets sent
+	TxBytes       uint64 `protobuf:"varint,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`                   // Bytes sent
+	RxPackets     uint64 `protobuf:"varint,3,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`             // Packets received
+	RxBytes       uint64 `protobuf:"varint,4,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`                   // Bytes received
+	Drops         uint64 `protobuf:"varint,5,opt,name=drops,proto3" json:"drops,omitempty"`                                      // Packets dropped
+	EncryptErrors uint64 `protobuf:"varint,6,opt,name=encrypt_errors,json=encryptErrors,proto3" json:"encrypt_errors,omitempty"` // Failures to encrypt
+	DecryptErrors uint64 `protobuf:"varint,7,opt,name=decrypt_errors,json=decryptErrors,proto3" json:"decrypt_errors,omitempty"` // Failures to decrypt
+}
+
+func (x *TrafficCounters) Reset() {
+	*x = TrafficCounters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficCounters) ProtoMessage() {}
+
+func (x *TrafficCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficCounters.ProtoReflect.Descriptor instead.
+func (*TrafficCounters) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TrafficCounters) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *TrafficCounters) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *TrafficCounters) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *TrafficCounters) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *TrafficCounters) GetDrops() uint64 {
+	if x != nil {
+		return x.Drops
+	}
+	return 0
+}
+
+func (x *TrafficCounters) GetEncryptErrors() uint64 {
+	if x != nil {
+		return x.EncryptErrors
+	}
+	return 0
+}
+
+func (x *TrafficCounters) GetDecryptErrors() uint64 {
+	if x != nil {
+		return x.DecryptErrors
+	}
+	return 0
+}
+
+// *
+// It represents the traffic statistics of a peer (cumulative since its agent started).
+type PeerStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string                      `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                                                                 // ID of Cloud Adaptive Network
+	HostId    string                      `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                                                                          // ID of the host
+	Peers     map[string]*TrafficCounters `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`  // Counters by the host ID of each remote peer
+	Drops     map[string]uint64           `protobuf:"bytes,4,rep,name=drops,proto3" json:"drops,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Dropped packets by reason (e.g., no_route)
+	Total     *TrafficCounters            `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`                                                                                          // Total counters of the peer
+	Timestamp string                      `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                                                                  // Time published by the agent (RFC3339)
+}
+
+func (x *PeerStatistics) Reset() {
+	*x = PeerStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerStatistics) ProtoMessage() {}
+
+func (x *PeerStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerStatistics.ProtoReflect.Descriptor instead.
+func (*PeerStatistics) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PeerStatistics) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *PeerStatistics) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *PeerStatistics) GetPeers() map[string]*TrafficCounters {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *PeerStatistics) GetDrops() map[string]uint64 {
+	if x != nil {
+		return x.Drops
+	}
+	return nil
+}
+
+func (x *PeerStatistics) GetTotal() *TrafficCounters {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *PeerStatistics) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// *
+// It represents the traffic statistics of all peers in a Cloud Adaptive Network.
+type CLADNetStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string            `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                                                                 // ID of Cloud Adaptive Network
+	Peers     []*PeerStatistics `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`                                                                                          // Statistics of each peer
+	Drops     map[string]uint64 `protobuf:"bytes,3,rep,name=drops,proto3" json:"drops,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Dropped packets by reason
+	Total     *TrafficCounters  `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`                                                                                          // Total counters of the CLADNet
+}
+
+func (x *CLADNetStatistics) Reset() {
+	*x = CLADNetStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CLADNetStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CLADNetStatistics) ProtoMessage() {}
+
+func (x *CLADNetStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CLADNetStatistics.ProtoReflect.Descriptor instead.
+func (*CLADNetStatistics) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CLADNetStatistics) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *CLADNetStatistics) GetPeers() []*PeerStatistics {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *CLADNetStatistics) GetDrops() map[string]uint64 {
+	if x != nil {
+		return x.Drops
+	}
+	return nil
+}
+
+func (x *CLADNetStatistics) GetTotal() *TrafficCounters {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// *
+// It represents a request of an agent in a Cloud Adaptive Network.
+type AgentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`          // ID of the agent's host
+}
+
+func (x *AgentRequest) Reset() {
+	*x = AgentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentRequest) ProtoMessage() {}
+
+func (x *AgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentRequest.ProtoReflect.Descriptor instead.
+func (*AgentRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AgentRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+// *
+// It represents a response to an agent.
+type AgentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsSucceeded bool   `protobuf:"varint,1,opt,name=is_succeeded,json=isSucceeded,proto3" json:"is_succeeded,omitempty"` // Success or failure
+	Message     string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                             // Message
+}
+
+func (x *AgentResponse) Reset() {
+	*x = AgentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentResponse) ProtoMessage() {}
+
+func (x *AgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentResponse.ProtoReflect.Descriptor instead.
+func (*AgentResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AgentResponse) GetIsSucceeded() bool {
+	if x != nil {
+		return x.IsSucceeded
+	}
+	return false
+}
+
+func (x *AgentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// *
+// It represents a registration of an agent to a Cloud Adaptive Network.
+type AgentRegistration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId              string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                                          // ID of Cloud Adaptive Network
+	HostId                 string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                                                   // ID of the agent's host
+	HostNetworkInformation string `protobuf:"bytes,3,opt,name=host_network_information,json=hostNetworkInformation,proto3" json:"host_network_information,omitempty"` // Host network information (JSON)
+}
+
+func (x *AgentRegistration) Reset() {
+	*x = AgentRegistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentRegistration) ProtoMessage() {}
+
+func (x *AgentRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentRegistration.ProtoReflect.Descriptor instead.
+func (*AgentRegistration) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AgentRegistration) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentRegistration) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentRegistration) GetHostNetworkInformation() string {
+	if x != nil {
+		return x.HostNetworkInformation
+	}
+	return ""
+}
+
+// *
+// It represents a heartbeat of an agent.
+type AgentHeartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`          // ID of the agent's host
+	State     string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                          // Current state of the peer
+}
+
+func (x *AgentHeartbeat) Reset() {
+	*x = AgentHeartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentHeartbeat) ProtoMessage() {}
+
+func (x *AgentHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentHeartbeat.ProtoReflect.Descriptor instead.
+func (*AgentHeartbeat) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AgentHeartbeat) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentHeartbeat) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentHeartbeat) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// *
+// It represents a state of an agent's peer.
+type AgentPeerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`          // ID of the agent's host
+	State     string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                          // State of the peer (e.g., configuring, tunneling, closing, released)
+}
+
+func (x *AgentPeerState) Reset() {
+	*x = AgentPeerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentPeerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentPeerState) ProtoMessage() {}
+
+func (x *AgentPeerState) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentPeerState.ProtoReflect.Descriptor instead.
+func (*AgentPeerState) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AgentPeerState) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentPeerState) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentPeerState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// *
+// It represents the re-addressing state of an agent.
+type AgentReaddressingState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`          // ID of the agent's host
+	State     string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                          // State of re-addressing (overlapping, completed)
+	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                      // Message (e.g., the previous IPv4 CIDR)
+}
+
+func (x *AgentReaddressingState) Reset() {
+	*x = AgentReaddressingState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentReaddressingState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentReaddressingState) ProtoMessage() {}
+
+func (x *AgentReaddressingState) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentReaddressingState.ProtoReflect.Descriptor instead.
+func (*AgentReaddressingState) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AgentReaddressingState) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentReaddressingState) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentReaddressingState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AgentReaddressingState) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// *
+// It represents a secret (RSA public key) of an agent.
+type AgentSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"` // ID of Cloud Adaptive Network
+	HostId    string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`          // ID of the agent's host
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // Base64-encoded RSA public key
+}
+
+func (x *AgentSecret) Reset() {
+	*x = AgentSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentSecret) ProtoMessage() {}
+
+func (x *AgentSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentSecret.ProtoReflect.Descriptor instead.
+func (*AgentSecret) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AgentSecret) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentSecret) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentSecret) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+// *
+// It represents a list of secrets.
+type AgentSecrets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets []*AgentSecret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"` // A list of secrets
+}
+
+func (x *AgentSecrets) Reset() {
+	*x = AgentSecrets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentSecrets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentSecrets) ProtoMessage() {}
+
+func (x *AgentSecrets) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentSecrets.ProtoReflect.Descriptor instead.
+func (*AgentSecrets) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AgentSecrets) GetSecrets() []*AgentSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// *
+// It represents a networking rule of an agent.
+type AgentNetworkingRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId      string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                // ID of Cloud Adaptive Network
+	HostId         string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                         // ID of the agent's host
+	NetworkingRule string `protobuf:"bytes,3,opt,name=networking_rule,json=networkingRule,proto3" json:"networking_rule,omitempty"` // Networking rule (JSON)
+}
+
+func (x *AgentNetworkingRule) Reset() {
+	*x = AgentNetworkingRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentNetworkingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentNetworkingRule) ProtoMessage() {}
+
+func (x *AgentNetworkingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentNetworkingRule.ProtoReflect.Descriptor instead.
+func (*AgentNetworkingRule) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AgentNetworkingRule) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentNetworkingRule) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentNetworkingRule) GetNetworkingRule() string {
+	if x != nil {
+		return x.NetworkingRule
+	}
+	return ""
+}
+
+// *
+// It represents a test result of an agent.
+type AgentTestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId     string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`             // ID of Cloud Adaptive Network
+	HostId        string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                      // ID of the agent's host
+	NetworkStatus string `protobuf:"bytes,3,opt,name=network_status,json=networkStatus,proto3" json:"network_status,omitempty"` // Network status (JSON)
+}
+
+func (x *AgentTestResult) Reset() {
+	*x = AgentTestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentTestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentTestResult) ProtoMessage() {}
+
+func (x *AgentTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentTestResult.ProtoReflect.Descriptor instead.
+func (*AgentTestResult) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AgentTestResult) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentTestResult) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentTestResult) GetNetworkStatus() string {
+	if x != nil {
+		return x.NetworkStatus
+	}
+	return ""
+}
+
+// *
+// It represents the traffic statistics of an agent.
+type AgentPeerStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId      string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                // ID of Cloud Adaptive Network
+	HostId         string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                         // ID of the agent's host
+	PeerStatistics string `protobuf:"bytes,3,opt,name=peer_statistics,json=peerStatistics,proto3" json:"peer_statistics,omitempty"` // Traffic statistics (JSON)
+}
+
+func (x *AgentPeerStatistics) Reset() {
+	*x = AgentPeerStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentPeerStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentPeerStatistics) ProtoMessage() {}
+
+func (x *AgentPeerStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentPeerStatistics.ProtoReflect.Descriptor instead.
+func (*AgentPeerStatistics) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AgentPeerStatistics) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentPeerStatistics) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentPeerStatistics) GetPeerStatistics() string {
+	if x != nil {
+		return x.PeerStatistics
+	}
+	return ""
+}
+
+// *
+// It represents a packet capture of an agent with the pcapng files.
+type AgentPacketCapture struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId     string `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`             // ID of Cloud Adaptive Network
+	HostId        string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                      // ID of the agent's host
+	PacketCapture string `protobuf:"bytes,3,opt,name=packet_capture,json=packetCapture,proto3" json:"packet_capture,omitempty"` // Metadata of the packet capture (JSON)
+	Inner         []byte `protobuf:"bytes,4,opt,name=inner,proto3" json:"inner,omitempty"`                                      // pcapng file of the inner packets
+	Outer         []byte `protobuf:"bytes,5,opt,name=outer,proto3" json:"outer,omitempty"`                                      // pcapng file of the outer packets
+}
+
+func (x *AgentPacketCapture) Reset() {
+	*x = AgentPacketCapture{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentPacketCapture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentPacketCapture) ProtoMessage() {}
+
+func (x *AgentPacketCapture) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentPacketCapture.ProtoReflect.Descriptor instead.
+func (*AgentPacketCapture) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AgentPacketCapture) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentPacketCapture) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentPacketCapture) GetPacketCapture() string {
+	if x != nil {
+		return x.PacketCapture
+	}
+	return ""
+}
+
+func (x *AgentPacketCapture) GetInner() []byte {
+	if x != nil {
+		return x.Inner
+	}
+	return nil
+}
+
+func (x *AgentPacketCapture) GetOuter() []byte {
+	if x != nil {
+		return x.Outer
+	}
+	return nil
+}
+
+// *
+// It represents a request to watch events for an agent.
+type AgentWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CladnetId     string         `protobuf:"bytes,1,opt,name=cladnet_id,json=cladnetId,proto3" json:"cladnet_id,omitempty"`                               // ID of Cloud Adaptive Network
+	HostId        string         `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                                        // ID of the agent's host
+	EventType     AgentEventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=cbnet.v1.AgentEventType" json:"event_type,omitempty"` // Type of events to watch
+	StartRevision int64          `protobuf:"varint,4,opt,name=start_revision,json=startRevision,proto3" json:"start_revision,omitempty"`                  // Revision to resume watching from (0: from now)
+}
+
+func (x *AgentWatchRequest) Reset() {
+	*x = AgentWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentWatchRequest) ProtoMessage() {}
+
+func (x *AgentWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentWatchRequest.ProtoReflect.Descriptor instead.
+func (*AgentWatchRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AgentWatchRequest) GetCladnetId() string {
+	if x != nil {
+		return x.CladnetId
+	}
+	return ""
+}
+
+func (x *AgentWatchRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentWatchRequest) GetEventType() AgentEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AgentEventType_PEER
+}
+
+func (x *AgentWatchRequest) GetStartRevision() int64 {
+	if x != nil {
+		return x.StartRevision
+	}
+	return 0
+}
+
+// *
+// It represents an event pushed to an agent.
+type AgentEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType AgentEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=cbnet.v1.AgentEventType" json:"event_type,omitempty"` // Type of the event
+	IsDeleted bool           `protobuf:"varint,2,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`                              // Whether the value has been deleted or not
+	HostId    string         `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                                        // ID of the host that the value belongs to
+	Value     string         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`                                                        // Value (e.g., JSON of a peer, a base64-encoded public key, a command message)
+	Revision  int64          `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`                                                 // Revision of the value
+}
+
+func (x *AgentEvent) Reset() {
+	*x = AgentEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_barista_network_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentEvent) ProtoMessage() {}
+
+func (x *AgentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_barista_network_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentEvent.ProtoReflect.Descriptor instead.
+func (*AgentEvent) Descriptor() ([]byte, []int) {
+	return file_cloud_barista_network_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AgentEvent) GetEventType() AgentEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AgentEventType_PEER
+}
+
+func (x *AgentEvent) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *AgentEvent) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *AgentEvent) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AgentEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+var File_cloud_barista_network_proto protoreflect.FileDescriptor
+
+var file_cloud_barista_network_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0x4e, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x74, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x61, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2b,
+	0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x4c, 0x0a, 0x0a, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x09, 0x54, 0x65,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x03,
+	0x72, 0x75, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75,
+	0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x73, 0x22, 0xfe, 0x01, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75,
+	0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x31, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x25,
+	0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x52,
+	0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61,
+	0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0xf5, 0x01,
+	0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x65, 0x6c, 0x6c,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda, 0x01, 0x0a, 0x0d, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x23, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x7d, 0x0a,
+	0x18, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61,
+	0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x9d, 0x02, 0x0a,
+	0x10, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x91, 0x01, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x22, 0xda, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x92, 0x01,
+	0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x7c, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9f, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61,
+	0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0xd4, 0x03, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x0e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x9f, 0x01, 0x0a, 0x18, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76, 0x34,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6e, 0x0a, 0x15, 0x43,
+	0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x16, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x0e, 0x43,
+	0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x09,
+	0x49, 0x50, 0x76, 0x34, 0x43, 0x49, 0x44, 0x52, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x76,
+	0x34, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x70, 0x76, 0x34, 0x43, 0x69, 0x64, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x72,
+	0x6f, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x6b, 0x0a, 0x0d, 0x46, 0x72,
+	0x65, 0x65, 0x49, 0x50, 0x76, 0x34, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x21, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x50, 0x76, 0x34, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x52, 0x0a,
+	0x26, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x76,
+	0x34, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x70, 0x76, 0x34, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x31, 0x30, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x31, 0x30, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x31, 0x37, 0x32,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x31, 0x37, 0x32, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x31, 0x39, 0x32, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x31, 0x39, 0x32, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x49, 0x50, 0x76, 0x34, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x53, 0x0a, 0x15, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x14, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x02, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x49, 0x70, 0x76, 0x34, 0x43, 0x69, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70,
+	0x12, 0x24, 0x0a, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63,
+	0x69, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x76, 0x34, 0x43,
+	0x69, 0x64, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22,
+	0xd1, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x76, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x22, 0x45, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x11, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xd4, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x10, 0x4a,
+	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xbb, 0x01, 0x0a,
+	0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x0a, 0x4a, 0x6f,
+	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x5f,
+	0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0xd1, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x4b, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x22, 0x49, 0x0a, 0x0e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x14,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x64, 0x72, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0d, 0x43, 0x4c,
+	0x41, 0x44, 0x4e, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x6e, 0x73, 0x61, 0x66, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x73,
+	0x61, 0x66, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x10,
+	0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x70, 0x76,
+	0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0xa3, 0x01, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x49, 0x70, 0x76, 0x34, 0x43, 0x69, 0x64,
+	0x72, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x63, 0x69,
+	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x49, 0x70, 0x76,
+	0x34, 0x43, 0x69, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x6f,
+	0x6c, 0x64, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6f, 0x6c, 0x64,
+	0x49, 0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x33, 0x0a, 0x16, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x6e, 0x65, 0x77, 0x49, 0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22,
+	0xe9, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x9c, 0x03, 0x0a, 0x0e,
+	0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x12, 0x39, 0x0a, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x53, 0x0a, 0x0a, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x11, 0x43,
+	0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x3c, 0x0a, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x2f, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x38,
+	0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x46, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0x4c, 0x0a, 0x0d, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x85,
+	0x01, 0x0a, 0x11, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x68, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x0e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x0e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x64, 0x0a, 0x0b, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22,
+	0x3f, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12,
+	0x2f, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x22, 0x76, 0x0a, 0x13, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61,
+	0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x70, 0x0a, 0x0f, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x76, 0x0a, 0x13, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61,
+	0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x0a, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x4e, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44,
+	0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f,
+	0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x73, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x50, 0x55, 0x54,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x4d, 0x54, 0x55, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x4a, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54,
+	0x59, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x4c, 0x41, 0x59, 0x5f,
+	0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x59, 0x10, 0x05, 0x2a, 0x33, 0x0a, 0x09, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
+	0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x4c, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x24,
+	0x0a, 0x0c, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x09,
+	0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54,
+	0x45, 0x52, 0x10, 0x01, 0x2a, 0x4d, 0x0a, 0x0e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x45, 0x45, 0x52, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10,
+	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x10, 0x03, 0x32, 0xd9, 0x0c, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x4f, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x93, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x18, 0x74, 0x65, 0x73, 0x74, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f,
+	0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x7b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x90, 0x01,
+	0x0a, 0x1e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x64, 0x61, 0x70, 0x74,
+	0x69, 0x76, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x40, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3a, 0x22, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x63,
+	0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x7b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x30, 0x01,
+	0x12, 0x69, 0x0a, 0x0e, 0x67, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c,
+	0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x75, 0x6e, 0x12, 0x86, 0x01, 0x0a, 0x13,
+	0x67, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x33, 0x12, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x75, 0x6e, 0x2f, 0x7b, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x12, 0xa3, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x49, 0x12, 0x47, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x75, 0x6e, 0x2f, 0x7b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x63, 0x0a, 0x0b, 0x73, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x63, 0x62, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2f, 0x6c, 0x6f, 0x67, 0x2d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x01, 0x2a, 0x12,
+	0x7f, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x1c, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0x84, 0x01, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x38, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2d, 0x63, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44,
+	0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f,
+	0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x2d, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x14,
+	0x67, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x5f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x59, 0x12, 0x57, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2d, 0x63, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x7b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x7b, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x7d, 0x32,
+	0xb0, 0x15, 0x0a, 0x1b, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76,
+	0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x68, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x18, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
+	0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5e, 0x0a, 0x0e, 0x67, 0x65, 0x74,
+	0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x12, 0x67, 0x0a, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x65, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x4c, 0x41, 0x44,
+	0x4e, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a,
+	0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0d, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xa1, 0x01, 0x0a, 0x2a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x50, 0x76, 0x34, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x13,
+	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x76, 0x34, 0x43, 0x49,
+	0x44, 0x52, 0x73, 0x1a, 0x2b, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x50, 0x76, 0x34, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c,
+	0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x50, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a, 0x07, 0x67, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x15,
+	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2f, 0x7b, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5c, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x65, 0x65, 0x72, 0x12, 0x81, 0x01, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x4f, 0x66, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x22, 0x3a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x34, 0x1a, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
+	0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70,
+	0x65, 0x65, 0x72, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x67, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x75, 0x6c, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f,
+	0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65, 0x65,
+	0x72, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x7a, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x43, 0x4c, 0x41,
+	0x44, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x18,
+	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x71, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x22,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61,
+	0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x79, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a,
+	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x75, 0x0a, 0x0c, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x62, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x77, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e,
+	0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x7b, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x2d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64,
+	0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x6c, 0x0a,
+	0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x18,
+	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x6e, 0x0a, 0x0d, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x4c,
+	0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65,
+	0x74, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x10, 0x72,
+	0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x12,
+	0x1a, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x27, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41,
+	0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x7b,
+	0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6b, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x4c,
+	0x41, 0x44, 0x4e, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6c, 0x61, 0x64, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x3a,
+	0x01, 0x2a, 0x32, 0xec, 0x06, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x68, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x1a, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b,
+	0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x62,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x1a, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x17, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x70, 0x75, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x17, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x19, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x17, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1d, 0x2e,
+	0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x1a, 0x17, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x2e, 0x63,
+	0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x17, 0x2e, 0x63, 0x62, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x62, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4c, 0x41, 0x44, 0x4e, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0f, 0x2e, 0x63, 0x62, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x42, 0x8c, 0x03, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x2f, 0x63,
+	0x62, 0x2d, 0x6c, 0x61, 0x72, 0x76, 0x61, 0x92, 0x41, 0xe5, 0x02, 0x12, 0xe2, 0x02, 0x0a, 0x2a,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x20, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x28, 0x63, 0x62, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x29, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x12,
+	0x47, 0x4e, 0x6f, 0x74, 0x65, 0x20, 0x2d, 0x20, 0x60, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x62,
+	0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73,
+	0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x60, 0x20, 0x69, 0x73, 0x20,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x60, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x60, 0x22, 0x69, 0x0a, 0x1a, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x2d, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2d, 0x62, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x1a, 0x29, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x2d, 0x74, 0x6f, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x62, 0x61, 0x72, 0x69, 0x73,
+	0x74, 0x61, 0x40, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2a, 0x59, 0x0a, 0x1a, 0x41, 0x70, 0x61, 0x63, 0x68, 0x65, 0x20, 0x4c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x32, 0x2e,
+	0x30, 0x12, 0x3b, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x62, 0x61, 0x72, 0x69,
+	0x73, 0x74, 0x61, 0x2f, 0x63, 0x62, 0x2d, 0x6c, 0x61, 0x72, 0x76, 0x61, 0x2f, 0x62, 0x6c, 0x6f,
+	0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x3a, 0x20,
+	0x0a, 0x15, 0x78, 0x2d, 0x73, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x6f,
+	0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x07, 0x1a, 0x05, 0x79, 0x61, 0x64, 0x64, 0x61,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cloud_barista_network_proto_rawDescOnce sync.Once
+	file_cloud_barista_network_proto_rawDescData = file_cloud_barista_network_proto_rawDesc
+)
+
+func file_cloud_barista_network_proto_rawDescGZIP() []byte {
+	file_cloud_barista_network_proto_rawDescOnce.Do(func() {
+		file_cloud_barista_network_proto_rawDescData = protoimpl.X.CompressGZIP(file_cloud_barista_network_proto_rawDescData)
+	})
+	return file_cloud_barista_network_proto_rawDescData
+}
+
+var file_cloud_barista_network_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_cloud_barista_network_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_cloud_barista_network_proto_goTypes = []interface{}{
+	(CommandType)(0),                          // 0: cbnet.v1.CommandType
+	(TestType)(0),                             // 1: cbnet.v1.TestType
+	(Component)(0),                            // 2: cbnet.v1.Component
+	(CaptureLayer)(0),                         // 3: cbnet.v1.CaptureLayer
+	(AgentEventType)(0),                       // 4: cbnet.v1.AgentEventType
+	(*ControlRequest)(nil),                    // 5: cbnet.v1.ControlRequest
+	(*ControlResponse)(nil),                   // 6: cbnet.v1.ControlResponse
+	(*TestRequest)(nil),                       // 7: cbnet.v1.TestRequest
+	(*TestResponse)(nil),                      // 8: cbnet.v1.TestResponse
+	(*TestResult)(nil),                        // 9: cbnet.v1.TestResult
+	(*TestEvent)(nil),                         // 10: cbnet.v1.TestEvent
+	(*TestRun)(nil),                           // 11: cbnet.v1.TestRun
+	(*TestRuns)(nil),                          // 12: cbnet.v1.TestRuns
+	(*TestRunRequest)(nil),                    // 13: cbnet.v1.TestRunRequest
+	(*TestResultCell)(nil),                    // 14: cbnet.v1.TestResultCell
+	(*TestResultRow)(nil),                     // 15: cbnet.v1.TestResultRow
+	(*TestResultMatrix)(nil),                  // 16: cbnet.v1.TestResultMatrix
+	(*TestRunComparisonRequest)(nil),          // 17: cbnet.v1.TestRunComparisonRequest
+	(*MetricComparison)(nil),                  // 18: cbnet.v1.MetricComparison
+	(*MetricSummary)(nil),                     // 19: cbnet.v1.MetricSummary
+	(*TestRunComparison)(nil),                 // 20: cbnet.v1.TestRunComparison
+	(*LogLevelRequest)(nil),                   // 21: cbnet.v1.LogLevelRequest
+	(*PacketTraceRequest)(nil),                // 22: cbnet.v1.PacketTraceRequest
+	(*PacketCaptureRequest)(nil),              // 23: cbnet.v1.PacketCaptureRequest
+	(*PacketCapture)(nil),                     // 24: cbnet.v1.PacketCapture
+	(*PacketCaptures)(nil),                    // 25: cbnet.v1.PacketCaptures
+	(*PacketCaptureFileRequest)(nil),          // 26: cbnet.v1.PacketCaptureFileRequest
+	(*CLADNetSpecification)(nil),              // 27: cbnet.v1.CLADNetSpecification
+	(*CLADNetSpecifications)(nil),             // 28: cbnet.v1.CLADNetSpecifications
+	(*CLADNetRequest)(nil),                    // 29: cbnet.v1.CLADNetRequest
+	(*IPv4CIDRs)(nil),                         // 30: cbnet.v1.IPv4CIDRs
+	(*FreeIPv4Block)(nil),                     // 31: cbnet.v1.FreeIPv4Block
+	(*AvailableIPv4PrivateAddressSpaces)(nil), // 32: cbnet.v1.AvailableIPv4PrivateAddressSpaces
+	(*DeletionResult)(nil),                    // 33: cbnet.v1.DeletionResult
+	(*Peer)(nil),                              // 34: cbnet.v1.Peer
+	(*CloudInformation)(nil),                  // 35: cbnet.v1.CloudInformation
+	(*Peers)(nil),                             // 36: cbnet.v1.Peers
+	(*PeerRequest)(nil),                       // 37: cbnet.v1.PeerRequest
+	(*UpdateDetailsRequest)(nil),              // 38: cbnet.v1.UpdateDetailsRequest
+	(*NetworkingRule)(nil),                    // 39: cbnet.v1.NetworkingRule
+	(*JoinTokenRequest)(nil),                  // 40: cbnet.v1.JoinTokenRequest
+	(*JoinToken)(nil),                         // 41: cbnet.v1.JoinToken
+	(*JoinTokens)(nil),                        // 42: cbnet.v1.JoinTokens
+	(*SecretRequest)(nil),                     // 43: cbnet.v1.SecretRequest
+	(*SecretAuditRecord)(nil),                 // 44: cbnet.v1.SecretAuditRecord
+	(*SecretAuditRecords)(nil),                // 45: cbnet.v1.SecretAuditRecords
+	(*CLADNetArchive)(nil),                    // 46: cbnet.v1.CLADNetArchive
+	(*ImportCLADNetRequest)(nil),              // 47: cbnet.v1.ImportCLADNetRequest
+	(*ApplyCLADNetRequest)(nil),               // 48: cbnet.v1.ApplyCLADNetRequest
+	(*CLADNetChange)(nil),                     // 49: cbnet.v1.CLADNetChange
+	(*ReaddressRequest)(nil),                  // 50: cbnet.v1.ReaddressRequest
+	(*PeerReaddressing)(nil),                  // 51: cbnet.v1.PeerReaddressing
+	(*ReaddressingStatus)(nil),                // 52: cbnet.v1.ReaddressingStatus
+	(*ApplyCLADNetResponse)(nil),              // 53: cbnet.v1.ApplyCLADNetResponse
+	(*TrafficCounters)(nil),                   // 54: cbnet.v1.TrafficCounters
+	(*PeerStatistics)(nil),                    // 55: cbnet.v1.PeerStatistics
+	(*CLADNetStatistics)(nil),                 // 56: cbnet.v1.CLADNetStatistics
+	(*AgentRequest)(nil),                      // 57: cbnet.v1.AgentRequest
+	(*AgentResponse)(nil),                     // 58: cbnet.v1.AgentResponse
+	(*AgentRegistration)(nil),                 // 59: cbnet.v1.AgentRegistration
+	(*AgentHeartbeat)(nil),                    // 60: cbnet.v1.AgentHeartbeat
+	(*AgentPeerState)(nil),                    // 61: cbnet.v1.AgentPeerState
+	(*AgentReaddressingState)(nil),            // 62: cbnet.v1.AgentReaddressingState
+	(*AgentSecret)(nil),                       // 63: cbnet.v1.AgentSecret
+	(*AgentSecrets)(nil),                      // 64: cbnet.v1.AgentSecrets
+	(*AgentNetworkingRule)(nil),               // 65: cbnet.v1.AgentNetworkingRule
+	(*AgentTestResult)(nil),                   // 66: cbnet.v1.AgentTestResult
+	(*AgentPeerStatistics)(nil),               // 67: cbnet.v1.AgentPeerStatistics
+	(*AgentPacketCapture)(nil),                // 68: cbnet.v1.AgentPacketCapture
+	(*AgentWatchRequest)(nil),                 // 69: cbnet.v1.AgentWatchRequest
+	(*AgentEvent)(nil),                        // 70: cbnet.v1.AgentEvent
+	nil,                                       // 71: cbnet.v1.TestResultCell.MetricsEntry
+	nil,                                       // 72: cbnet.v1.PeerStatistics.PeersEntry
+	nil,                                       // 73: cbnet.v1.PeerStatistics.DropsEntry
+	nil,                                       // 74: cbnet.v1.CLADNetStatistics.DropsEntry
+	(*emptypb.Empty)(nil),                     // 75: google.protobuf.Empty
+	(*wrapperspb.StringValue)(nil),            // 76: google.protobuf.StringValue
+	(*httpbody.HttpBody)(nil),                 // 77: google.api.HttpBody
+}
+var file_cloud_barista_network_proto_depIdxs = []int32{
+	0,  // 0: cbnet.v1.ControlRequest.command_type:type_name -> cbnet.v1.CommandType
+	1,  // 1: cbnet.v1.TestRequest.test_type:type_name -> cbnet.v1.TestType
+	9,  // 2: cbnet.v1.TestResponse.results:type_name -> cbnet.v1.TestResult
+	11, // 3: cbnet.v1.TestEvent.run:type_name -> cbnet.v1.TestRun
+	9,  // 4: cbnet.v1.TestEvent.result:type_name -> cbnet.v1.TestResult
+	1,  // 5: cbnet.v1.TestRun.test_type:type_name -> cbnet.v1.TestType
+	11, // 6: cbnet.v1.TestRuns.runs:type_name -> cbnet.v1.TestRun
+	71, // 7: cbnet.v1.TestResultCell.metrics:type_name -> cbnet.v1.TestResultCell.MetricsEntry
+	14, // 8: cbnet.v1.TestResultRow.cells:type_name -> cbnet.v1.TestResultCell
+	11, // 9: cbnet.v1.TestResultMatrix.run:type_name -> cbnet.v1.TestRun
+	15, // 10: cbnet.v1.TestResultMatrix.rows:type_name -> cbnet.v1.TestResultRow
+	11, // 11: cbnet.v1.TestRunComparison.base:type_name -> cbnet.v1.TestRun
+	11, // 12: cbnet.v1.TestRunComparison.target:type_name -> cbnet.v1.TestRun
+	19, // 13: cbnet.v1.TestRunComparison.summaries:type_name -> cbnet.v1.MetricSummary
+	18, // 14: cbnet.v1.TestRunComparison.comparisons:type_name -> cbnet.v1.MetricComparison
+	2,  // 15: cbnet.v1.LogLevelRequest.component:type_name -> cbnet.v1.Component
+	24, // 16: cbnet.v1.PacketCaptures.captures:type_name -> cbnet.v1.PacketCapture
+	3,  // 17: cbnet.v1.PacketCaptureFileRequest.layer:type_name -> cbnet.v1.CaptureLayer
+	27, // 18: cbnet.v1.CLADNetSpecifications.cladnet_specifications:type_name -> cbnet.v1.CLADNetSpecification
+	31, // 19: cbnet.v1.AvailableIPv4PrivateAddressSpaces.free_blocks:type_name -> cbnet.v1.FreeIPv4Block
+	27, // 20: cbnet.v1.DeletionResult.cladnet_specification:type_name -> cbnet.v1.CLADNetSpecification
+	35, // 21: cbnet.v1.Peer.details:type_name -> cbnet.v1.CloudInformation
+	34, // 22: cbnet.v1.Peers.peers:type_name -> cbnet.v1.Peer
+	35, // 23: cbnet.v1.UpdateDetailsRequest.cloud_information:type_name -> cbnet.v1.CloudInformation
+	41, // 24: cbnet.v1.JoinTokens.join_tokens:type_name -> cbnet.v1.JoinToken
+	44, // 25: cbnet.v1.SecretAuditRecords.records:type_name -> cbnet.v1.SecretAuditRecord
+	51, // 26: cbnet.v1.ReaddressingStatus.peers:type_name -> cbnet.v1.PeerReaddressing
+	49, // 27: cbnet.v1.ApplyCLADNetResponse.changes:type_name -> cbnet.v1.CLADNetChange
+	72, // 28: cbnet.v1.PeerStatistics.peers:type_name -> cbnet.v1.PeerStatistics.PeersEntry
+	73, // 29: cbnet.v1.PeerStatistics.drops:type_name -> cbnet.v1.PeerStatistics.DropsEntry
+	54, // 30: cbnet.v1.PeerStatistics.total:type_name -> cbnet.v1.TrafficCounters
+	55, // 31: cbnet.v1.CLADNetStatistics.peers:type_name -> cbnet.v1.PeerStatistics
+	74, // 32: cbnet.v1.CLADNetStatistics.drops:type_name -> cbnet.v1.CLADNetStatistics.DropsEntry
+	54, // 33: cbnet.v1.CLADNetStatistics.total:type_name -> cbnet.v1.TrafficCounters
+	63, // 34: cbnet.v1.AgentSecrets.secrets:type_name -> cbnet.v1.AgentSecret
+	4,  // 35: cbnet.v1.AgentWatchRequest.event_type:type_name -> cbnet.v1.AgentEventType
+	4,  // 36: cbnet.v1.AgentEvent.event_type:type_name -> cbnet.v1.AgentEventType
+	54, // 37: cbnet.v1.PeerStatistics.PeersEntry.value:type_name -> cbnet.v1.TrafficCounters
+	75, // 38: cbnet.v1.SystemManagementService.health:input_type -> google.protobuf.Empty
+	5,  // 39: cbnet.v1.SystemManagementService.controlCloudAdaptiveNetwork:input_type -> cbnet.v1.ControlRequest
+	7,  // 40: cbnet.v1.SystemManagementService.testCloudAdaptiveNetwork:input_type -> cbnet.v1.TestRequest
+	7,  // 41: cbnet.v1.SystemManagementService.testCloudAdaptiveNetworkStream:input_type -> cbnet.v1.TestRequest
+	29, // 42: cbnet.v1.SystemManagementService.getTestRunList:input_type -> cbnet.v1.CLADNetRequest
+	13, // 43: cbnet.v1.SystemManagementService.getTestResultMatrix:input_type -> cbnet.v1.TestRunRequest
+	17, // 44: cbnet.v1.SystemManagementService.compareTestRuns:input_type -> cbnet.v1.TestRunComparisonRequest
+	21, // 45: cbnet.v1.SystemManagementService.setLogLevel:input_type -> cbnet.v1.LogLevelRequest
+	22, // 46: cbnet.v1.SystemManagementService.tracePackets:input_type -> cbnet.v1.PacketTraceRequest
+	23, // 47: cbnet.v1.SystemManagementService.capturePackets:input_type -> cbnet.v1.PacketCaptureRequest
+	29, // 48: cbnet.v1.SystemManagementService.getPacketCaptureList:input_type -> cbnet.v1.CLADNetRequest
+	26, // 49: cbnet.v1.SystemManagementService.getPacketCaptureFile:input_type -> cbnet.v1.PacketCaptureFileRequest
+	29, // 50: cbnet.v1.CloudAdaptiveNetworkService.getCLADNet:input_type -> cbnet.v1.CLADNetRequest
+	75, // 51: cbnet.v1.CloudAdaptiveNetworkService.getCLADNetList:input_type -> google.protobuf.Empty
+	27, // 52: cbnet.v1.CloudAdaptiveNetworkService.createCLADNet:input_type -> cbnet.v1.CLADNetSpecification
+	29, // 53: cbnet.v1.CloudAdaptiveNetworkService.deleteCLADNet:input_type -> cbnet.v1.CLADNetRequest
+	27, // 54: cbnet.v1.CloudAdaptiveNetworkService.updateCLADNet:input_type -> cbnet.v1.CLADNetSpecification
+	30, // 55: cbnet.v1.CloudAdaptiveNetworkService.recommendAvailableIPv4PrivateAddressSpaces:input_type -> cbnet.v1.IPv4CIDRs
+	37, // 56: cbnet.v1.CloudAdaptiveNetworkService.getPeer:input_type -> cbnet.v1.PeerRequest
+	37, // 57: cbnet.v1.CloudAdaptiveNetworkService.getPeerList:input_type -> cbnet.v1.PeerRequest
+	38, // 58: cbnet.v1.CloudAdaptiveNetworkService.updateDetailsOfPeer:input_type -> cbnet.v1.UpdateDetailsRequest
+	37, // 59: cbnet.v1.CloudAdaptiveNetworkService.getPeerNetworkingRule:input_type -> cbnet.v1.PeerRequest
+	37, // 60: cbnet.v1.CloudAdaptiveNetworkService.getPeerStatistics:input_type -> cbnet.v1.PeerRequest
+	29, // 61: cbnet.v1.CloudAdaptiveNetworkService.getCLADNetStatistics:input_type -> cbnet.v1.CLADNetRequest
+	40, // 62: cbnet.v1.CloudAdaptiveNetworkService.createJoinToken:input_type -> cbnet.v1.JoinTokenRequest
+	29, // 63: cbnet.v1.CloudAdaptiveNetworkService.getJoinTokenList:input_type -> cbnet.v1.CLADNetRequest
+	40, // 64: cbnet.v1.CloudAdaptiveNetworkService.revokeJoinToken:input_type -> cbnet.v1.JoinTokenRequest
+	43, // 65: cbnet.v1.CloudAdaptiveNetworkService.rotateSecret:input_type -> cbnet.v1.SecretRequest
+	43, // 66: cbnet.v1.CloudAdaptiveNetworkService.revokeSecret:input_type -> cbnet.v1.SecretRequest
+	29, // 67: cbnet.v1.CloudAdaptiveNetworkService.getSecretAuditList:input_type -> cbnet.v1.CLADNetRequest
+	29, // 68: cbnet.v1.CloudAdaptiveNetworkService.exportCLADNet:input_type -> cbnet.v1.CLADNetRequest
+	47, // 69: cbnet.v1.CloudAdaptiveNetworkService.importCLADNet:input_type -> cbnet.v1.ImportCLADNetRequest
+	50, // 70: cbnet.v1.CloudAdaptiveNetworkService.readdressCLADNet:input_type -> cbnet.v1.ReaddressRequest
+	29, // 71: cbnet.v1.CloudAdaptiveNetworkService.getReaddressingStatus:input_type -> cbnet.v1.CLADNetRequest
+	48, // 72: cbnet.v1.CloudAdaptiveNetworkService.applyCLADNet:input_type -> cbnet.v1.ApplyCLADNetRequest
+	59, // 73: cbnet.v1.AgentService.registerAgent:input_type -> cbnet.v1.AgentRegistration
+	60, // 74: cbnet.v1.AgentService.heartbeat:input_type -> cbnet.v1.AgentHeartbeat
+	69, // 75: cbnet.v1.AgentService.watchAgentEvents:input_type -> cbnet.v1.AgentWatchRequest
+	61, // 76: cbnet.v1.AgentService.updatePeerState:input_type -> cbnet.v1.AgentPeerState
+	62, // 77: cbnet.v1.AgentService.updateReaddressingState:input_type -> cbnet.v1.AgentReaddressingState
+	63, // 78: cbnet.v1.AgentService.initializeSecret:input_type -> cbnet.v1.AgentSecret
+	65, // 79: cbnet.v1.AgentService.putNetworkingRule:input_type -> cbnet.v1.AgentNetworkingRule
+	66, // 80: cbnet.v1.AgentService.postTestResult:input_type -> cbnet.v1.AgentTestResult
+	67, // 81: cbnet.v1.AgentService.reportPeerStatistics:input_type -> cbnet.v1.AgentPeerStatistics
+	68, // 82: cbnet.v1.AgentService.uploadPacketCapture:input_type -> cbnet.v1.AgentPacketCapture
+	57, // 83: cbnet.v1.AgentService.getCLADNet:input_type -> cbnet.v1.AgentRequest
+	57, // 84: cbnet.v1.AgentService.getPeerList:input_type -> cbnet.v1.AgentRequest
+	76, // 85: cbnet.v1.SystemManagementService.health:output_type -> google.protobuf.StringValue
+	6,  // 86: cbnet.v1.SystemManagementService.controlCloudAdaptiveNetwork:output_type -> cbnet.v1.ControlResponse
+	8,  // 87: cbnet.v1.SystemManagementService.testCloudAdaptiveNetwork:output_type -> cbnet.v1.TestResponse
+	10, // 88: cbnet.v1.SystemManagementService.testCloudAdaptiveNetworkStream:output_type -> cbnet.v1.TestEvent
+	12, // 89: cbnet.v1.SystemManagementService.getTestRunList:output_type -> cbnet.v1.TestRuns
+	16, // 90: cbnet.v1.SystemManagementService.getTestResultMatrix:output_type -> cbnet.v1.TestResultMatrix
+	20, // 91: cbnet.v1.SystemManagementService.compareTestRuns:output_type -> cbnet.v1.TestRunComparison
+	6,  // 92: cbnet.v1.SystemManagementService.setLogLevel:output_type -> cbnet.v1.ControlResponse
+	6,  // 93: cbnet.v1.SystemManagementService.tracePackets:output_type -> cbnet.v1.ControlResponse
+	25, // 94: cbnet.v1.SystemManagementService.capturePackets:output_type -> cbnet.v1.PacketCaptures
+	25, // 95: cbnet.v1.SystemManagementService.getPacketCaptureList:output_type -> cbnet.v1.PacketCaptures
+	77, // 96: cbnet.v1.SystemManagementService.getPacketCaptureFile:output_type -> google.api.HttpBody
+	27, // 97: cbnet.v1.CloudAdaptiveNetworkService.getCLADNet:output_type -> cbnet.v1.CLADNetSpecification
+	28, // 98: cbnet.v1.CloudAdaptiveNetworkService.getCLADNetList:output_type -> cbnet.v1.CLADNetSpecifications
+	27, // 99: cbnet.v1.CloudAdaptiveNetworkService.createCLADNet:output_type -> cbnet.v1.CLADNetSpecification
+	33, // 100: cbnet.v1.CloudAdaptiveNetworkService.deleteCLADNet:output_type -> cbnet.v1.DeletionResult
+	27, // 101: cbnet.v1.CloudAdaptiveNetworkService.updateCLADNet:output_type -> cbnet.v1.CLADNetSpecification
+	32, // 102: cbnet.v1.CloudAdaptiveNetworkService.recommendAvailableIPv4PrivateAddressSpaces:output_type -> cbnet.v1.AvailableIPv4PrivateAddressSpaces
+	34, // 103: cbnet.v1.CloudAdaptiveNetworkService.getPeer:output_type -> cbnet.v1.Peer
+	36, // 104: cbnet.v1.CloudAdaptiveNetworkService.getPeerList:output_type -> cbnet.v1.Peers
+	34, // 105: cbnet.v1.CloudAdaptiveNetworkService.updateDetailsOfPeer:output_type -> cbnet.v1.Peer
+	39, // 106: cbnet.v1.CloudAdaptiveNetworkService.getPeerNetworkingRule:output_type -> cbnet.v1.NetworkingRule
+	55, // 107: cbnet.v1.CloudAdaptiveNetworkService.getPeerStatistics:output_type -> cbnet.v1.PeerStatistics
+	56, // 108: cbnet.v1.CloudAdaptiveNetworkService.getCLADNetStatistics:output_type -> cbnet.v1.CLADNetStatistics
+	41, // 109: cbnet.v1.CloudAdaptiveNetworkService.createJoinToken:output_type -> cbnet.v1.JoinToken
+	42, // 110: cbnet.v1.CloudAdaptiveNetworkService.getJoinTokenList:output_type -> cbnet.v1.JoinTokens
+	41, // 111: cbnet.v1.CloudAdaptiveNetworkService.revokeJoinToken:output_type -> cbnet.v1.JoinToken
+	6,  // 112: cbnet.v1.CloudAdaptiveNetworkService.rotateSecret:output_type -> cbnet.v1.ControlResponse
+	44, // 113: cbnet.v1.CloudAdaptiveNetworkService.revokeSecret:output_type -> cbnet.v1.SecretAuditRecord
+	45, // 114: cbnet.v1.CloudAdaptiveNetworkService.getSecretAuditList:output_type -> cbnet.v1.SecretAuditRecords
+	46, // 115: cbnet.v1.CloudAdaptiveNetworkService.exportCLADNet:output_type -> cbnet.v1.CLADNetArchive
+	27, // 116: cbnet.v1.CloudAdaptiveNetworkService.importCLADNet:output_type -> cbnet.v1.CLADNetSpecification
+	52, // 117: cbnet.v1.CloudAdaptiveNetworkService.readdressCLADNet:output_type -> cbnet.v1.ReaddressingStatus
+	52, // 118: cbnet.v1.CloudAdaptiveNetworkService.getReaddressingStatus:output_type -> cbnet.v1.ReaddressingStatus
+	53, // 119: cbnet.v1.CloudAdaptiveNetworkService.applyCLADNet:output_type -> cbnet.v1.ApplyCLADNetResponse
+	58, // 120: cbnet.v1.AgentService.registerAgent:output_type -> cbnet.v1.AgentResponse
+	58, // 121: cbnet.v1.AgentService.heartbeat:output_type -> cbnet.v1.AgentResponse
+	70, // 122: cbnet.v1.AgentService.watchAgentEvents:output_type -> cbnet.v1.AgentEvent
+	58, // 123: cbnet.v1.AgentService.updatePeerState:output_type -> cbnet.v1.AgentResponse
+	58, // 124: cbnet.v1.AgentService.updateReaddressingState:output_type -> cbnet.v1.AgentResponse
+	64, // 125: cbnet.v1.AgentService.initializeSecret:output_type -> cbnet.v1.AgentSecrets
+	58, // 126: cbnet.v1.AgentService.putNetworkingRule:output_type -> cbnet.v1.AgentResponse
+	58, // 127: cbnet.v1.AgentService.postTestResult:output_type -> cbnet.v1.AgentResponse
+	58, // 128: cbnet.v1.AgentService.reportPeerStatistics:output_type -> cbnet.v1.AgentResponse
+	58, // 129: cbnet.v1.AgentService.uploadPacketCapture:output_type -> cbnet.v1.AgentResponse
+	27, // 130: cbnet.v1.AgentService.getCLADNet:output_type -> cbnet.v1.CLADNetSpecification
+	36, // 131: cbnet.v1.AgentService.getPeerList:output_type -> cbnet.v1.Peers
+	85, // [85:132] is the sub-list for method output_type
+	38, // [38:85] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_cloud_barista_network_proto_init() }
+func file_cloud_barista_network_proto_init() {
+	if File_cloud_barista_network_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cloud_barista_network_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRuns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResultCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResultRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResultMatrix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRunComparisonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRunComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCaptureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCapture); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCaptures); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCaptureFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLADNetSpecification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLADNetSpecifications); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLADNetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPv4CIDRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreeIPv4Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailableIPv4PrivateAddressSpaces); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudInformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDetailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkingRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretAuditRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretAuditRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLADNetArchive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCLADNetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyCLADNetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1589,8 +6945,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlResponse); i {
+		file_cloud_barista_network_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLADNetChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1601,8 +6957,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestRequest); i {
+		file_cloud_barista_network_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReaddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1613,8 +6969,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestResponse); i {
+		file_cloud_barista_network_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReaddressing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1625,8 +6981,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CLADNetSpecification); i {
+		file_cloud_barista_network_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReaddressingStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1637,8 +6993,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CLADNetSpecifications); i {
+		file_cloud_barista_network_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyCLADNetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1649,8 +7005,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CLADNetRequest); i {
+		file_cloud_barista_network_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficCounters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1661,8 +7017,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPv4CIDRs); i {
+		file_cloud_barista_network_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1673,8 +7029,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AvailableIPv4PrivateAddressSpaces); i {
+		file_cloud_barista_network_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CLADNetStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1685,8 +7041,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletionResult); i {
+		file_cloud_barista_network_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1697,8 +7053,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Peer); i {
+		file_cloud_barista_network_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1709,8 +7065,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloudInformation); i {
+		file_cloud_barista_network_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1721,8 +7077,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Peers); i {
+		file_cloud_barista_network_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentHeartbeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1733,8 +7089,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerRequest); i {
+		file_cloud_barista_network_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentPeerState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1745,8 +7101,8 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDetailsRequest); i {
+		file_cloud_barista_network_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentReaddressingState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1757,8 +7113,92 @@ func file_cloud_barista_network_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_barista_network_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkingRule); i {
+		file_cloud_barista_network_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentSecrets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentNetworkingRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentTestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentPeerStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentPacketCapture); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_barista_network_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1775,10 +7215,10 @@ func file_cloud_barista_network_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cloud_barista_network_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   16,
+			NumEnums:      5,
+			NumMessages:   70,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_cloud_barista_network_proto_goTypes,
 		DependencyIndexes: file_cloud_barista_network_proto_depIdxs,
@@ -8,6 +8,7 @@ package cb_larva
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -30,6 +31,24 @@ type SystemManagementServiceClient interface {
 	ControlCloudAdaptiveNetwork(ctx context.Context, in *ControlRequest, opts ...grpc.CallOption) (*ControlResponse, error)
 	// Tests a Cloud Adaptvie Network
 	TestCloudAdaptiveNetwork(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (*TestResponse, error)
+	// Tests a Cloud Adaptvie Network, and streams the results until all targeted agents report them or the timeout
+	TestCloudAdaptiveNetworkStream(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (SystemManagementService_TestCloudAdaptiveNetworkStreamClient, error)
+	// Get the test runs in a Cloud Adaptive Network (the latest first)
+	GetTestRunList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*TestRuns, error)
+	// Get an N x N matrix of the results in a test run
+	GetTestResultMatrix(ctx context.Context, in *TestRunRequest, opts ...grpc.CallOption) (*TestResultMatrix, error)
+	// Compare the results of 2 test runs of the same test type
+	CompareTestRuns(ctx context.Context, in *TestRunComparisonRequest, opts ...grpc.CallOption) (*TestRunComparison, error)
+	// Changes the log level of the cb-network service, the controllers or the agents at runtime
+	SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+	// Traces the packets through the tunnels of the agents in a Cloud Adaptive Network for a bounded period
+	TracePackets(ctx context.Context, in *PacketTraceRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+	// Captures the packets through the tunnels of the agents in a Cloud Adaptive Network into the pcapng files
+	CapturePackets(ctx context.Context, in *PacketCaptureRequest, opts ...grpc.CallOption) (*PacketCaptures, error)
+	// Get the packet captures in a Cloud Adaptive Network
+	GetPacketCaptureList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*PacketCaptures, error)
+	// Get a pcapng file (the inner or outer packets) of a packet capture
+	GetPacketCaptureFile(ctx context.Context, in *PacketCaptureFileRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 }
 
 type systemManagementServiceClient struct {
@@ -67,6 +86,110 @@ func (c *systemManagementServiceClient) TestCloudAdaptiveNetwork(ctx context.Con
 	return out, nil
 }
 
+func (c *systemManagementServiceClient) TestCloudAdaptiveNetworkStream(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (SystemManagementService_TestCloudAdaptiveNetworkStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SystemManagementService_ServiceDesc.Streams[0], "/cbnet.v1.SystemManagementService/testCloudAdaptiveNetworkStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemManagementServiceTestCloudAdaptiveNetworkStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SystemManagementService_TestCloudAdaptiveNetworkStreamClient interface {
+	Recv() (*TestEvent, error)
+	grpc.ClientStream
+}
+
+type systemManagementServiceTestCloudAdaptiveNetworkStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemManagementServiceTestCloudAdaptiveNetworkStreamClient) Recv() (*TestEvent, error) {
+	m := new(TestEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *systemManagementServiceClient) GetTestRunList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*TestRuns, error) {
+	out := new(TestRuns)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/getTestRunList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemManagementServiceClient) GetTestResultMatrix(ctx context.Context, in *TestRunRequest, opts ...grpc.CallOption) (*TestResultMatrix, error) {
+	out := new(TestResultMatrix)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/getTestResultMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemManagementServiceClient) CompareTestRuns(ctx context.Context, in *TestRunComparisonRequest, opts ...grpc.CallOption) (*TestRunComparison, error) {
+	out := new(TestRunComparison)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/compareTestRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemManagementServiceClient) SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/setLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemManagementServiceClient) TracePackets(ctx context.Context, in *PacketTraceRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/tracePackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemManagementServiceClient) CapturePackets(ctx context.Context, in *PacketCaptureRequest, opts ...grpc.CallOption) (*PacketCaptures, error) {
+	out := new(PacketCaptures)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/capturePackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemManagementServiceClient) GetPacketCaptureList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*PacketCaptures, error) {
+	out := new(PacketCaptures)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/getPacketCaptureList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemManagementServiceClient) GetPacketCaptureFile(ctx context.Context, in *PacketCaptureFileRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.SystemManagementService/getPacketCaptureFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemManagementServiceServer is the server API for SystemManagementService service.
 // All implementations must embed UnimplementedSystemManagementServiceServer
 // for forward compatibility
@@ -77,6 +200,24 @@ type SystemManagementServiceServer interface {
 	ControlCloudAdaptiveNetwork(context.Context, *ControlRequest) (*ControlResponse, error)
 	// Tests a Cloud Adaptvie Network
 	TestCloudAdaptiveNetwork(context.Context, *TestRequest) (*TestResponse, error)
+	// Tests a Cloud Adaptvie Network, and streams the results until all targeted agents report them or the timeout
+	TestCloudAdaptiveNetworkStream(*TestRequest, SystemManagementService_TestCloudAdaptiveNetworkStreamServer) error
+	// Get the test runs in a Cloud Adaptive Network (the latest first)
+	GetTestRunList(context.Context, *CLADNetRequest) (*TestRuns, error)
+	// Get an N x N matrix of the results in a test run
+	GetTestResultMatrix(context.Context, *TestRunRequest) (*TestResultMatrix, error)
+	// Compare the results of 2 test runs of the same test type
+	CompareTestRuns(context.Context, *TestRunComparisonRequest) (*TestRunComparison, error)
+	// Changes the log level of the cb-network service, the controllers or the agents at runtime
+	SetLogLevel(context.Context, *LogLevelRequest) (*ControlResponse, error)
+	// Traces the packets through the tunnels of the agents in a Cloud Adaptive Network for a bounded period
+	TracePackets(context.Context, *PacketTraceRequest) (*ControlResponse, error)
+	// Captures the packets through the tunnels of the agents in a Cloud Adaptive Network into the pcapng files
+	CapturePackets(context.Context, *PacketCaptureRequest) (*PacketCaptures, error)
+	// Get the packet captures in a Cloud Adaptive Network
+	GetPacketCaptureList(context.Context, *CLADNetRequest) (*PacketCaptures, error)
+	// Get a pcapng file (the inner or outer packets) of a packet capture
+	GetPacketCaptureFile(context.Context, *PacketCaptureFileRequest) (*httpbody.HttpBody, error)
 	mustEmbedUnimplementedSystemManagementServiceServer()
 }
 
@@ -93,6 +234,33 @@ func (UnimplementedSystemManagementServiceServer) ControlCloudAdaptiveNetwork(co
 func (UnimplementedSystemManagementServiceServer) TestCloudAdaptiveNetwork(context.Context, *TestRequest) (*TestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestCloudAdaptiveNetwork not implemented")
 }
+func (UnimplementedSystemManagementServiceServer) TestCloudAdaptiveNetworkStream(*TestRequest, SystemManagementService_TestCloudAdaptiveNetworkStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method TestCloudAdaptiveNetworkStream not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) GetTestRunList(context.Context, *CLADNetRequest) (*TestRuns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestRunList not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) GetTestResultMatrix(context.Context, *TestRunRequest) (*TestResultMatrix, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestResultMatrix not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) CompareTestRuns(context.Context, *TestRunComparisonRequest) (*TestRunComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareTestRuns not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) SetLogLevel(context.Context, *LogLevelRequest) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) TracePackets(context.Context, *PacketTraceRequest) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TracePackets not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) CapturePackets(context.Context, *PacketCaptureRequest) (*PacketCaptures, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapturePackets not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) GetPacketCaptureList(context.Context, *CLADNetRequest) (*PacketCaptures, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPacketCaptureList not implemented")
+}
+func (UnimplementedSystemManagementServiceServer) GetPacketCaptureFile(context.Context, *PacketCaptureFileRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPacketCaptureFile not implemented")
+}
 func (UnimplementedSystemManagementServiceServer) mustEmbedUnimplementedSystemManagementServiceServer() {
 }
 
@@ -161,6 +329,171 @@ func _SystemManagementService_TestCloudAdaptiveNetwork_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemManagementService_TestCloudAdaptiveNetworkStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemManagementServiceServer).TestCloudAdaptiveNetworkStream(m, &systemManagementServiceTestCloudAdaptiveNetworkStreamServer{stream})
+}
+
+type SystemManagementService_TestCloudAdaptiveNetworkStreamServer interface {
+	Send(*TestEvent) error
+	grpc.ServerStream
+}
+
+type systemManagementServiceTestCloudAdaptiveNetworkStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemManagementServiceTestCloudAdaptiveNetworkStreamServer) Send(m *TestEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SystemManagementService_GetTestRunList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).GetTestRunList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/getTestRunList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).GetTestRunList(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemManagementService_GetTestResultMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).GetTestResultMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/getTestResultMatrix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).GetTestResultMatrix(ctx, req.(*TestRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemManagementService_CompareTestRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRunComparisonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).CompareTestRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/compareTestRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).CompareTestRuns(ctx, req.(*TestRunComparisonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemManagementService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/setLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).SetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemManagementService_TracePackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PacketTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).TracePackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/tracePackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).TracePackets(ctx, req.(*PacketTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemManagementService_CapturePackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PacketCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).CapturePackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/capturePackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).CapturePackets(ctx, req.(*PacketCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemManagementService_GetPacketCaptureList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).GetPacketCaptureList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/getPacketCaptureList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).GetPacketCaptureList(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemManagementService_GetPacketCaptureFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PacketCaptureFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemManagementServiceServer).GetPacketCaptureFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.SystemManagementService/getPacketCaptureFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemManagementServiceServer).GetPacketCaptureFile(ctx, req.(*PacketCaptureFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SystemManagementService_ServiceDesc is the grpc.ServiceDesc for SystemManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -169,449 +502,1515 @@ var SystemManagementService_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*SystemManagementServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "health",
-			Handler:    _SystemManagementService_Health_Handler,
+			MethodName: "health",
+			Handler:    _SystemManagementService_Health_Handler,
+		},
+		{
+			MethodName: "controlCloudAdaptiveNetwork",
+			Handler:    _SystemManagementService_ControlCloudAdaptiveNetwork_Handler,
+		},
+		{
+			MethodName: "testCloudAdaptiveNetwork",
+			Handler:    _SystemManagementService_TestCloudAdaptiveNetwork_Handler,
+		},
+		{
+			MethodName: "getTestRunList",
+			Handler:    _SystemManagementService_GetTestRunList_Handler,
+		},
+		{
+			MethodName: "getTestResultMatrix",
+			Handler:    _SystemManagementService_GetTestResultMatrix_Handler,
+		},
+		{
+			MethodName: "compareTestRuns",
+			Handler:    _SystemManagementService_CompareTestRuns_Handler,
+		},
+		{
+			MethodName: "setLogLevel",
+			Handler:    _SystemManagementService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "tracePackets",
+			Handler:    _SystemManagementService_TracePackets_Handler,
+		},
+		{
+			MethodName: "capturePackets",
+			Handler:    _SystemManagementService_CapturePackets_Handler,
+		},
+		{
+			MethodName: "getPacketCaptureList",
+			Handler:    _SystemManagementService_GetPacketCaptureList_Handler,
+		},
+		{
+			MethodName: "getPacketCaptureFile",
+			Handler:    _SystemManagementService_GetPacketCaptureFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "testCloudAdaptiveNetworkStream",
+			Handler:       _SystemManagementService_TestCloudAdaptiveNetworkStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cloud_barista_network.proto",
+}
+
+// CloudAdaptiveNetworkServiceClient is the client API for CloudAdaptiveNetworkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CloudAdaptiveNetworkServiceClient interface {
+	// Get a Cloud Adaptive Network specification
+	GetCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error)
+	// Get a list of Cloud Adaptive Network specifications
+	GetCLADNetList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CLADNetSpecifications, error)
+	// Create a new Cloud Adaptive Network
+	CreateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error)
+	// [To be provided] Delete a Cloud Adaptive Network
+	DeleteCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*DeletionResult, error)
+	// Update a Cloud Adaptive Network
+	UpdateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error)
+	// Recommend available IPv4 private address spaces for Cloud Adaptive Network
+	RecommendAvailableIPv4PrivateAddressSpaces(ctx context.Context, in *IPv4CIDRs, opts ...grpc.CallOption) (*AvailableIPv4PrivateAddressSpaces, error)
+	// Get a peer in a Cloud Adaptive Network
+	GetPeer(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peer, error)
+	// Get a list of peers in a Cloud Adaptive Network
+	GetPeerList(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peers, error)
+	// Update a peer's details
+	UpdateDetailsOfPeer(ctx context.Context, in *UpdateDetailsRequest, opts ...grpc.CallOption) (*Peer, error)
+	// Get a networking rule of a peer
+	GetPeerNetworkingRule(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*NetworkingRule, error)
+	// Get the traffic statistics of a peer
+	GetPeerStatistics(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*PeerStatistics, error)
+	// Get the traffic statistics of all peers in a Cloud Adaptive Network
+	GetCLADNetStatistics(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetStatistics, error)
+	// Create a join token for agents joining a Cloud Adaptive Network
+	CreateJoinToken(ctx context.Context, in *JoinTokenRequest, opts ...grpc.CallOption) (*JoinToken, error)
+	// Get a list of join tokens of a Cloud Adaptive Network
+	GetJoinTokenList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*JoinTokens, error)
+	// Revoke a join token of a Cloud Adaptive Network
+	RevokeJoinToken(ctx context.Context, in *JoinTokenRequest, opts ...grpc.CallOption) (*JoinToken, error)
+	// Rotate the secrets (RSA keys) of a host or all hosts in a Cloud Adaptive Network
+	RotateSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+	// Revoke a secret (RSA public key) of a host and force the host to re-key
+	RevokeSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*SecretAuditRecord, error)
+	// Get a list of audit records of the secrets in a Cloud Adaptive Network
+	GetSecretAuditList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*SecretAuditRecords, error)
+	// Export the state of a Cloud Adaptive Network to an archive
+	ExportCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetArchive, error)
+	// Import a Cloud Adaptive Network from an archive (the IP addresses of the peers are preserved)
+	ImportCLADNet(ctx context.Context, in *ImportCLADNetRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error)
+	// Change the IPv4 address space of a Cloud Adaptive Network, and re-address the peers
+	ReaddressCLADNet(ctx context.Context, in *ReaddressRequest, opts ...grpc.CallOption) (*ReaddressingStatus, error)
+	// Get the progress of re-addressing a Cloud Adaptive Network
+	GetReaddressingStatus(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*ReaddressingStatus, error)
+	// Apply a manifest of a Cloud Adaptive Network (create, or update by the diff)
+	ApplyCLADNet(ctx context.Context, in *ApplyCLADNetRequest, opts ...grpc.CallOption) (*ApplyCLADNetResponse, error)
+}
+
+type cloudAdaptiveNetworkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCloudAdaptiveNetworkServiceClient(cc grpc.ClientConnInterface) CloudAdaptiveNetworkServiceClient {
+	return &cloudAdaptiveNetworkServiceClient{cc}
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
+	out := new(CLADNetSpecification)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetCLADNetList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CLADNetSpecifications, error) {
+	out := new(CLADNetSpecifications)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) CreateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
+	out := new(CLADNetSpecification)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/createCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) DeleteCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*DeletionResult, error) {
+	out := new(DeletionResult)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/deleteCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) UpdateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
+	out := new(CLADNetSpecification)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/updateCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) RecommendAvailableIPv4PrivateAddressSpaces(ctx context.Context, in *IPv4CIDRs, opts ...grpc.CallOption) (*AvailableIPv4PrivateAddressSpaces, error) {
+	out := new(AvailableIPv4PrivateAddressSpaces)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/recommendAvailableIPv4PrivateAddressSpaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetPeer(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peer, error) {
+	out := new(Peer)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetPeerList(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peers, error) {
+	out := new(Peers)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getPeerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) UpdateDetailsOfPeer(ctx context.Context, in *UpdateDetailsRequest, opts ...grpc.CallOption) (*Peer, error) {
+	out := new(Peer)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/updateDetailsOfPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetPeerNetworkingRule(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*NetworkingRule, error) {
+	out := new(NetworkingRule)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getPeerNetworkingRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetPeerStatistics(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*PeerStatistics, error) {
+	out := new(PeerStatistics)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getPeerStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetCLADNetStatistics(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetStatistics, error) {
+	out := new(CLADNetStatistics)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNetStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) CreateJoinToken(ctx context.Context, in *JoinTokenRequest, opts ...grpc.CallOption) (*JoinToken, error) {
+	out := new(JoinToken)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/createJoinToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetJoinTokenList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*JoinTokens, error) {
+	out := new(JoinTokens)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getJoinTokenList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) RevokeJoinToken(ctx context.Context, in *JoinTokenRequest, opts ...grpc.CallOption) (*JoinToken, error) {
+	out := new(JoinToken)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/revokeJoinToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) RotateSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/rotateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) RevokeSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*SecretAuditRecord, error) {
+	out := new(SecretAuditRecord)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/revokeSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetSecretAuditList(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*SecretAuditRecords, error) {
+	out := new(SecretAuditRecords)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getSecretAuditList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) ExportCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetArchive, error) {
+	out := new(CLADNetArchive)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/exportCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) ImportCLADNet(ctx context.Context, in *ImportCLADNetRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
+	out := new(CLADNetSpecification)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/importCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) ReaddressCLADNet(ctx context.Context, in *ReaddressRequest, opts ...grpc.CallOption) (*ReaddressingStatus, error) {
+	out := new(ReaddressingStatus)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/readdressCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) GetReaddressingStatus(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*ReaddressingStatus, error) {
+	out := new(ReaddressingStatus)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getReaddressingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAdaptiveNetworkServiceClient) ApplyCLADNet(ctx context.Context, in *ApplyCLADNetRequest, opts ...grpc.CallOption) (*ApplyCLADNetResponse, error) {
+	out := new(ApplyCLADNetResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/applyCLADNet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CloudAdaptiveNetworkServiceServer is the server API for CloudAdaptiveNetworkService service.
+// All implementations must embed UnimplementedCloudAdaptiveNetworkServiceServer
+// for forward compatibility
+type CloudAdaptiveNetworkServiceServer interface {
+	// Get a Cloud Adaptive Network specification
+	GetCLADNet(context.Context, *CLADNetRequest) (*CLADNetSpecification, error)
+	// Get a list of Cloud Adaptive Network specifications
+	GetCLADNetList(context.Context, *emptypb.Empty) (*CLADNetSpecifications, error)
+	// Create a new Cloud Adaptive Network
+	CreateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error)
+	// [To be provided] Delete a Cloud Adaptive Network
+	DeleteCLADNet(context.Context, *CLADNetRequest) (*DeletionResult, error)
+	// Update a Cloud Adaptive Network
+	UpdateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error)
+	// Recommend available IPv4 private address spaces for Cloud Adaptive Network
+	RecommendAvailableIPv4PrivateAddressSpaces(context.Context, *IPv4CIDRs) (*AvailableIPv4PrivateAddressSpaces, error)
+	// Get a peer in a Cloud Adaptive Network
+	GetPeer(context.Context, *PeerRequest) (*Peer, error)
+	// Get a list of peers in a Cloud Adaptive Network
+	GetPeerList(context.Context, *PeerRequest) (*Peers, error)
+	// Update a peer's details
+	UpdateDetailsOfPeer(context.Context, *UpdateDetailsRequest) (*Peer, error)
+	// Get a networking rule of a peer
+	GetPeerNetworkingRule(context.Context, *PeerRequest) (*NetworkingRule, error)
+	// Get the traffic statistics of a peer
+	GetPeerStatistics(context.Context, *PeerRequest) (*PeerStatistics, error)
+	// Get the traffic statistics of all peers in a Cloud Adaptive Network
+	GetCLADNetStatistics(context.Context, *CLADNetRequest) (*CLADNetStatistics, error)
+	// Create a join token for agents joining a Cloud Adaptive Network
+	CreateJoinToken(context.Context, *JoinTokenRequest) (*JoinToken, error)
+	// Get a list of join tokens of a Cloud Adaptive Network
+	GetJoinTokenList(context.Context, *CLADNetRequest) (*JoinTokens, error)
+	// Revoke a join token of a Cloud Adaptive Network
+	RevokeJoinToken(context.Context, *JoinTokenRequest) (*JoinToken, error)
+	// Rotate the secrets (RSA keys) of a host or all hosts in a Cloud Adaptive Network
+	RotateSecret(context.Context, *SecretRequest) (*ControlResponse, error)
+	// Revoke a secret (RSA public key) of a host and force the host to re-key
+	RevokeSecret(context.Context, *SecretRequest) (*SecretAuditRecord, error)
+	// Get a list of audit records of the secrets in a Cloud Adaptive Network
+	GetSecretAuditList(context.Context, *CLADNetRequest) (*SecretAuditRecords, error)
+	// Export the state of a Cloud Adaptive Network to an archive
+	ExportCLADNet(context.Context, *CLADNetRequest) (*CLADNetArchive, error)
+	// Import a Cloud Adaptive Network from an archive (the IP addresses of the peers are preserved)
+	ImportCLADNet(context.Context, *ImportCLADNetRequest) (*CLADNetSpecification, error)
+	// Change the IPv4 address space of a Cloud Adaptive Network, and re-address the peers
+	ReaddressCLADNet(context.Context, *ReaddressRequest) (*ReaddressingStatus, error)
+	// Get the progress of re-addressing a Cloud Adaptive Network
+	GetReaddressingStatus(context.Context, *CLADNetRequest) (*ReaddressingStatus, error)
+	// Apply a manifest of a Cloud Adaptive Network (create, or update by the diff)
+	ApplyCLADNet(context.Context, *ApplyCLADNetRequest) (*ApplyCLADNetResponse, error)
+	mustEmbedUnimplementedCloudAdaptiveNetworkServiceServer()
+}
+
+// UnimplementedCloudAdaptiveNetworkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCloudAdaptiveNetworkServiceServer struct {
+}
+
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetCLADNet(context.Context, *CLADNetRequest) (*CLADNetSpecification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetCLADNetList(context.Context, *emptypb.Empty) (*CLADNetSpecifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCLADNetList not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) CreateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) DeleteCLADNet(context.Context, *CLADNetRequest) (*DeletionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) UpdateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) RecommendAvailableIPv4PrivateAddressSpaces(context.Context, *IPv4CIDRs) (*AvailableIPv4PrivateAddressSpaces, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendAvailableIPv4PrivateAddressSpaces not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetPeer(context.Context, *PeerRequest) (*Peer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetPeerList(context.Context, *PeerRequest) (*Peers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerList not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) UpdateDetailsOfPeer(context.Context, *UpdateDetailsRequest) (*Peer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDetailsOfPeer not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetPeerNetworkingRule(context.Context, *PeerRequest) (*NetworkingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerNetworkingRule not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetPeerStatistics(context.Context, *PeerRequest) (*PeerStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerStatistics not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetCLADNetStatistics(context.Context, *CLADNetRequest) (*CLADNetStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCLADNetStatistics not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) CreateJoinToken(context.Context, *JoinTokenRequest) (*JoinToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJoinToken not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetJoinTokenList(context.Context, *CLADNetRequest) (*JoinTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinTokenList not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) RevokeJoinToken(context.Context, *JoinTokenRequest) (*JoinToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeJoinToken not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) RotateSecret(context.Context, *SecretRequest) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSecret not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) RevokeSecret(context.Context, *SecretRequest) (*SecretAuditRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSecret not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetSecretAuditList(context.Context, *CLADNetRequest) (*SecretAuditRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretAuditList not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) ExportCLADNet(context.Context, *CLADNetRequest) (*CLADNetArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) ImportCLADNet(context.Context, *ImportCLADNetRequest) (*CLADNetSpecification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) ReaddressCLADNet(context.Context, *ReaddressRequest) (*ReaddressingStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReaddressCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) GetReaddressingStatus(context.Context, *CLADNetRequest) (*ReaddressingStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReaddressingStatus not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) ApplyCLADNet(context.Context, *ApplyCLADNetRequest) (*ApplyCLADNetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCLADNet not implemented")
+}
+func (UnimplementedCloudAdaptiveNetworkServiceServer) mustEmbedUnimplementedCloudAdaptiveNetworkServiceServer() {
+}
+
+// UnsafeCloudAdaptiveNetworkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CloudAdaptiveNetworkServiceServer will
+// result in compilation errors.
+type UnsafeCloudAdaptiveNetworkServiceServer interface {
+	mustEmbedUnimplementedCloudAdaptiveNetworkServiceServer()
+}
+
+func RegisterCloudAdaptiveNetworkServiceServer(s grpc.ServiceRegistrar, srv CloudAdaptiveNetworkServiceServer) {
+	s.RegisterService(&CloudAdaptiveNetworkService_ServiceDesc, srv)
+}
+
+func _CloudAdaptiveNetworkService_GetCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNet(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetCLADNetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNetList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_CreateCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetSpecification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).CreateCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/createCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).CreateCLADNet(ctx, req.(*CLADNetSpecification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_DeleteCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).DeleteCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/deleteCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).DeleteCLADNet(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_UpdateCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetSpecification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).UpdateCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/updateCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).UpdateCLADNet(ctx, req.(*CLADNetSpecification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_RecommendAvailableIPv4PrivateAddressSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPv4CIDRs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).RecommendAvailableIPv4PrivateAddressSpaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/recommendAvailableIPv4PrivateAddressSpaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).RecommendAvailableIPv4PrivateAddressSpaces(ctx, req.(*IPv4CIDRs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeer(ctx, req.(*PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetPeerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getPeerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerList(ctx, req.(*PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_UpdateDetailsOfPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).UpdateDetailsOfPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/updateDetailsOfPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).UpdateDetailsOfPeer(ctx, req.(*UpdateDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetPeerNetworkingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerNetworkingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getPeerNetworkingRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerNetworkingRule(ctx, req.(*PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetPeerStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getPeerStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerStatistics(ctx, req.(*PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetCLADNetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNetStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNetStatistics(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_CreateJoinToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).CreateJoinToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/createJoinToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).CreateJoinToken(ctx, req.(*JoinTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetJoinTokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetJoinTokenList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getJoinTokenList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetJoinTokenList(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_RevokeJoinToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).RevokeJoinToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/revokeJoinToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).RevokeJoinToken(ctx, req.(*JoinTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_RotateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).RotateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/rotateSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).RotateSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_RevokeSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).RevokeSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/revokeSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).RevokeSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetSecretAuditList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetSecretAuditList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getSecretAuditList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetSecretAuditList(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_ExportCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).ExportCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/exportCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).ExportCLADNet(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_ImportCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).ImportCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/importCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).ImportCLADNet(ctx, req.(*ImportCLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_ReaddressCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReaddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).ReaddressCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/readdressCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).ReaddressCLADNet(ctx, req.(*ReaddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_GetReaddressingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetReaddressingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getReaddressingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).GetReaddressingStatus(ctx, req.(*CLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAdaptiveNetworkService_ApplyCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCLADNetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAdaptiveNetworkServiceServer).ApplyCLADNet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/applyCLADNet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAdaptiveNetworkServiceServer).ApplyCLADNet(ctx, req.(*ApplyCLADNetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CloudAdaptiveNetworkService_ServiceDesc is the grpc.ServiceDesc for CloudAdaptiveNetworkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CloudAdaptiveNetworkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbnet.v1.CloudAdaptiveNetworkService",
+	HandlerType: (*CloudAdaptiveNetworkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "getCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_GetCLADNet_Handler,
+		},
+		{
+			MethodName: "getCLADNetList",
+			Handler:    _CloudAdaptiveNetworkService_GetCLADNetList_Handler,
+		},
+		{
+			MethodName: "createCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_CreateCLADNet_Handler,
+		},
+		{
+			MethodName: "deleteCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_DeleteCLADNet_Handler,
+		},
+		{
+			MethodName: "updateCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_UpdateCLADNet_Handler,
+		},
+		{
+			MethodName: "recommendAvailableIPv4PrivateAddressSpaces",
+			Handler:    _CloudAdaptiveNetworkService_RecommendAvailableIPv4PrivateAddressSpaces_Handler,
+		},
+		{
+			MethodName: "getPeer",
+			Handler:    _CloudAdaptiveNetworkService_GetPeer_Handler,
+		},
+		{
+			MethodName: "getPeerList",
+			Handler:    _CloudAdaptiveNetworkService_GetPeerList_Handler,
+		},
+		{
+			MethodName: "updateDetailsOfPeer",
+			Handler:    _CloudAdaptiveNetworkService_UpdateDetailsOfPeer_Handler,
+		},
+		{
+			MethodName: "getPeerNetworkingRule",
+			Handler:    _CloudAdaptiveNetworkService_GetPeerNetworkingRule_Handler,
+		},
+		{
+			MethodName: "getPeerStatistics",
+			Handler:    _CloudAdaptiveNetworkService_GetPeerStatistics_Handler,
+		},
+		{
+			MethodName: "getCLADNetStatistics",
+			Handler:    _CloudAdaptiveNetworkService_GetCLADNetStatistics_Handler,
+		},
+		{
+			MethodName: "createJoinToken",
+			Handler:    _CloudAdaptiveNetworkService_CreateJoinToken_Handler,
+		},
+		{
+			MethodName: "getJoinTokenList",
+			Handler:    _CloudAdaptiveNetworkService_GetJoinTokenList_Handler,
+		},
+		{
+			MethodName: "revokeJoinToken",
+			Handler:    _CloudAdaptiveNetworkService_RevokeJoinToken_Handler,
+		},
+		{
+			MethodName: "rotateSecret",
+			Handler:    _CloudAdaptiveNetworkService_RotateSecret_Handler,
 		},
 		{
-			MethodName: "controlCloudAdaptiveNetwork",
-			Handler:    _SystemManagementService_ControlCloudAdaptiveNetwork_Handler,
+			MethodName: "revokeSecret",
+			Handler:    _CloudAdaptiveNetworkService_RevokeSecret_Handler,
 		},
 		{
-			MethodName: "testCloudAdaptiveNetwork",
-			Handler:    _SystemManagementService_TestCloudAdaptiveNetwork_Handler,
+			MethodName: "getSecretAuditList",
+			Handler:    _CloudAdaptiveNetworkService_GetSecretAuditList_Handler,
+		},
+		{
+			MethodName: "exportCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_ExportCLADNet_Handler,
+		},
+		{
+			MethodName: "importCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_ImportCLADNet_Handler,
+		},
+		{
+			MethodName: "readdressCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_ReaddressCLADNet_Handler,
+		},
+		{
+			MethodName: "getReaddressingStatus",
+			Handler:    _CloudAdaptiveNetworkService_GetReaddressingStatus_Handler,
+		},
+		{
+			MethodName: "applyCLADNet",
+			Handler:    _CloudAdaptiveNetworkService_ApplyCLADNet_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cloud_barista_network.proto",
 }
 
-// CloudAdaptiveNetworkServiceClient is the client API for CloudAdaptiveNetworkService service.
+// AgentServiceClient is the client API for AgentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type CloudAdaptiveNetworkServiceClient interface {
-	// Get a Cloud Adaptive Network specification
-	GetCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error)
-	// Get a list of Cloud Adaptive Network specifications
-	GetCLADNetList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CLADNetSpecifications, error)
-	// Create a new Cloud Adaptive Network
-	CreateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error)
-	// [To be provided] Delete a Cloud Adaptive Network
-	DeleteCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*DeletionResult, error)
-	// Update a Cloud Adaptive Network
-	UpdateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error)
-	// Recommend available IPv4 private address spaces for Cloud Adaptive Network
-	RecommendAvailableIPv4PrivateAddressSpaces(ctx context.Context, in *IPv4CIDRs, opts ...grpc.CallOption) (*AvailableIPv4PrivateAddressSpaces, error)
-	// Get a peer in a Cloud Adaptive Network
-	GetPeer(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peer, error)
-	// Get a list of peers in a Cloud Adaptive Network
-	GetPeerList(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peers, error)
-	// Update a peer's details
-	UpdateDetailsOfPeer(ctx context.Context, in *UpdateDetailsRequest, opts ...grpc.CallOption) (*Peer, error)
-	// Get a networking rule of a peer
-	GetPeerNetworkingRule(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*NetworkingRule, error)
+type AgentServiceClient interface {
+	// Register an agent (i.e., its host network information) to a Cloud Adaptive Network
+	RegisterAgent(ctx context.Context, in *AgentRegistration, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Send a heartbeat of an agent
+	Heartbeat(ctx context.Context, in *AgentHeartbeat, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Watch events (peers, secrets, control commands, or test requests) for an agent
+	WatchAgentEvents(ctx context.Context, in *AgentWatchRequest, opts ...grpc.CallOption) (AgentService_WatchAgentEventsClient, error)
+	// Update a state of an agent's peer
+	UpdatePeerState(ctx context.Context, in *AgentPeerState, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Report a re-addressing state of an agent's peer
+	UpdateReaddressingState(ctx context.Context, in *AgentReaddressingState, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Put a secret of an agent and get the secrets of the other agents
+	InitializeSecret(ctx context.Context, in *AgentSecret, opts ...grpc.CallOption) (*AgentSecrets, error)
+	// Put a networking rule of an agent
+	PutNetworkingRule(ctx context.Context, in *AgentNetworkingRule, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Post a test result of an agent
+	PostTestResult(ctx context.Context, in *AgentTestResult, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Publish the traffic statistics of an agent
+	ReportPeerStatistics(ctx context.Context, in *AgentPeerStatistics, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Upload a packet capture of an agent
+	UploadPacketCapture(ctx context.Context, in *AgentPacketCapture, opts ...grpc.CallOption) (*AgentResponse, error)
+	// Get the specification of an agent's Cloud Adaptive Network
+	GetCLADNet(ctx context.Context, in *AgentRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error)
+	// Get the peers in an agent's Cloud Adaptive Network
+	GetPeerList(ctx context.Context, in *AgentRequest, opts ...grpc.CallOption) (*Peers, error)
 }
 
-type cloudAdaptiveNetworkServiceClient struct {
+type agentServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewCloudAdaptiveNetworkServiceClient(cc grpc.ClientConnInterface) CloudAdaptiveNetworkServiceClient {
-	return &cloudAdaptiveNetworkServiceClient{cc}
+func NewAgentServiceClient(cc grpc.ClientConnInterface) AgentServiceClient {
+	return &agentServiceClient{cc}
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) GetCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
-	out := new(CLADNetSpecification)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNet", in, out, opts...)
+func (c *agentServiceClient) RegisterAgent(ctx context.Context, in *AgentRegistration, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/registerAgent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) GetCLADNetList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CLADNetSpecifications, error) {
-	out := new(CLADNetSpecifications)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNetList", in, out, opts...)
+func (c *agentServiceClient) Heartbeat(ctx context.Context, in *AgentHeartbeat, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/heartbeat", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) CreateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
-	out := new(CLADNetSpecification)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/createCLADNet", in, out, opts...)
+func (c *agentServiceClient) WatchAgentEvents(ctx context.Context, in *AgentWatchRequest, opts ...grpc.CallOption) (AgentService_WatchAgentEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentService_ServiceDesc.Streams[0], "/cbnet.v1.AgentService/watchAgentEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentServiceWatchAgentEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentService_WatchAgentEventsClient interface {
+	Recv() (*AgentEvent, error)
+	grpc.ClientStream
+}
+
+type agentServiceWatchAgentEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentServiceWatchAgentEventsClient) Recv() (*AgentEvent, error) {
+	m := new(AgentEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentServiceClient) UpdatePeerState(ctx context.Context, in *AgentPeerState, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/updatePeerState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) DeleteCLADNet(ctx context.Context, in *CLADNetRequest, opts ...grpc.CallOption) (*DeletionResult, error) {
-	out := new(DeletionResult)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/deleteCLADNet", in, out, opts...)
+func (c *agentServiceClient) UpdateReaddressingState(ctx context.Context, in *AgentReaddressingState, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/updateReaddressingState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) UpdateCLADNet(ctx context.Context, in *CLADNetSpecification, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
-	out := new(CLADNetSpecification)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/updateCLADNet", in, out, opts...)
+func (c *agentServiceClient) InitializeSecret(ctx context.Context, in *AgentSecret, opts ...grpc.CallOption) (*AgentSecrets, error) {
+	out := new(AgentSecrets)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/initializeSecret", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) RecommendAvailableIPv4PrivateAddressSpaces(ctx context.Context, in *IPv4CIDRs, opts ...grpc.CallOption) (*AvailableIPv4PrivateAddressSpaces, error) {
-	out := new(AvailableIPv4PrivateAddressSpaces)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/recommendAvailableIPv4PrivateAddressSpaces", in, out, opts...)
+func (c *agentServiceClient) PutNetworkingRule(ctx context.Context, in *AgentNetworkingRule, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/putNetworkingRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) GetPeer(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peer, error) {
-	out := new(Peer)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getPeer", in, out, opts...)
+func (c *agentServiceClient) PostTestResult(ctx context.Context, in *AgentTestResult, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/postTestResult", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) GetPeerList(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*Peers, error) {
-	out := new(Peers)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getPeerList", in, out, opts...)
+func (c *agentServiceClient) ReportPeerStatistics(ctx context.Context, in *AgentPeerStatistics, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/reportPeerStatistics", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) UpdateDetailsOfPeer(ctx context.Context, in *UpdateDetailsRequest, opts ...grpc.CallOption) (*Peer, error) {
-	out := new(Peer)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/updateDetailsOfPeer", in, out, opts...)
+func (c *agentServiceClient) UploadPacketCapture(ctx context.Context, in *AgentPacketCapture, opts ...grpc.CallOption) (*AgentResponse, error) {
+	out := new(AgentResponse)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/uploadPacketCapture", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cloudAdaptiveNetworkServiceClient) GetPeerNetworkingRule(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*NetworkingRule, error) {
-	out := new(NetworkingRule)
-	err := c.cc.Invoke(ctx, "/cbnet.v1.CloudAdaptiveNetworkService/getPeerNetworkingRule", in, out, opts...)
+func (c *agentServiceClient) GetCLADNet(ctx context.Context, in *AgentRequest, opts ...grpc.CallOption) (*CLADNetSpecification, error) {
+	out := new(CLADNetSpecification)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/getCLADNet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CloudAdaptiveNetworkServiceServer is the server API for CloudAdaptiveNetworkService service.
-// All implementations must embed UnimplementedCloudAdaptiveNetworkServiceServer
+func (c *agentServiceClient) GetPeerList(ctx context.Context, in *AgentRequest, opts ...grpc.CallOption) (*Peers, error) {
+	out := new(Peers)
+	err := c.cc.Invoke(ctx, "/cbnet.v1.AgentService/getPeerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentServiceServer is the server API for AgentService service.
+// All implementations must embed UnimplementedAgentServiceServer
 // for forward compatibility
-type CloudAdaptiveNetworkServiceServer interface {
-	// Get a Cloud Adaptive Network specification
-	GetCLADNet(context.Context, *CLADNetRequest) (*CLADNetSpecification, error)
-	// Get a list of Cloud Adaptive Network specifications
-	GetCLADNetList(context.Context, *emptypb.Empty) (*CLADNetSpecifications, error)
-	// Create a new Cloud Adaptive Network
-	CreateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error)
-	// [To be provided] Delete a Cloud Adaptive Network
-	DeleteCLADNet(context.Context, *CLADNetRequest) (*DeletionResult, error)
-	// Update a Cloud Adaptive Network
-	UpdateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error)
-	// Recommend available IPv4 private address spaces for Cloud Adaptive Network
-	RecommendAvailableIPv4PrivateAddressSpaces(context.Context, *IPv4CIDRs) (*AvailableIPv4PrivateAddressSpaces, error)
-	// Get a peer in a Cloud Adaptive Network
-	GetPeer(context.Context, *PeerRequest) (*Peer, error)
-	// Get a list of peers in a Cloud Adaptive Network
-	GetPeerList(context.Context, *PeerRequest) (*Peers, error)
-	// Update a peer's details
-	UpdateDetailsOfPeer(context.Context, *UpdateDetailsRequest) (*Peer, error)
-	// Get a networking rule of a peer
-	GetPeerNetworkingRule(context.Context, *PeerRequest) (*NetworkingRule, error)
-	mustEmbedUnimplementedCloudAdaptiveNetworkServiceServer()
+type AgentServiceServer interface {
+	// Register an agent (i.e., its host network information) to a Cloud Adaptive Network
+	RegisterAgent(context.Context, *AgentRegistration) (*AgentResponse, error)
+	// Send a heartbeat of an agent
+	Heartbeat(context.Context, *AgentHeartbeat) (*AgentResponse, error)
+	// Watch events (peers, secrets, control commands, or test requests) for an agent
+	WatchAgentEvents(*AgentWatchRequest, AgentService_WatchAgentEventsServer) error
+	// Update a state of an agent's peer
+	UpdatePeerState(context.Context, *AgentPeerState) (*AgentResponse, error)
+	// Report a re-addressing state of an agent's peer
+	UpdateReaddressingState(context.Context, *AgentReaddressingState) (*AgentResponse, error)
+	// Put a secret of an agent and get the secrets of the other agents
+	InitializeSecret(context.Context, *AgentSecret) (*AgentSecrets, error)
+	// Put a networking rule of an agent
+	PutNetworkingRule(context.Context, *AgentNetworkingRule) (*AgentResponse, error)
+	// Post a test result of an agent
+	PostTestResult(context.Context, *AgentTestResult) (*AgentResponse, error)
+	// Publish the traffic statistics of an agent
+	ReportPeerStatistics(context.Context, *AgentPeerStatistics) (*AgentResponse, error)
+	// Upload a packet capture of an agent
+	UploadPacketCapture(context.Context, *AgentPacketCapture) (*AgentResponse, error)
+	// Get the specification of an agent's Cloud Adaptive Network
+	GetCLADNet(context.Context, *AgentRequest) (*CLADNetSpecification, error)
+	// Get the peers in an agent's Cloud Adaptive Network
+	GetPeerList(context.Context, *AgentRequest) (*Peers, error)
+	mustEmbedUnimplementedAgentServiceServer()
 }
 
-// UnimplementedCloudAdaptiveNetworkServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedCloudAdaptiveNetworkServiceServer struct {
+// UnimplementedAgentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAgentServiceServer struct {
 }
 
-func (UnimplementedCloudAdaptiveNetworkServiceServer) GetCLADNet(context.Context, *CLADNetRequest) (*CLADNetSpecification, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCLADNet not implemented")
+func (UnimplementedAgentServiceServer) RegisterAgent(context.Context, *AgentRegistration) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgent not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) GetCLADNetList(context.Context, *emptypb.Empty) (*CLADNetSpecifications, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCLADNetList not implemented")
+func (UnimplementedAgentServiceServer) Heartbeat(context.Context, *AgentHeartbeat) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) CreateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCLADNet not implemented")
+func (UnimplementedAgentServiceServer) WatchAgentEvents(*AgentWatchRequest, AgentService_WatchAgentEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAgentEvents not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) DeleteCLADNet(context.Context, *CLADNetRequest) (*DeletionResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCLADNet not implemented")
+func (UnimplementedAgentServiceServer) UpdatePeerState(context.Context, *AgentPeerState) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePeerState not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) UpdateCLADNet(context.Context, *CLADNetSpecification) (*CLADNetSpecification, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCLADNet not implemented")
+func (UnimplementedAgentServiceServer) UpdateReaddressingState(context.Context, *AgentReaddressingState) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReaddressingState not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) RecommendAvailableIPv4PrivateAddressSpaces(context.Context, *IPv4CIDRs) (*AvailableIPv4PrivateAddressSpaces, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecommendAvailableIPv4PrivateAddressSpaces not implemented")
+func (UnimplementedAgentServiceServer) InitializeSecret(context.Context, *AgentSecret) (*AgentSecrets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitializeSecret not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) GetPeer(context.Context, *PeerRequest) (*Peer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+func (UnimplementedAgentServiceServer) PutNetworkingRule(context.Context, *AgentNetworkingRule) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutNetworkingRule not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) GetPeerList(context.Context, *PeerRequest) (*Peers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeerList not implemented")
+func (UnimplementedAgentServiceServer) PostTestResult(context.Context, *AgentTestResult) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTestResult not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) UpdateDetailsOfPeer(context.Context, *UpdateDetailsRequest) (*Peer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDetailsOfPeer not implemented")
+func (UnimplementedAgentServiceServer) ReportPeerStatistics(context.Context, *AgentPeerStatistics) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPeerStatistics not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) GetPeerNetworkingRule(context.Context, *PeerRequest) (*NetworkingRule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeerNetworkingRule not implemented")
+func (UnimplementedAgentServiceServer) UploadPacketCapture(context.Context, *AgentPacketCapture) (*AgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPacketCapture not implemented")
 }
-func (UnimplementedCloudAdaptiveNetworkServiceServer) mustEmbedUnimplementedCloudAdaptiveNetworkServiceServer() {
+func (UnimplementedAgentServiceServer) GetCLADNet(context.Context, *AgentRequest) (*CLADNetSpecification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCLADNet not implemented")
 }
+func (UnimplementedAgentServiceServer) GetPeerList(context.Context, *AgentRequest) (*Peers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerList not implemented")
+}
+func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
 
-// UnsafeCloudAdaptiveNetworkServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CloudAdaptiveNetworkServiceServer will
+// UnsafeAgentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentServiceServer will
 // result in compilation errors.
-type UnsafeCloudAdaptiveNetworkServiceServer interface {
-	mustEmbedUnimplementedCloudAdaptiveNetworkServiceServer()
+type UnsafeAgentServiceServer interface {
+	mustEmbedUnimplementedAgentServiceServer()
 }
 
-func RegisterCloudAdaptiveNetworkServiceServer(s grpc.ServiceRegistrar, srv CloudAdaptiveNetworkServiceServer) {
-	s.RegisterService(&CloudAdaptiveNetworkService_ServiceDesc, srv)
+func RegisterAgentServiceServer(s grpc.ServiceRegistrar, srv AgentServiceServer) {
+	s.RegisterService(&AgentService_ServiceDesc, srv)
 }
 
-func _CloudAdaptiveNetworkService_GetCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CLADNetRequest)
+func _AgentService_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentRegistration)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNet(ctx, in)
+		return srv.(AgentServiceServer).RegisterAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNet",
+		FullMethod: "/cbnet.v1.AgentService/registerAgent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNet(ctx, req.(*CLADNetRequest))
+		return srv.(AgentServiceServer).RegisterAgent(ctx, req.(*AgentRegistration))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_GetCLADNetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _AgentService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentHeartbeat)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNetList(ctx, in)
+		return srv.(AgentServiceServer).Heartbeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getCLADNetList",
+		FullMethod: "/cbnet.v1.AgentService/heartbeat",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetCLADNetList(ctx, req.(*emptypb.Empty))
+		return srv.(AgentServiceServer).Heartbeat(ctx, req.(*AgentHeartbeat))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_CreateCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CLADNetSpecification)
+func _AgentService_WatchAgentEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AgentWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServiceServer).WatchAgentEvents(m, &agentServiceWatchAgentEventsServer{stream})
+}
+
+type AgentService_WatchAgentEventsServer interface {
+	Send(*AgentEvent) error
+	grpc.ServerStream
+}
+
+type agentServiceWatchAgentEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentServiceWatchAgentEventsServer) Send(m *AgentEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AgentService_UpdatePeerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentPeerState)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).CreateCLADNet(ctx, in)
+		return srv.(AgentServiceServer).UpdatePeerState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/createCLADNet",
+		FullMethod: "/cbnet.v1.AgentService/updatePeerState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).CreateCLADNet(ctx, req.(*CLADNetSpecification))
+		return srv.(AgentServiceServer).UpdatePeerState(ctx, req.(*AgentPeerState))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_DeleteCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CLADNetRequest)
+func _AgentService_UpdateReaddressingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentReaddressingState)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).DeleteCLADNet(ctx, in)
+		return srv.(AgentServiceServer).UpdateReaddressingState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/deleteCLADNet",
+		FullMethod: "/cbnet.v1.AgentService/updateReaddressingState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).DeleteCLADNet(ctx, req.(*CLADNetRequest))
+		return srv.(AgentServiceServer).UpdateReaddressingState(ctx, req.(*AgentReaddressingState))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_UpdateCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CLADNetSpecification)
+func _AgentService_InitializeSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentSecret)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).UpdateCLADNet(ctx, in)
+		return srv.(AgentServiceServer).InitializeSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/updateCLADNet",
+		FullMethod: "/cbnet.v1.AgentService/initializeSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).UpdateCLADNet(ctx, req.(*CLADNetSpecification))
+		return srv.(AgentServiceServer).InitializeSecret(ctx, req.(*AgentSecret))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_RecommendAvailableIPv4PrivateAddressSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IPv4CIDRs)
+func _AgentService_PutNetworkingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentNetworkingRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).RecommendAvailableIPv4PrivateAddressSpaces(ctx, in)
+		return srv.(AgentServiceServer).PutNetworkingRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/recommendAvailableIPv4PrivateAddressSpaces",
+		FullMethod: "/cbnet.v1.AgentService/putNetworkingRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).RecommendAvailableIPv4PrivateAddressSpaces(ctx, req.(*IPv4CIDRs))
+		return srv.(AgentServiceServer).PutNetworkingRule(ctx, req.(*AgentNetworkingRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PeerRequest)
+func _AgentService_PostTestResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentTestResult)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetPeer(ctx, in)
+		return srv.(AgentServiceServer).PostTestResult(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getPeer",
+		FullMethod: "/cbnet.v1.AgentService/postTestResult",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetPeer(ctx, req.(*PeerRequest))
+		return srv.(AgentServiceServer).PostTestResult(ctx, req.(*AgentTestResult))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_GetPeerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PeerRequest)
+func _AgentService_ReportPeerStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentPeerStatistics)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerList(ctx, in)
+		return srv.(AgentServiceServer).ReportPeerStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getPeerList",
+		FullMethod: "/cbnet.v1.AgentService/reportPeerStatistics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerList(ctx, req.(*PeerRequest))
+		return srv.(AgentServiceServer).ReportPeerStatistics(ctx, req.(*AgentPeerStatistics))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_UpdateDetailsOfPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDetailsRequest)
+func _AgentService_UploadPacketCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentPacketCapture)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).UpdateDetailsOfPeer(ctx, in)
+		return srv.(AgentServiceServer).UploadPacketCapture(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/updateDetailsOfPeer",
+		FullMethod: "/cbnet.v1.AgentService/uploadPacketCapture",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).UpdateDetailsOfPeer(ctx, req.(*UpdateDetailsRequest))
+		return srv.(AgentServiceServer).UploadPacketCapture(ctx, req.(*AgentPacketCapture))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CloudAdaptiveNetworkService_GetPeerNetworkingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PeerRequest)
+func _AgentService_GetCLADNet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerNetworkingRule(ctx, in)
+		return srv.(AgentServiceServer).GetCLADNet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cbnet.v1.CloudAdaptiveNetworkService/getPeerNetworkingRule",
+		FullMethod: "/cbnet.v1.AgentService/getCLADNet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CloudAdaptiveNetworkServiceServer).GetPeerNetworkingRule(ctx, req.(*PeerRequest))
+		return srv.(AgentServiceServer).GetCLADNet(ctx, req.(*AgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CloudAdaptiveNetworkService_ServiceDesc is the grpc.ServiceDesc for CloudAdaptiveNetworkService service.
+func _AgentService_GetPeerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetPeerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbnet.v1.AgentService/getPeerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetPeerList(ctx, req.(*AgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var CloudAdaptiveNetworkService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "cbnet.v1.CloudAdaptiveNetworkService",
-	HandlerType: (*CloudAdaptiveNetworkServiceServer)(nil),
+var AgentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cbnet.v1.AgentService",
+	HandlerType: (*AgentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "getCLADNet",
-			Handler:    _CloudAdaptiveNetworkService_GetCLADNet_Handler,
+			MethodName: "registerAgent",
+			Handler:    _AgentService_RegisterAgent_Handler,
 		},
 		{
-			MethodName: "getCLADNetList",
-			Handler:    _CloudAdaptiveNetworkService_GetCLADNetList_Handler,
+			MethodName: "heartbeat",
+			Handler:    _AgentService_Heartbeat_Handler,
 		},
 		{
-			MethodName: "createCLADNet",
-			Handler:    _CloudAdaptiveNetworkService_CreateCLADNet_Handler,
+			MethodName: "updatePeerState",
+			Handler:    _AgentService_UpdatePeerState_Handler,
 		},
 		{
-			MethodName: "deleteCLADNet",
-			Handler:    _CloudAdaptiveNetworkService_DeleteCLADNet_Handler,
+			MethodName: "updateReaddressingState",
+			Handler:    _AgentService_UpdateReaddressingState_Handler,
 		},
 		{
-			MethodName: "updateCLADNet",
-			Handler:    _CloudAdaptiveNetworkService_UpdateCLADNet_Handler,
+			MethodName: "initializeSecret",
+			Handler:    _AgentService_InitializeSecret_Handler,
 		},
 		{
-			MethodName: "recommendAvailableIPv4PrivateAddressSpaces",
-			Handler:    _CloudAdaptiveNetworkService_RecommendAvailableIPv4PrivateAddressSpaces_Handler,
+			MethodName: "putNetworkingRule",
+			Handler:    _AgentService_PutNetworkingRule_Handler,
 		},
 		{
-			MethodName: "getPeer",
-			Handler:    _CloudAdaptiveNetworkService_GetPeer_Handler,
+			MethodName: "postTestResult",
+			Handler:    _AgentService_PostTestResult_Handler,
 		},
 		{
-			MethodName: "getPeerList",
-			Handler:    _CloudAdaptiveNetworkService_GetPeerList_Handler,
+			MethodName: "reportPeerStatistics",
+			Handler:    _AgentService_ReportPeerStatistics_Handler,
 		},
 		{
-			MethodName: "updateDetailsOfPeer",
-			Handler:    _CloudAdaptiveNetworkService_UpdateDetailsOfPeer_Handler,
+			MethodName: "uploadPacketCapture",
+			Handler:    _AgentService_UploadPacketCapture_Handler,
 		},
 		{
-			MethodName: "getPeerNetworkingRule",
-			Handler:    _CloudAdaptiveNetworkService_GetPeerNetworkingRule_Handler,
+			MethodName: "getCLADNet",
+			Handler:    _AgentService_GetCLADNet_Handler,
+		},
+		{
+			MethodName: "getPeerList",
+			Handler:    _AgentService_GetPeerList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "watchAgentEvents",
+			Handler:       _AgentService_WatchAgentEvents_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cloud_barista_network.proto",
 }
@@ -0,0 +1,245 @@
+package capturefilter
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFilter is returned if a filter expression cannot be parsed
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Protocol numbers of the inner packets
+const (
+	protocolICMP = 1
+	protocolTCP  = 6
+	protocolUDP  = 17
+)
+
+var protocolNumbers = map[string]int{
+	"icmp": protocolICMP,
+	"tcp":  protocolTCP,
+	"udp":  protocolUDP,
+}
+
+// Packet represents the fields of an inner (i.e., overlay) packet to be matched by a filter
+type Packet struct {
+	Peer     string // Host ID of the remote peer
+	Src      net.IP // Source address
+	Dst      net.IP // Destination address
+	Protocol int    // Protocol number (e.g., 6 for TCP)
+	SrcPort  int    // Source port of TCP or UDP (0 otherwise)
+	DstPort  int    // Destination port of TCP or UDP (0 otherwise)
+}
+
+// Decode represents a function to get the fields of an inner IPv4 packet to or from a peer.
+// The fields which cannot be decoded (e.g., the ports of the non-first fragments) are left empty.
+func Decode(peer string, packet []byte) Packet {
+	decoded := Packet{Peer: peer}
+	if len(packet) < 20 || packet[0]>>4 != 4 {
+		return decoded
+	}
+
+	headerLength := int(packet[0]&0x0f) * 4
+	decoded.Protocol = int(packet[9])
+	decoded.Src = net.IP(packet[12:16])
+	decoded.Dst = net.IP(packet[16:20])
+
+	// The ports are in the first fragment only
+	isFirstFragment := binary.BigEndian.Uint16(packet[6:8])&0x1fff == 0
+	if (decoded.Protocol == protocolTCP || decoded.Protocol == protocolUDP) && isFirstFragment && len(packet) >= headerLength+4 {
+		decoded.SrcPort = int(binary.BigEndian.Uint16(packet[headerLength : headerLength+2]))
+		decoded.DstPort = int(binary.BigEndian.Uint16(packet[headerLength+2 : headerLength+4]))
+	}
+	return decoded
+}
+
+// Filter represents a parsed filter expression of the packet capture
+type Filter struct {
+	expression string
+	match      func(packet Packet) bool
+}
+
+// Parse represents a function to parse a BPF-like filter expression, which matches all packets if it is empty.
+// The primitives are "peer <host-id>", "host <ip>", "port <number>", "proto <icmp|tcp|udp|number>",
+// and "icmp", "tcp" and "udp" for short. They are combined by "and" (&&), "or" (||), "not" (!) and parentheses,
+// e.g., "peer host-b and (tcp port 22 or icmp)". Adjacent primitives are combined by "and" like tcpdump.
+func Parse(expression string) (*Filter, error) {
+	filter := &Filter{expression: strings.TrimSpace(expression)}
+	if filter.expression == "" {
+		filter.match = func(Packet) bool { return true }
+		return filter, nil
+	}
+
+	parser := &parser{tokens: tokenize(filter.expression)}
+	match, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token != "" {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, token)
+	}
+	filter.match = match
+	return filter, nil
+}
+
+// Match represents a function to check if a packet matches the filter
+func (filter *Filter) Match(packet Packet) bool {
+	return filter.match(packet)
+}
+
+// String returns the filter expression
+func (filter *Filter) String() string {
+	return filter.expression
+}
+
+// tokenize splits an expression into the tokens, where the parentheses and "!" are separate tokens
+func tokenize(expression string) []string {
+	replacer := strings.NewReplacer("(", " ( ", ")", " ) ", "&&", " and ", "||", " or ", "!", " not ")
+	return strings.Fields(replacer.Replace(expression))
+}
+
+type matcher func(packet Packet) bool
+
+// parser is a recursive descent parser of a filter expression:
+//
+//	or      := and { "or" and }
+//	and     := unary { ["and"] unary }
+//	unary   := "not" unary | "(" or ")" | primitive
+type parser struct {
+	tokens   []string
+	position int
+}
+
+func (p *parser) peek() string {
+	if p.position >= len(p.tokens) {
+		return ""
+	}
+	return strings.ToLower(p.tokens[p.position])
+}
+
+func (p *parser) next() string {
+	token := p.peek()
+	if token != "" {
+		p.position++
+	}
+	return token
+}
+
+// nextValue gets the value of a primitive with its case
+func (p *parser) nextValue(primitive string) (string, error) {
+	if p.position >= len(p.tokens) {
+		return "", fmt.Errorf("%w: %q requires a value", ErrInvalidFilter, primitive)
+	}
+	value := p.tokens[p.position]
+	p.position++
+	return value, nil
+}
+
+func (p *parser) parseOr() (matcher, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		lhs := left
+		left = func(packet Packet) bool { return lhs(packet) || right(packet) }
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (matcher, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token := p.peek()
+		if token == "and" {
+			p.next()
+		} else if token == "" || token == "or" || token == ")" {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lhs := left
+		left = func(packet Packet) bool { return lhs(packet) && right(packet) }
+	}
+}
+
+func (p *parser) parseUnary() (matcher, error) {
+	switch token := p.next(); token {
+	case "":
+		return nil, fmt.Errorf("%w: unexpected end of the expression", ErrInvalidFilter)
+	case "not":
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(packet Packet) bool { return !operand(packet) }, nil
+	case "(":
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("%w: missing ')'", ErrInvalidFilter)
+		}
+		return inner, nil
+	default:
+		return p.parsePrimitive(token)
+	}
+}
+
+func (p *parser) parsePrimitive(primitive string) (matcher, error) {
+	if protocol, ok := protocolNumbers[primitive]; ok {
+		return func(packet Packet) bool { return packet.Protocol == protocol }, nil
+	}
+
+	value, err := p.nextValue(primitive)
+	if err != nil {
+		return nil, err
+	}
+
+	switch primitive {
+	case "peer":
+		return func(packet Packet) bool { return packet.Peer == value }, nil
+
+	case "host":
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("%w: invalid host (%v)", ErrInvalidFilter, value)
+		}
+		return func(packet Packet) bool { return ip.Equal(packet.Src) || ip.Equal(packet.Dst) }, nil
+
+	case "port":
+		port, err := strconv.Atoi(value)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, fmt.Errorf("%w: invalid port (%v)", ErrInvalidFilter, value)
+		}
+		return func(packet Packet) bool { return packet.SrcPort == port || packet.DstPort == port }, nil
+
+	case "proto":
+		protocol, ok := protocolNumbers[strings.ToLower(value)]
+		if !ok {
+			protocol, err = strconv.Atoi(value)
+			if err != nil || protocol < 0 || protocol > 255 {
+				return nil, fmt.Errorf("%w: invalid protocol (%v)", ErrInvalidFilter, value)
+			}
+		}
+		return func(packet Packet) bool { return packet.Protocol == protocol }, nil
+
+	default:
+		return nil, fmt.Errorf("%w: unknown primitive %q", ErrInvalidFilter, primitive)
+	}
+}
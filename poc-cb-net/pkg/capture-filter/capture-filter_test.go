@@ -0,0 +1,159 @@
+package capturefilter
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestParseErrors(t *testing.T) {
+	for _, expression := range []string{
+		"peer",             // No value
+		"host 10.0.0.256",  // Invalid IP address
+		"host xxxx",        // Not an IP address
+		"port 0",           // Out of the range
+		"port 65536",       // Out of the range
+		"port ssh",         // Not a number
+		"proto sctpx",      // Unknown protocol
+		"proto 256",        // Out of the range
+		"src 10.0.0.2",     // Unknown primitive
+		"tcp and",          // Unexpected end
+		"tcp or",           // Unexpected end
+		"not",              // Unexpected end
+		"(tcp or udp",      // Missing ')'
+		"tcp)",             // Unexpected ')'
+		"()",               // Empty parentheses
+		"tcp and or udp",   // Missing an operand
+		"tcp port 22 port", // No value
+	} {
+		t.Run(expression, func(t *testing.T) {
+			if filter, err := Parse(expression); !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("Parse() = %v, %v, want ErrInvalidFilter", filter, err)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	ssh := Packet{Peer: "host-b", Src: net.ParseIP("10.0.0.2"), Dst: net.ParseIP("10.0.0.3"), Protocol: protocolTCP, SrcPort: 50000, DstPort: 22}
+	dns := Packet{Peer: "host-c", Src: net.ParseIP("10.0.0.4"), Dst: net.ParseIP("10.0.0.2"), Protocol: protocolUDP, SrcPort: 53, DstPort: 40000}
+	ping := Packet{Peer: "host-b", Src: net.ParseIP("10.0.0.3"), Dst: net.ParseIP("10.0.0.2"), Protocol: protocolICMP}
+	gre := Packet{Peer: "host-c", Src: net.ParseIP("10.0.0.4"), Dst: net.ParseIP("10.0.0.3"), Protocol: 47}
+
+	tests := []struct {
+		expression string
+		want       [4]bool // ssh, dns, ping, gre
+	}{
+		{"", [4]bool{true, true, true, true}},
+		{"   ", [4]bool{true, true, true, true}},
+		// Protocols
+		{"tcp", [4]bool{true, false, false, false}},
+		{"UDP", [4]bool{false, true, false, false}},
+		{"icmp", [4]bool{false, false, true, false}},
+		{"proto tcp", [4]bool{true, false, false, false}},
+		{"proto 47", [4]bool{false, false, false, true}},
+		// Ports of the source or the destination
+		{"port 22", [4]bool{true, false, false, false}},
+		{"port 53", [4]bool{false, true, false, false}},
+		{"port 40000", [4]bool{false, true, false, false}},
+		{"tcp port 53", [4]bool{false, false, false, false}},
+		// Hosts of the source or the destination
+		{"host 10.0.0.2", [4]bool{true, true, true, false}},
+		{"host 10.0.0.4", [4]bool{false, true, false, true}},
+		{"host 10.0.0.9", [4]bool{false, false, false, false}},
+		// Peers
+		{"peer host-b", [4]bool{true, false, true, false}},
+		{"peer HOST-B", [4]bool{false, false, false, false}}, // The host ID is case-sensitive
+		// Combinations
+		{"peer host-b and (tcp port 22 or icmp)", [4]bool{true, false, true, false}},
+		{"peer host-b && !icmp", [4]bool{true, false, false, false}},
+		{"tcp or udp", [4]bool{true, true, false, false}},
+		{"tcp || udp and port 53", [4]bool{true, true, false, false}}, // "and" binds tighter
+		{"(tcp || udp) and port 53", [4]bool{false, true, false, false}},
+		{"not not tcp", [4]bool{true, false, false, false}},
+		{"not (tcp or udp or icmp)", [4]bool{false, false, false, true}},
+		{"host 10.0.0.3 and not peer host-b", [4]bool{false, false, false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			filter, err := Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			for i, packet := range []Packet{ssh, dns, ping, gre} {
+				if got := filter.Match(packet); got != tt.want[i] {
+					t.Errorf("Match(%+v) = %v, want %v", packet, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// ipv4Packet returns an IPv4 packet having the ports after the header (of 20 bytes)
+func ipv4Packet(protocol byte, src string, dst string, fragmentOffset uint16, srcPort uint16, dstPort uint16) []byte {
+	packet := make([]byte, 28)
+	packet[0] = 0x45
+	packet[6], packet[7] = byte(fragmentOffset>>8), byte(fragmentOffset)
+	packet[9] = protocol
+	copy(packet[12:16], net.ParseIP(src).To4())
+	copy(packet[16:20], net.ParseIP(dst).To4())
+	packet[20], packet[21] = byte(srcPort>>8), byte(srcPort)
+	packet[22], packet[23] = byte(dstPort>>8), byte(dstPort)
+	return packet
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name   string
+		packet []byte
+		want   Packet
+	}{
+		{
+			name:   "TCP",
+			packet: ipv4Packet(protocolTCP, "10.0.0.2", "10.0.0.3", 0, 50000, 22),
+			want:   Packet{Peer: "host-b", Src: net.ParseIP("10.0.0.2"), Dst: net.ParseIP("10.0.0.3"), Protocol: protocolTCP, SrcPort: 50000, DstPort: 22},
+		},
+		{
+			name:   "UDP with the more fragments flag",
+			packet: ipv4Packet(protocolUDP, "10.0.0.2", "10.0.0.3", 0x2000, 53, 40000),
+			want:   Packet{Peer: "host-b", Src: net.ParseIP("10.0.0.2"), Dst: net.ParseIP("10.0.0.3"), Protocol: protocolUDP, SrcPort: 53, DstPort: 40000},
+		},
+		{
+			name:   "non-first fragment",
+			packet: ipv4Packet(protocolUDP, "10.0.0.2", "10.0.0.3", 185, 53, 40000),
+			want:   Packet{Peer: "host-b", Src: net.ParseIP("10.0.0.2"), Dst: net.ParseIP("10.0.0.3"), Protocol: protocolUDP},
+		},
+		{
+			name:   "ICMP",
+			packet: ipv4Packet(protocolICMP, "10.0.0.2", "10.0.0.3", 0, 0x0800, 0),
+			want:   Packet{Peer: "host-b", Src: net.ParseIP("10.0.0.2"), Dst: net.ParseIP("10.0.0.3"), Protocol: protocolICMP},
+		},
+		{
+			name:   "truncated ports",
+			packet: ipv4Packet(protocolTCP, "10.0.0.2", "10.0.0.3", 0, 50000, 22)[:22],
+			want:   Packet{Peer: "host-b", Src: net.ParseIP("10.0.0.2"), Dst: net.ParseIP("10.0.0.3"), Protocol: protocolTCP},
+		},
+		{name: "too short", packet: make([]byte, 19), want: Packet{Peer: "host-b"}},
+		{name: "IPv6", packet: append([]byte{0x60}, make([]byte, 39)...), want: Packet{Peer: "host-b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Decode("host-b", tt.packet)
+			if got.Peer != tt.want.Peer || !got.Src.Equal(tt.want.Src) || !got.Dst.Equal(tt.want.Dst) ||
+				got.Protocol != tt.want.Protocol || got.SrcPort != tt.want.SrcPort || got.DstPort != tt.want.DstPort {
+				t.Errorf("Decode() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// A decoded packet is matched by a filter
+	filter, err := Parse("peer host-b and tcp port 22 and host 10.0.0.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Match(Decode("host-b", tests[0].packet)) {
+		t.Errorf("Match() of the decoded TCP packet = false, want true")
+	}
+}
@@ -1,31 +1,33 @@
 package cbnet
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 	"unsafe"
 
 	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
 	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/logger"
+	nethelper "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/network-helper"
+	publicip "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/public-ip"
 	ruletype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/rule-type"
 	secutil "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/secret-util"
-	cblog "github.com/cloud-barista/cb-log"
 	"github.com/rs/xid"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/net/ipv4"
 )
 
@@ -39,51 +41,12 @@ const (
 	IPv4 = "IPv4"
 	// IPv6 represents a version of IP address
 	IPv6 = "IPv6"
+	// DefaultKeyRotationGracePeriod represents a period to accept both the previous and new keys after rotation.
+	DefaultKeyRotationGracePeriod = 5 * time.Minute
 )
 
-// CBLogger represents a logger to show execution processes according to the logging level.
-var CBLogger *logrus.Logger
-
-func init() {
-	fmt.Println("\nStart......... init() of cb-network.go")
-	// Set cb-log
-	env := os.Getenv("CBLOG_ROOT")
-	if env != "" {
-		// Load cb-log config from the environment variable path (default)
-		fmt.Printf("CBLOG_ROOT: %v\n", env)
-		CBLogger = cblog.GetLogger("cb-network")
-	} else {
-
-		// Load cb-log config from the current directory (usually for the production)
-		ex, err := os.Executable()
-		if err != nil {
-			panic(err)
-		}
-		exePath := filepath.Dir(ex)
-		// fmt.Printf("exe path: %v\n", exePath)
-
-		logConfPath := filepath.Join(exePath, "config", "log_conf.yaml")
-		if file.Exists(logConfPath) {
-			fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
-			CBLogger = cblog.GetLoggerWithConfigPath("cb-network", logConfPath)
-
-		} else {
-			// Load cb-log config from the project directory (usually for development)
-			logConfPath = filepath.Join(exePath, "..", "..", "config", "log_conf.yaml")
-			if file.Exists(logConfPath) {
-				fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
-				CBLogger = cblog.GetLoggerWithConfigPath("cb-network", logConfPath)
-			} else {
-				err := errors.New("fail to load log_conf.yaml")
-				panic(err)
-			}
-		}
-
-		CBLogger.Debugf("Load %v", logConfPath)
-	}
-	fmt.Println("End......... init() of cb-network.go")
-	fmt.Println("")
-}
+// publicIPResolutionTimeout is the time to resolve the public IP address by all sources
+const publicIPResolutionTimeout = 10 * time.Second
 
 type ifReq struct {
 	Name  [0x10]byte
@@ -103,29 +66,56 @@ type CBNetwork struct {
 	// TBD
 
 	// Variables for the cb-network agents
-	HostID                string                    // HostID in a cloud adaptive network
-	HostName              string                    // HostName in a cloud adaptive network
-	HostPublicIP          string                    // Inquired public IP of VM/Host
-	ThisPeer              model.Peer                // Peer object for this host
-	OtherPeers            map[string]model.Peer     // Peers map for the other hosts
-	Interface             *os.File                  // Assigned cbnet0 IP from the controller
-	name                  string                    // Name of a network interface, e.g., cbnet0
-	port                  int                       // Port used for tunneling
-	isInterfaceConfigured bool                      // Status if a network interface is configured or not
-	notificationChannel   chan bool                 // Channel to notify the status of a network interface
-	privateKey            *rsa.PrivateKey           // Private key
-	keyring               map[string]*rsa.PublicKey // Keyring for secrets
-	keyringMutex          *sync.Mutex               // Mutex for keyring
-	peersMutex            *sync.Mutex               // Mutex for peers
-	listenConnection      *net.UDPConn              // Listen connection for encapsulation and decapsulation
+	HostID                string                         // HostID in a cloud adaptive network
+	HostName              string                         // HostName in a cloud adaptive network
+	HostPublicIP          string                         // Inquired public IP of VM/Host
+	PublicIPResolver      publicip.PublicIPResolver      // Resolver of the public IP of VM/Host
+	CloudInformation      model.CloudInformation         // Detected (or configured) cloud information of VM/Host
+	JoinToken             string                         // Join token to be admitted to a cloud adaptive network
+	ThisPeer              model.Peer                     // Peer object for this host
+	OtherPeers            map[string]model.Peer          // Peers map for the other hosts
+	Interface             *os.File                       // Assigned cbnet0 IP from the controller
+	name                  string                         // Name of a network interface, e.g., cbnet0
+	port                  int                            // Port used for tunneling
+	isInterfaceConfigured bool                           // Status if a network interface is configured or not
+	configuredIPv4CIDR    string                         // IPv4 CIDR configured on the network interface
+	addressMutex          *sync.Mutex                    // Mutex for the configured IPv4 CIDR
+	notificationChannel   chan bool                      // Channel to notify the status of a network interface
+	privateKey            *rsa.PrivateKey                // Private key
+	previousPrivateKey    *rsa.PrivateKey                // Previous private key accepted during the grace period of key rotation
+	previousKeyExpiresAt  time.Time                      // Expiration time of the previous private key
+	privateKeyMutex       *sync.RWMutex                  // Mutex for private keys
+	keyring               map[string]*rsa.PublicKey      // Keyring for secrets
+	keyringMutex          *sync.Mutex                    // Mutex for keyring
+	peersMutex            *sync.Mutex                    // Mutex for peers
+	listenConnection      *net.UDPConn                   // Listen connection for encapsulation and decapsulation
+	statistics            *trafficStatistics             // Traffic counters by peer and drops by reason
+	packetTrace           *packetTrace                   // Temporary tracing of the packets (see TracePackets)
+	packetCapture         *atomic.Pointer[packetCapture] // Packet capture in progress, if any (see CapturePackets)
+	logger                logger.Logger                  // Logger injected by WithLogger
+
+	// Underlay network interfaces pinned by configuration (the interface of the default route if empty)
+	UnderlayIPv4InterfaceName string // Network interface for IPv4 tunneling
+	UnderlayIPv6InterfaceName string // Network interface for IPv6 tunneling
 
 	// Models
 	hostNetworkInterfaces []model.NetworkInterface // Inquired network interfaces of VM/Host
+	underlayIPv4          model.UnderlayAddress    // Detected underlay IPv4 address
+	underlayIPv6          model.UnderlayAddress    // Detected underlay IPv6 address
+}
+
+// Option represents an option of the constructor of CBNetwork
+type Option func(cbnetwork *CBNetwork)
+
+// WithLogger represents an option to set the logger of CBNetwork (all log entries are discarded by default)
+func WithLogger(logger logger.Logger) Option {
+	return func(cbnetwork *CBNetwork) {
+		cbnetwork.logger = logger
+	}
 }
 
 // New represents a constructor of CBNetwork
-func New(name string, port string) *CBNetwork {
-	CBLogger.Debug("Start.........")
+func New(name string, port string, options ...Option) *CBNetwork {
 
 	// Default
 	tunDeviceName := "cbnet0"
@@ -137,12 +127,9 @@ func New(name string, port string) *CBNetwork {
 	}
 
 	// Set tunneling port
-	var err error
+	var errPort error
 	if port != "" {
-		tunnelingPort, err = strconv.Atoi(port)
-		if err != nil {
-			CBLogger.Error(err)
-		}
+		tunnelingPort, errPort = strconv.Atoi(port)
 	}
 
 	temp := &CBNetwork{
@@ -155,80 +142,64 @@ func New(name string, port string) *CBNetwork {
 		notificationChannel:   make(chan bool),
 		keyring:               make(map[string]*rsa.PublicKey),
 		keyringMutex:          new(sync.Mutex),
+		privateKeyMutex:       new(sync.RWMutex),
 		peersMutex:            new(sync.Mutex),
+		addressMutex:          new(sync.Mutex),
+		PublicIPResolver:      publicip.NewDefaultResolver(""),
+		statistics:            newTrafficStatistics(),
+		packetTrace:           new(packetTrace),
+		packetCapture:         new(atomic.Pointer[packetCapture]),
+		logger:                logger.Discard(),
+	}
+	for _, option := range options {
+		option(temp)
+	}
+
+	temp.logger.Debug("Start.........")
+	if errPort != nil {
+		temp.logger.Error(errPort)
+	}
+	if err := temp.UpdateHostNetworkInformation(); err != nil {
+		temp.logger.Error(err)
 	}
-	temp.UpdateHostNetworkInformation()
 
-	CBLogger.Debug("End.........")
+	temp.logger.Debug("End.........")
 	return temp
 }
 
 // UpdateHostNetworkInformation represents a function to update the host network information, such as
 // public IP address of VM and private IPv4 networks.
-func (cbnetwork *CBNetwork) UpdateHostNetworkInformation() {
-	CBLogger.Debug("Start.........")
-	cbnetwork.inquireVMPublicIP()
+// It returns an error if the public IP address could not be resolved (the previous one is kept).
+func (cbnetwork *CBNetwork) UpdateHostNetworkInformation() error {
+	cbnetwork.logger.Debug("Start.........")
+	errPublicIP := cbnetwork.inquireVMPublicIP()
 	cbnetwork.getHostNetworkInterfaces()
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
+	return errPublicIP
 }
 
-func (cbnetwork *CBNetwork) inquireVMPublicIP() {
-	CBLogger.Debug("Start.........")
-
-	urls := []string{"https://ifconfig.co/",
-		"https://api.ipify.org?format=text",
-		"https://www.ipify.org",
-		"http://myexternalip.com",
-		"http://api.ident.me",
-		"http://whatismyipaddress.com/api",
-	}
-
-	for _, url := range urls {
-
-		// Try to inquire public IP address
-		CBLogger.Debug("Try to inquire public IP address")
-		CBLogger.Tracef("by %s", url)
+func (cbnetwork *CBNetwork) inquireVMPublicIP() error {
+	cbnetwork.logger.Debug("Start.........")
 
-		resp, err := http.Get(url)
-		if err != nil {
-			CBLogger.Error(err)
-		}
+	ctx, cancel := context.WithTimeout(context.Background(), publicIPResolutionTimeout)
+	defer cancel()
 
-		// Perform error handling
-		defer func() {
-			errClose := resp.Body.Close()
-			if errClose != nil {
-				CBLogger.Fatal("can't close the response", errClose)
-			}
-		}()
-
-		data, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			CBLogger.Error(err)
-		}
-
-		trimmed := strings.TrimSuffix(string(data), "\n") // Remove '\n' if exist
-		CBLogger.Tracef("Returned: %s", trimmed)
-
-		// Check if it's IP address or not
-		if net.ParseIP(trimmed) != nil {
-			CBLogger.Info("Public IP address is acquired.")
-			CBLogger.Tracef("Public IP address: %s", string(trimmed))
-			cbnetwork.HostPublicIP = trimmed
-			break
-		}
+	publicIP, err := cbnetwork.PublicIPResolver.ResolvePublicIP(ctx)
+	if err != nil {
+		// Keep the previous one if any
+		return err
 	}
 
-	// If "", fail to acquire public IP address
-	if cbnetwork.HostPublicIP == "" {
-		CBLogger.Fatal("Fail to acquire public IP address")
-	}
+	cbnetwork.logger.Info("Public IP address is acquired.")
+	cbnetwork.logger.Tracef("Public IP address: %s", publicIP)
+	cbnetwork.HostPublicIP = publicIP
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
+	return nil
 }
 
 func (cbnetwork *CBNetwork) getHostNetworkInterfaces() {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
 	var networkInterfaces []model.NetworkInterface
 
@@ -238,7 +209,7 @@ func (cbnetwork *CBNetwork) getHostNetworkInterfaces() {
 	// Recursively get network interface information
 	for _, iface := range ifaces {
 		// Print a network interface name
-		CBLogger.Trace("Interface name: ", iface.Name)
+		cbnetwork.logger.Trace("Interface name: ", iface.Name)
 
 		// Declare a NetworkInterface variable
 		var networkInterface model.NetworkInterface
@@ -256,7 +227,7 @@ func (cbnetwork *CBNetwork) getHostNetworkInterfaces() {
 			// Get IP Address and IP Network
 			ipAddr, _, err := net.ParseCIDR(ipCIDR)
 			if err != nil {
-				CBLogger.Error(err)
+				cbnetwork.logger.Error(err)
 			}
 
 			// To string
@@ -275,100 +246,171 @@ func (cbnetwork *CBNetwork) getHostNetworkInterfaces() {
 					version = IPv6
 				} else {
 					version = "Unknown"
-					CBLogger.Tracef("Unknown version (IPAddr: %s)", ipAddr.String())
+					cbnetwork.logger.Tracef("Unknown version (IPAddr: %s)", ipAddr.String())
 				}
 
-				// Append the IP network to a list for local IP network
+				// Append the IP network to a list for local IP network (the first one is kept as the primary)
 				if version == IPv4 { // Is IPv4 ?
-					CBLogger.Tracef("IPv4: %s, IPv4CIDR: %s", ipAddrStr, ipCIDR)
-					networkInterface.IPv4 = ipAddrStr
-					networkInterface.IPv4CIDR = ipCIDR
+					cbnetwork.logger.Tracef("IPv4: %s, IPv4CIDR: %s", ipAddrStr, ipCIDR)
+					if networkInterface.IPv4 == "" {
+						networkInterface.IPv4 = ipAddrStr
+						networkInterface.IPv4CIDR = ipCIDR
+					}
+					networkInterface.IPv4CIDRs = append(networkInterface.IPv4CIDRs, ipCIDR)
 				} else if version == IPv6 { // Is IPv6 ?
-					CBLogger.Tracef("IPv6: %s, IPv6CIDR: %s", ipAddrStr, ipCIDR)
-					networkInterface.IPv6 = ipAddrStr
-					networkInterface.IPv6CIDR = ipCIDR
+					cbnetwork.logger.Tracef("IPv6: %s, IPv6CIDR: %s", ipAddrStr, ipCIDR)
+					if networkInterface.IPv6 == "" {
+						networkInterface.IPv6 = ipAddrStr
+						networkInterface.IPv6CIDR = ipCIDR
+					}
+					networkInterface.IPv6CIDRs = append(networkInterface.IPv6CIDRs, ipCIDR)
 				} else { // Unknown version
-					CBLogger.Trace("!!! Unknown version !!!")
+					cbnetwork.logger.Trace("!!! Unknown version !!!")
 				}
 			} else {
-				CBLogger.Tracef("PublicIPAddress %s, %s", ipAddrStr, ipCIDR)
+				cbnetwork.logger.Tracef("PublicIPAddress %s, %s", ipAddrStr, ipCIDR)
 			}
 		}
 		networkInterfaces = append(networkInterfaces, networkInterface)
 	}
 	cbnetwork.hostNetworkInterfaces = networkInterfaces
+
+	// Detect the underlay addresses of IPv4 and IPv6 separately
+	underlayIPv4, err := cbnetwork.detectUnderlayAddress(IPv4, cbnetwork.UnderlayIPv4InterfaceName)
+	if err != nil {
+		cbnetwork.logger.Error(err)
+	}
+	cbnetwork.underlayIPv4 = underlayIPv4
+	cbnetwork.logger.Tracef("Underlay IPv4: %+v", underlayIPv4)
+
+	underlayIPv6, err := cbnetwork.detectUnderlayAddress(IPv6, cbnetwork.UnderlayIPv6InterfaceName)
+	if err != nil {
+		// IPv6 is optional
+		cbnetwork.logger.Debug(err)
+	}
+	cbnetwork.underlayIPv6 = underlayIPv6
+	cbnetwork.logger.Tracef("Underlay IPv6: %+v", underlayIPv6)
+
+	cbnetwork.logger.Debug("End.........")
+}
+
+// detectUnderlayAddress detects the underlay address of an IP version, which is used for tunneling.
+// It is the source IP address of the default route if no network interface is pinned,
+// or the first address of the pinned network interface.
+func (cbnetwork *CBNetwork) detectUnderlayAddress(version string, pinnedInterfaceName string) (model.UnderlayAddress, error) {
+
+	if pinnedInterfaceName != "" {
+		for _, networkInterface := range cbnetwork.hostNetworkInterfaces {
+			if networkInterface.Name != pinnedInterfaceName {
+				continue
+			}
+			ip, cidr := networkInterface.IPv4, networkInterface.IPv4CIDR
+			if version == IPv6 {
+				ip, cidr = networkInterface.IPv6, networkInterface.IPv6CIDR
+			}
+			if ip == "" {
+				return model.UnderlayAddress{}, fmt.Errorf("no %v address on the pinned network interface (%v)", version, pinnedInterfaceName)
+			}
+			return model.UnderlayAddress{InterfaceName: pinnedInterfaceName, IP: ip, CIDR: cidr, IsPinned: true}, nil
+		}
+		return model.UnderlayAddress{}, fmt.Errorf("could not find the pinned network interface (%v)", pinnedInterfaceName)
+	}
+
+	sourceIP, err := nethelper.DefaultRouteSourceIP(version)
+	if err != nil {
+		return model.UnderlayAddress{}, err
+	}
+
+	// Find the network interface having the source IP address
+	for _, networkInterface := range cbnetwork.hostNetworkInterfaces {
+		cidrs := networkInterface.IPv4CIDRs
+		if version == IPv6 {
+			cidrs = networkInterface.IPv6CIDRs
+		}
+		for _, cidr := range cidrs {
+			ip, _, err := net.ParseCIDR(cidr)
+			if err == nil && ip.Equal(sourceIP) {
+				return model.UnderlayAddress{InterfaceName: networkInterface.Name, IP: ip.String(), CIDR: cidr}, nil
+			}
+		}
+	}
+	return model.UnderlayAddress{}, fmt.Errorf("could not find the network interface of the default route (source: %v)", sourceIP)
 }
 
 // GetHostNetworkInformation represents a function to get the network information of a VM.
 func (cbnetwork CBNetwork) GetHostNetworkInformation() model.HostNetworkInformation {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
 	temp := model.HostNetworkInformation{
 		HostName:          cbnetwork.HostName,
+		JoinToken:         cbnetwork.JoinToken,
 		IsEncrypted:       cbnetwork.isEncryptionEnabled,
 		PublicIP:          cbnetwork.HostPublicIP,
 		NetworkInterfaces: cbnetwork.hostNetworkInterfaces,
+		UnderlayIPv4:      cbnetwork.underlayIPv4,
+		UnderlayIPv6:      cbnetwork.underlayIPv6,
+		CloudInformation:  cbnetwork.CloudInformation,
 	}
-	CBLogger.Trace(temp)
+	cbnetwork.logger.Trace(temp)
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 	return temp
 }
 
 // UpdateNetworkingRule represents a function to update networking rule.
 func (cbnetwork *CBNetwork) UpdateNetworkingRule(networkingRule model.NetworkingRule) {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
-	CBLogger.Debug("Lock to update the networking rule")
+	cbnetwork.logger.Debug("Lock to update the networking rule")
 	cbnetwork.networkingRuleMutex.Lock()
 	cbnetwork.NetworkingRule = networkingRule
-	CBLogger.Debug("Unlock to update the networking rule")
+	cbnetwork.logger.Debug("Unlock to update the networking rule")
 	cbnetwork.networkingRuleMutex.Unlock()
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 }
 
 // func (cbnetwork *CBNetwork) updateNetworkingRule(peer model.Peer) {
-// 	CBLogger.Debug("Start.........")
+// 	cbnetwork.logger.Debug("Start.........")
 
-// 	CBLogger.Debug("Lock to update the networking rule")
+// 	cbnetwork.logger.Debug("Lock to update the networking rule")
 // 	mutex.Lock()
 // 	cbnetwork.NetworkingRule.CLADNetID = peer.CLADNetID
 // 	cbnetwork.NetworkingRule.UpdateRule(peer.HostID, peer.HostName, peer.IP, peer.HostPublicIP, peer.State)
-// 	CBLogger.Debug("Unlock to update the networking rule")
+// 	cbnetwork.logger.Debug("Unlock to update the networking rule")
 // 	mutex.Unlock()
 
-// 	CBLogger.Debug("End.........")
+// 	cbnetwork.logger.Debug("End.........")
 // }
 
 // // UpdateNetworkingRule represents a function to decode binary of networking rule and set it.
 // func (cbnetwork *CBNetwork) UpdateNetworkingRule(peer model.Peer) {
-// 	CBLogger.Debug("Start.........")
+// 	cbnetwork.logger.Debug("Start.........")
 
 // 	prettyJSON, _ := json.MarshalIndent(peer, "", "\t")
-// 	CBLogger.Trace("Pretty JSON")
-// 	CBLogger.Trace(string(prettyJSON))
+// 	cbnetwork.logger.Trace("Pretty JSON")
+// 	cbnetwork.logger.Trace(string(prettyJSON))
 
 // 	cbnetwork.updateNetworkingRule(peer)
 
-// 	CBLogger.Debug("End.........")
+// 	cbnetwork.logger.Debug("End.........")
 // }
 
 // ThisPeerState represents the state of this host (peer)
 func (cbnetwork CBNetwork) ThisPeerState() string {
-	CBLogger.Debugf("Current peer state: %s", cbnetwork.ThisPeer.State)
+	cbnetwork.logger.Debugf("Current peer state: %s", cbnetwork.ThisPeer.State)
 	return cbnetwork.ThisPeer.State
 }
 
 // ConfigureCBNetworkInterface represents a function to configure a network interface (default: cbnet0)
 // for Cloud Adaptive Network
 func (cbnetwork *CBNetwork) ConfigureCBNetworkInterface() error {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
 	// Open TUN device
 	fd, err := syscall.Open("/dev/net/tun", os.O_RDWR|syscall.O_NONBLOCK, 0)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 	fdInt := uintptr(fd)
 
@@ -384,12 +426,12 @@ func (cbnetwork *CBNetwork) ConfigureCBNetworkInterface() error {
 
 	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fdInt, uintptr(syscall.TUNSETIFF), uintptr(unsafe.Pointer(&req)))
 	if errno != 0 {
-		return err
+		return errno
 	}
 
 	createdIFName := strings.Trim(string(req.Name[:]), "\x00")
-	CBLogger.Tracef("Created interface name: %s\n", createdIFName)
-	CBLogger.Info("Interface allocated: ", cbnetwork.name)
+	cbnetwork.logger.Tracef("Created interface name: %s\n", createdIFName)
+	cbnetwork.logger.Info("Interface allocated: ", cbnetwork.name)
 
 	// Open TUN Interface
 	tunFd := os.NewFile(fdInt, "tun")
@@ -397,12 +439,21 @@ func (cbnetwork *CBNetwork) ConfigureCBNetworkInterface() error {
 
 	// Get HostIPv4CIDR
 	thisPeerIPv4CIDR := cbnetwork.ThisPeer.IPv4CIDR
-	CBLogger.Trace("=== cb-network.HostIPv4CIDR: ", thisPeerIPv4CIDR)
+	cbnetwork.logger.Trace("=== cb-network.HostIPv4CIDR: ", thisPeerIPv4CIDR)
 
 	// Set interface parameters
-	cbnetwork.runIP("link", "set", "dev", cbnetwork.name, "mtu", MTU)
-	cbnetwork.runIP("addr", "add", thisPeerIPv4CIDR, "dev", cbnetwork.name)
-	cbnetwork.runIP("link", "set", "dev", cbnetwork.name, "up")
+	if err := cbnetwork.runIP("link", "set", "dev", cbnetwork.name, "mtu", MTU); err != nil {
+		return err
+	}
+	if err := cbnetwork.runIP("addr", "add", thisPeerIPv4CIDR, "dev", cbnetwork.name); err != nil {
+		return err
+	}
+	if err := cbnetwork.runIP("link", "set", "dev", cbnetwork.name, "up"); err != nil {
+		return err
+	}
+	cbnetwork.addressMutex.Lock()
+	cbnetwork.configuredIPv4CIDR = thisPeerIPv4CIDR
+	cbnetwork.addressMutex.Unlock()
 
 	time.Sleep(1 * time.Second)
 
@@ -412,14 +463,68 @@ func (cbnetwork *CBNetwork) ConfigureCBNetworkInterface() error {
 	// Wait until tunneling() is started
 	time.Sleep(1 * time.Second)
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 	return nil
 }
 
-func (cbnetwork *CBNetwork) runIP(args ...string) {
-	CBLogger.Debug("Start.........")
+// IsInterfaceConfigured represents a function to check if the network interface is configured or not
+func (cbnetwork CBNetwork) IsInterfaceConfigured() bool {
+	return cbnetwork.isInterfaceConfigured
+}
+
+// IsReaddressed represents a function to check if this peer has been assigned another IPv4 CIDR
+// than the one configured on the network interface
+func (cbnetwork CBNetwork) IsReaddressed() bool {
+	cbnetwork.addressMutex.Lock()
+	defer cbnetwork.addressMutex.Unlock()
+	return cbnetwork.isInterfaceConfigured && cbnetwork.configuredIPv4CIDR != cbnetwork.ThisPeer.IPv4CIDR
+}
+
+// AddCBNetworkAddress represents a function to add the assigned IPv4 CIDR of this peer to the network interface.
+// The previous one is kept until RemoveCBNetworkAddress() is called, so both are available for a while.
+// It returns the previous IPv4 CIDR.
+func (cbnetwork *CBNetwork) AddCBNetworkAddress() string {
+	cbnetwork.logger.Debug("Start.........")
 
-	CBLogger.Trace(args)
+	cbnetwork.addressMutex.Lock()
+	defer cbnetwork.addressMutex.Unlock()
+
+	previousIPv4CIDR := cbnetwork.configuredIPv4CIDR
+	thisPeerIPv4CIDR := cbnetwork.ThisPeer.IPv4CIDR
+	cbnetwork.logger.Infof("Add %v to %v (previous: %v)", thisPeerIPv4CIDR, cbnetwork.name, previousIPv4CIDR)
+
+	if err := cbnetwork.runIP("addr", "add", thisPeerIPv4CIDR, "dev", cbnetwork.name); err != nil {
+		cbnetwork.logger.Error(err)
+	}
+	cbnetwork.configuredIPv4CIDR = thisPeerIPv4CIDR
+
+	cbnetwork.logger.Debug("End.........")
+	return previousIPv4CIDR
+}
+
+// RemoveCBNetworkAddress represents a function to remove a previous IPv4 CIDR from the network interface
+func (cbnetwork *CBNetwork) RemoveCBNetworkAddress(previousIPv4CIDR string) {
+	cbnetwork.logger.Debug("Start.........")
+
+	cbnetwork.addressMutex.Lock()
+	defer cbnetwork.addressMutex.Unlock()
+
+	if previousIPv4CIDR == "" || previousIPv4CIDR == cbnetwork.configuredIPv4CIDR {
+		cbnetwork.logger.Debug("End.........")
+		return
+	}
+	cbnetwork.logger.Infof("Remove %v from %v", previousIPv4CIDR, cbnetwork.name)
+	if err := cbnetwork.runIP("addr", "del", previousIPv4CIDR, "dev", cbnetwork.name); err != nil {
+		cbnetwork.logger.Error(err)
+	}
+
+	cbnetwork.logger.Debug("End.........")
+}
+
+func (cbnetwork *CBNetwork) runIP(args ...string) error {
+	cbnetwork.logger.Debug("Start.........")
+
+	cbnetwork.logger.Trace(args)
 
 	cmd := exec.Command("/sbin/ip", args...)
 	cmd.Stderr = os.Stderr
@@ -427,41 +532,45 @@ func (cbnetwork *CBNetwork) runIP(args ...string) {
 	cmd.Stdin = os.Stdin
 	err := cmd.Run()
 	if nil != err {
-		CBLogger.Fatal("Error running /sbin/ip:", err)
+		cbnetwork.logger.Debug("End.........")
+		return fmt.Errorf("error running /sbin/ip %v: %v", strings.Join(args, " "), err)
 	}
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
+	return nil
 }
 
 // Run represents a function to start the cloud-barista network.
 func (cbnetwork *CBNetwork) Run() {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
-	CBLogger.Debug("Blocked till the networking rule setup")
+	cbnetwork.logger.Debug("Blocked till the networking rule setup")
 	cbnetwork.notificationChannel = make(chan bool)
 	<-cbnetwork.notificationChannel
 
 	cbnetwork.initializeTunneling()
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 }
 
 // initializeTunneling represents a function to be performing tunneling between hosts (e.g., VMs).
 func (cbnetwork *CBNetwork) initializeTunneling() {
 
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
 	// Listen to local socket
 	// Create network address to listen
 	lstnAddr, err := net.ResolveUDPAddr("udp", fmt.Sprintf(":%v", cbnetwork.port))
 	if err != nil {
-		CBLogger.Fatal("Unable to get UDP socket:", err)
+		cbnetwork.logger.Error("Unable to get UDP socket: ", err)
+		return
 	}
 
 	// Create connection to network address
 	lstnConn, err := net.ListenUDP("udp", lstnAddr)
 	if err != nil {
-		CBLogger.Fatal("Unable to listen on UDP socket:", err)
+		cbnetwork.logger.Error("Unable to listen on UDP socket: ", err)
+		return
 	}
 	cbnetwork.listenConnection = lstnConn
 
@@ -469,7 +578,7 @@ func (cbnetwork *CBNetwork) initializeTunneling() {
 	defer func() {
 		errClose := cbnetwork.listenConnection.Close()
 		if errClose != nil {
-			CBLogger.Error("can't close the listen connection", errClose)
+			cbnetwork.logger.Error("can't close the listen connection", errClose)
 		}
 	}()
 
@@ -485,11 +594,13 @@ func (cbnetwork *CBNetwork) initializeTunneling() {
 
 	wg.Wait()
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 }
 
+// encapsulate is on the per-packet hot path, so it logs nothing but the errors, at most once per peer per errorLogInterval
+// (see the traffic statistics for the packets, TracePackets to log the headers for a while, or CapturePackets).
 func (cbnetwork *CBNetwork) encapsulate(wg *sync.WaitGroup) error {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 	defer wg.Done()
 
 	packet := make([]byte, BUFFERSIZE)
@@ -498,30 +609,34 @@ func (cbnetwork *CBNetwork) encapsulate(wg *sync.WaitGroup) error {
 		// Read packet from the interface "cbnet0"
 		plen, err := cbnetwork.Interface.Read(packet[:])
 		if err != nil {
-			CBLogger.Error("Error Read() in encapsulation: ", err)
+			cbnetwork.logger.Error("Error Read() in encapsulation: ", err)
 			return err
 		}
 
 		// Parse header
 		header, _ := ipv4.ParseHeader(packet[:plen])
-		CBLogger.Tracef("[Encapsulation] Received %d bytes from %v", plen, header.Src.String())
-		CBLogger.Tracef("[Encapsulation] Header: %+v", header)
 
 		// Search and change destination (Public IP of target VM)
 		idx := cbnetwork.NetworkingRule.GetIndexOfPeerIP(header.Dst.String())
 
 		if idx != -1 {
+			peer := cbnetwork.peerLabel(idx)
+			cbnetwork.tracePacket("Encapsulation", peer, packet[:plen])
 
 			// Get the corresponding host's IP address
 			remoteIP := cbnetwork.NetworkingRule.SelectedIP[idx]
 
 			// Resolve remote addr
 			remoteAddr, err := net.ResolveUDPAddr("udp", fmt.Sprintf("%s:%v", remoteIP, cbnetwork.port))
-			CBLogger.Tracef("Remote Endpoint: %+v", remoteAddr)
 			if nil != err {
-				CBLogger.Fatal("Unable to resolve remote addr:", err)
+				if cbnetwork.statistics.shouldLogError(peer, time.Now()) {
+					cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Error("Unable to resolve remote addr: ", err)
+				}
+				cbnetwork.countDrop(peer, model.DropSendError)
+				continue
 			}
 
+			innerPacket := packet[:plen]
 			bufToWrite := packet[:plen]
 
 			if cbnetwork.isEncryptionEnabled {
@@ -531,8 +646,15 @@ func (cbnetwork *CBNetwork) encapsulate(wg *sync.WaitGroup) error {
 
 					// Get the corresponding host's public key
 					HostID := cbnetwork.NetworkingRule.HostID[idx]
-					CBLogger.Tracef("HostID: %+v", HostID)
 					publicKey := cbnetwork.GetKey(HostID)
+					if publicKey == nil {
+						// The key may be revoked or not yet received
+						if cbnetwork.statistics.shouldLogError(peer, time.Now()) {
+							cbnetwork.logger.WithFields(logger.Fields{logger.Peer: HostID}).Error("no public key of the host")
+						}
+						cbnetwork.countDrop(peer, model.DropNoPublicKey)
+						continue
+					}
 
 					// Encrypt plaintext by corresponidng public key
 					ciphertext, err := rsa.EncryptPKCS1v15(
@@ -540,10 +662,11 @@ func (cbnetwork *CBNetwork) encapsulate(wg *sync.WaitGroup) error {
 						publicKey,
 						[]byte(packet[:plen]),
 					)
-					CBLogger.Tracef("[Encapsulation] Ciphertext (encrypted) %d bytes", len(ciphertext))
-
 					if err != nil {
-						CBLogger.Error("could not encrypt plaintext")
+						if cbnetwork.statistics.shouldLogError(peer, time.Now()) {
+							cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Error("could not encrypt plaintext: ", err)
+						}
+						cbnetwork.countEncryptError(peer)
 						continue
 					}
 
@@ -555,15 +678,25 @@ func (cbnetwork *CBNetwork) encapsulate(wg *sync.WaitGroup) error {
 			// Send packet
 			nWriteToUDP, errWriteToUDP := cbnetwork.listenConnection.WriteToUDP(bufToWrite[:plen], remoteAddr)
 			if errWriteToUDP != nil || nWriteToUDP == 0 {
-				CBLogger.Errorf("Error(%d len): %s", nWriteToUDP, errWriteToUDP)
+				if cbnetwork.statistics.shouldLogError(peer, time.Now()) {
+					cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Errorf("Error(%d len): %s", nWriteToUDP, errWriteToUDP)
+				}
+				cbnetwork.countDrop(peer, model.DropSendError)
+				continue
 			}
+			cbnetwork.countSent(peer, nWriteToUDP)
+			cbnetwork.capturePacket(outbound, peer, innerPacket, bufToWrite[:plen], remoteAddr)
+		} else {
+			// No networking rule to the destination
+			cbnetwork.countDrop("", model.DropNoRoute)
 		}
-		// CBLogger.Debug("End.........")
 	}
 }
 
+// decapsulate is on the per-packet hot path, so it logs nothing but the errors, at most once per peer per errorLogInterval
+// (see the traffic statistics for the packets, TracePackets to log the headers for a while, or CapturePackets).
 func (cbnetwork *CBNetwork) decapsulate(wg *sync.WaitGroup) error {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 	defer wg.Done()
 
 	// Decapsulation
@@ -572,11 +705,16 @@ func (cbnetwork *CBNetwork) decapsulate(wg *sync.WaitGroup) error {
 		// ReadFromUDP acts like ReadFrom but returns a UDPAddr.
 		n, addr, err := cbnetwork.listenConnection.ReadFromUDP(buf)
 		if err != nil {
-			CBLogger.Error("Error in cbnetwork.listenConnection.ReadFromUDP(buf): ", err)
+			cbnetwork.logger.Error("Error in cbnetwork.listenConnection.ReadFromUDP(buf): ", err)
 			return err
 		}
-		CBLogger.Tracef("[Decapsulation] Received %d bytes from %v", n, addr)
 
+		// Search the peer by the source (the selected IP of the peer)
+		idx := cbnetwork.NetworkingRule.GetIndexOfSelectedIP(addr.IP.String())
+		peer := cbnetwork.peerLabel(idx)
+		cbnetwork.countReceived(peer, n)
+
+		outerPayload := buf[:n]
 		bufToWrite := buf[:n]
 		// if n < BUFFERSIZE-1 {
 		// 	buf[n+1] = '\n'
@@ -584,24 +722,18 @@ func (cbnetwork *CBNetwork) decapsulate(wg *sync.WaitGroup) error {
 
 		if cbnetwork.isEncryptionEnabled {
 
-			// Search and change destination (Public IP of target VM)
-			idx := cbnetwork.NetworkingRule.GetIndexOfSelectedIP(addr.IP.String())
-
 			if idx != -1 {
 				// Get the corresponding peer's scope
 				peerScope := cbnetwork.NetworkingRule.PeerScope[idx]
 
 				if peerScope == "inter" {
-					// Decrypt ciphertext by private key
-					plaintext, err := rsa.DecryptPKCS1v15(
-						rand.Reader,
-						cbnetwork.privateKey,
-						buf[:n],
-					)
-					CBLogger.Tracef("[Decapsulation] Plaintext (decrypted) %d bytes", len(plaintext))
-
+					// Decrypt ciphertext by private key (or the previous one during the grace period)
+					plaintext, err := cbnetwork.decrypt(buf[:n])
 					if err != nil {
-						CBLogger.Error("could not decrypt ciphertext")
+						if cbnetwork.statistics.shouldLogError(peer, time.Now()) {
+							cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Error("could not decrypt ciphertext: ", err)
+						}
+						cbnetwork.countDecryptError(peer)
 						continue
 					}
 					bufToWrite = plaintext
@@ -611,9 +743,8 @@ func (cbnetwork *CBNetwork) decapsulate(wg *sync.WaitGroup) error {
 
 		}
 
-		// Parse header
-		header, _ := ipv4.ParseHeader(bufToWrite)
-		CBLogger.Tracef("[Decapsulation] Header: %+v", header)
+		cbnetwork.tracePacket("Decapsulation", peer, bufToWrite[:n])
+		cbnetwork.capturePacket(inbound, peer, bufToWrite[:n], outerPayload, addr)
 
 		// It might be necessary to handle or route packets to the specific destination
 		// based on the NetworkingRule table
@@ -622,36 +753,41 @@ func (cbnetwork *CBNetwork) decapsulate(wg *sync.WaitGroup) error {
 		// Write to TUN interface
 		nWrite, errWrite := cbnetwork.Interface.Write(bufToWrite[:n])
 		if errWrite != nil || nWrite == 0 {
-			CBLogger.Errorf("Error(%d len): %s", nWrite, errWrite)
+			if cbnetwork.statistics.shouldLogError(peer, time.Now()) {
+				cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Errorf("Error(%d len): %s", nWrite, errWrite)
+			}
+			cbnetwork.countDrop(peer, model.DropWriteError)
 		}
 
 	}
-	// CBLogger.Debug("End.........")
+	// cbnetwork.logger.Debug("End.........")
 }
 
 // CloseCBNetworkInterface represents a function to stop the cloud-barista network.
 func (cbnetwork *CBNetwork) CloseCBNetworkInterface() {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
 	// [To be improved] Stop tunneling routines
 	// Currently just return func() when an error occur
 
-	CBLogger.Debug("close the listen connection")
+	cbnetwork.logger.Debug("close the listen connection")
 	cbnetwork.listenConnection.Close()
 
-	CBLogger.Debugf("down interface (%s)", cbnetwork.name)
-	cbnetwork.runIP("link", "set", "dev", cbnetwork.name, "down")
+	cbnetwork.logger.Debugf("down interface (%s)", cbnetwork.name)
+	if err := cbnetwork.runIP("link", "set", "dev", cbnetwork.name, "down"); err != nil {
+		cbnetwork.logger.Error(err)
+	}
 
-	CBLogger.Debug("close interface")
+	cbnetwork.logger.Debug("close interface")
 	cbnetwork.Interface.Close()
 
-	CBLogger.Debug("set flag (isInterfaceConfigured) false")
+	cbnetwork.logger.Debug("set flag (isInterfaceConfigured) false")
 	cbnetwork.isInterfaceConfigured = false
 
-	CBLogger.Debug("close channel (notificationChannel)")
+	cbnetwork.logger.Debug("close channel (notificationChannel)")
 	close(cbnetwork.notificationChannel)
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 }
 
 // EnableEncryption represents a function to set a status for message encryption.
@@ -659,7 +795,7 @@ func (cbnetwork *CBNetwork) EnableEncryption(isTrue bool) {
 	if isTrue {
 		err := cbnetwork.configureRSAKey()
 		if err != nil {
-			CBLogger.Error(err)
+			cbnetwork.logger.Error(err)
 		}
 		cbnetwork.isEncryptionEnabled = true
 	}
@@ -677,20 +813,24 @@ func (cbnetwork CBNetwork) IsEncryptionEnabled() bool {
 
 // GetPublicKeyBase64 represents a function to get a public key.
 func (cbnetwork CBNetwork) GetPublicKeyBase64() (string, error) {
+	cbnetwork.privateKeyMutex.RLock()
+	defer cbnetwork.privateKeyMutex.RUnlock()
+
+	if cbnetwork.privateKey == nil {
+		return "", errors.New("no RSA key configured")
+	}
 	return secutil.PublicKeyToBase64(&cbnetwork.privateKey.PublicKey)
 }
 
-// GenerateRSAKey represents a function to generate RSA key
-func (cbnetwork *CBNetwork) configureRSAKey() error {
-	CBLogger.Debug("Start.........")
-
+// rsaKeyPaths returns the paths of the private and public key files of this host.
+func (cbnetwork CBNetwork) rsaKeyPaths() (string, string, string) {
 	// Set directory
 	ex, err := os.Executable()
 	if err != nil {
-		CBLogger.Error(err)
+		cbnetwork.logger.Error(err)
 	}
 	exePath := filepath.Dir(ex)
-	CBLogger.Tracef("exePath: %v\n", exePath)
+	cbnetwork.logger.Tracef("exePath: %v\n", exePath)
 
 	// Set secret path
 	secretPath := filepath.Join(exePath, "secret")
@@ -698,61 +838,85 @@ func (cbnetwork *CBNetwork) configureRSAKey() error {
 	// Set file and path for private key
 	privateKeyFile := cbnetwork.HostID + ".pem"
 	privateKeyPath := filepath.Join(secretPath, privateKeyFile)
-	CBLogger.Tracef("privateKeyPath: %+v", privateKeyPath)
+	cbnetwork.logger.Tracef("privateKeyPath: %+v", privateKeyPath)
 
 	// Set file and path for public key
 	publicKeyFile := cbnetwork.HostID + ".pub"
 	publicKeyPath := filepath.Join(secretPath, publicKeyFile)
-	CBLogger.Tracef("publicKeyPath: %+v", publicKeyPath)
+	cbnetwork.logger.Tracef("publicKeyPath: %+v", publicKeyPath)
 
-	if !file.Exists(privateKeyPath) {
-		CBLogger.Debug("Generage and save RSA key to files")
-		// Create directory or folder if not exist
-		_, err := os.Stat(secretPath)
+	return secretPath, privateKeyPath, publicKeyPath
+}
 
-		if os.IsNotExist(err) {
-			errDir := os.MkdirAll(secretPath, 0600)
-			if errDir != nil {
-				log.Fatal(err)
-			}
+// generateRSAKey generates a RSA key and saves it to the key files.
+func (cbnetwork CBNetwork) generateRSAKey() (*rsa.PrivateKey, error) {
+	cbnetwork.logger.Debug("Generage and save RSA key to files")
 
-		}
+	secretPath, privateKeyPath, publicKeyPath := cbnetwork.rsaKeyPaths()
 
-		// Generate RSA key
-		privateKey, publicKey, err := secutil.GenerateRSAKey()
-		if err != nil {
-			return err
+	// Create directory or folder if not exist
+	_, err := os.Stat(secretPath)
+
+	if os.IsNotExist(err) {
+		errDir := os.MkdirAll(secretPath, 0600)
+		if errDir != nil {
+			return nil, errDir
 		}
 
-		// Set member data in CBNetwork
-		cbnetwork.privateKey = privateKey
+	}
 
-		// To bytes
-		privateKeyBytes, err := secutil.PrivateKeyToBytes(privateKey)
-		if err != nil {
-			return err
-		}
+	// Generate RSA key
+	privateKey, publicKey, err := secutil.GenerateRSAKey()
+	if err != nil {
+		return nil, err
+	}
 
-		// Save private key
-		err = secutil.SavePrivateKeyToFile(privateKeyBytes, privateKeyPath)
-		if err != nil {
-			return err
-		}
+	// To bytes
+	privateKeyBytes, err := secutil.PrivateKeyToBytes(privateKey)
+	if err != nil {
+		return nil, err
+	}
 
-		// To bytes
-		publicKeyBytes, err := secutil.PublicKeyToBytes(publicKey)
-		if err != nil {
-			return err
-		}
+	// Save private key
+	err = secutil.SavePrivateKeyToFile(privateKeyBytes, privateKeyPath)
+	if err != nil {
+		return nil, err
+	}
 
-		// Save public key
-		err = secutil.SavePublicKeyToFile(publicKeyBytes, publicKeyPath)
+	// To bytes
+	publicKeyBytes, err := secutil.PublicKeyToBytes(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save public key
+	err = secutil.SavePublicKeyToFile(publicKeyBytes, publicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey, nil
+}
+
+// GenerateRSAKey represents a function to generate RSA key
+func (cbnetwork *CBNetwork) configureRSAKey() error {
+	cbnetwork.logger.Debug("Start.........")
+
+	_, privateKeyPath, publicKeyPath := cbnetwork.rsaKeyPaths()
+
+	if !file.Exists(privateKeyPath) {
+		privateKey, err := cbnetwork.generateRSAKey()
 		if err != nil {
 			return err
 		}
 
+		// Set member data in CBNetwork
+		cbnetwork.privateKeyMutex.Lock()
+		cbnetwork.privateKey = privateKey
+		cbnetwork.privateKeyMutex.Unlock()
+
 	} else {
-		CBLogger.Debug("Load RSA key from files")
+		cbnetwork.logger.Debug("Load RSA key from files")
 		privateKey, err := secutil.LoadPrivateKeyFromFile(privateKeyPath)
 		if err != nil {
 			return err
@@ -766,17 +930,67 @@ func (cbnetwork *CBNetwork) configureRSAKey() error {
 		privateKey.PublicKey = *publicKey
 
 		// Set member data in CBNetwork
+		cbnetwork.privateKeyMutex.Lock()
 		cbnetwork.privateKey = privateKey
+		cbnetwork.privateKeyMutex.Unlock()
+	}
+
+	cbnetwork.logger.Debug("End.........")
+
+	return nil
+}
+
+// RotateRSAKey represents a function to replace the RSA key with a new one.
+// The previous private key is still accepted to decrypt packets during the grace period,
+// so that the peers encrypting by the previous public key are not interrupted.
+// If the grace period is 0, the previous private key is discarded immediately (e.g., revocation).
+func (cbnetwork *CBNetwork) RotateRSAKey(gracePeriod time.Duration) error {
+	cbnetwork.logger.Debug("Start.........")
+
+	privateKey, err := cbnetwork.generateRSAKey()
+	if err != nil {
+		return err
+	}
+
+	cbnetwork.privateKeyMutex.Lock()
+	if gracePeriod > 0 && cbnetwork.privateKey != nil {
+		cbnetwork.previousPrivateKey = cbnetwork.privateKey
+		cbnetwork.previousKeyExpiresAt = time.Now().Add(gracePeriod)
+	} else {
+		cbnetwork.previousPrivateKey = nil
+		cbnetwork.previousKeyExpiresAt = time.Time{}
 	}
+	cbnetwork.privateKey = privateKey
+	cbnetwork.privateKeyMutex.Unlock()
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Infof("RSA key rotated (grace period: %v)", gracePeriod)
 
+	cbnetwork.logger.Debug("End.........")
 	return nil
 }
 
+// decrypt decrypts a ciphertext by the private key, or by the previous private key during the grace period.
+func (cbnetwork CBNetwork) decrypt(ciphertext []byte) ([]byte, error) {
+	cbnetwork.privateKeyMutex.RLock()
+	privateKey := cbnetwork.privateKey
+	previousPrivateKey := cbnetwork.previousPrivateKey
+	previousKeyExpiresAt := cbnetwork.previousKeyExpiresAt
+	cbnetwork.privateKeyMutex.RUnlock()
+
+	if privateKey == nil {
+		return nil, errors.New("no RSA key configured")
+	}
+
+	plaintext, err := rsa.DecryptPKCS1v15(rand.Reader, privateKey, ciphertext)
+	if err != nil && previousPrivateKey != nil && time.Now().Before(previousKeyExpiresAt) {
+		return rsa.DecryptPKCS1v15(rand.Reader, previousPrivateKey, ciphertext)
+	}
+	return plaintext, err
+}
+
 // UpdateKeyring updates a public key with a host ID
 func (cbnetwork *CBNetwork) UpdateKeyring(hostID string, base64PublicKey string) error {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 	publicKey, err := secutil.PublicKeyFromBase64(base64PublicKey)
 	if err != nil {
 		return err
@@ -785,93 +999,129 @@ func (cbnetwork *CBNetwork) UpdateKeyring(hostID string, base64PublicKey string)
 	cbnetwork.keyringMutex.Lock()
 	cbnetwork.keyring[hostID] = publicKey
 	cbnetwork.keyringMutex.Unlock()
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 
 	return nil
 }
 
+// RemoveKeyring removes a public key of a host ID (e.g., revoked)
+func (cbnetwork *CBNetwork) RemoveKeyring(hostID string) {
+	cbnetwork.logger.Debug("Start.........")
+	cbnetwork.keyringMutex.Lock()
+	delete(cbnetwork.keyring, hostID)
+	cbnetwork.keyringMutex.Unlock()
+	cbnetwork.logger.Debug("End.........")
+}
+
 // GetKey returns a public key by a host ID
+// It is called for each packet to be encrypted, so it doesn't log.
 func (cbnetwork CBNetwork) GetKey(hostID string) *rsa.PublicKey {
-	CBLogger.Debug("Start.........")
 	cbnetwork.keyringMutex.Lock()
 	key := cbnetwork.keyring[hostID]
 	cbnetwork.keyringMutex.Unlock()
-	CBLogger.Debug("End.........")
 
 	return key
 }
 
 // ConfigureHostID represents a function to set a unique host ID
 func (cbnetwork *CBNetwork) ConfigureHostID() error {
-	CBLogger.Debug("Start.........")
+	hostID, err := LoadHostID(cbnetwork.logger)
+	if err != nil {
+		return err
+	}
+	cbnetwork.HostID = hostID
+	return nil
+}
+
+// LoadHostID represents a function to load the unique host ID of this host,
+// which is generated and saved to "secret/hostID" next to the executable at first.
+// It is used before constructing CBNetwork, e.g., to name the logger after the host ID.
+func LoadHostID(logger logger.Logger) (string, error) {
+	return loadOrGenerateSecret(logger, "hostID", 0644, func() (string, error) {
+		// Generate host ID
+		guid := xid.New()
+		return guid.String(), nil
+	})
+}
+
+// LoadAgentCredential represents a function to load the credential of this agent,
+// which is generated and saved to "secret/agentCredential" next to the executable at first.
+// The cb-network service binds the host ID to the credential when this agent joins a CLADNet by a join token,
+// so the credential has to be kept along with the host ID.
+func LoadAgentCredential(logger logger.Logger) (string, error) {
+	return loadOrGenerateSecret(logger, "agentCredential", 0600, func() (string, error) {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(b), nil
+	})
+}
+
+// loadOrGenerateSecret loads a value from a file in the "secret" directory next to the executable,
+// or generates and saves it if the file does not exist.
+func loadOrGenerateSecret(logger logger.Logger, fileName string, perm os.FileMode, generate func() (string, error)) (string, error) {
+	logger.Debug("Start.........")
 
 	// Set directory
 	ex, err := os.Executable()
 	if err != nil {
-		CBLogger.Error(err)
+		logger.Error(err)
 	}
 	exePath := filepath.Dir(ex)
-	CBLogger.Tracef("exePath: %v\n", exePath)
+	logger.Tracef("exePath: %v\n", exePath)
 
 	// Set secret path
 	secretPath := filepath.Join(exePath, "secret")
 
-	// Set file and path for the host ID
-	hostIDFile := "hostID"
-	hostIDPath := filepath.Join(secretPath, hostIDFile)
-	CBLogger.Tracef("hostIDPath: %+v", hostIDPath)
+	// Set file and path
+	filePath := filepath.Join(secretPath, fileName)
+	logger.Tracef("filePath: %+v", filePath)
 
-	if !file.Exists(hostIDPath) {
-		CBLogger.Debug("Generate and save host ID to file")
+	if !file.Exists(filePath) {
+		logger.Debugf("Generate and save %v to file", fileName)
 		// Create directory or folder if not exist
 		_, err := os.Stat(secretPath)
 
 		if os.IsNotExist(err) {
 			errDir := os.MkdirAll(secretPath, 0600)
 			if errDir != nil {
-				log.Fatal(err)
+				return "", errDir
 			}
 
 		}
 
-		// Generate host ID
-		guid := xid.New()
-		hostID := guid.String()
-
-		cbnetwork.HostID = hostID
-
-		// Dump host ID to file
-		err = ioutil.WriteFile(hostIDPath, []byte(hostID), 0644)
+		value, err := generate()
 		if err != nil {
-			CBLogger.Error(err)
-			return err
+			return "", err
 		}
 
-	} else {
-		CBLogger.Debug("Load host ID from file")
-
-		dat, err := ioutil.ReadFile(hostIDPath)
+		// Dump the value to file
+		err = ioutil.WriteFile(filePath, []byte(value), perm)
 		if err != nil {
-			CBLogger.Error(err)
-			return err
+			logger.Error(err)
+			return "", err
 		}
 
-		cbnetwork.HostID = string(dat)
-
+		logger.Debug("End.........")
+		return value, nil
 	}
 
-	CBLogger.Debug("End.........")
+	logger.Debugf("Load %v from file", fileName)
+	dat, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		logger.Error(err)
+		return "", err
+	}
 
-	return nil
+	logger.Debug("End.........")
+	return string(dat), nil
 }
 
 // SelectDestinationByRuleType represents a function to set a unique host ID
 func SelectDestinationByRuleType(ruleType string, sourcePeer model.Peer, destinationPeer model.Peer) (string, string, error) {
-	CBLogger.Debug("Start.........")
-
 	var err error
 
-	CBLogger.Tracef("Rule type: %+v", ruleType)
 	switch ruleType {
 	case ruletype.Basic:
 		return destinationPeer.HostPublicIP, "inter", nil
@@ -879,17 +1129,15 @@ func SelectDestinationByRuleType(ruleType string, sourcePeer model.Peer, destina
 	case ruletype.CostPrioritized:
 		selectedDestination, peerScope, err2 := selectByCostPrioritizedRule(sourcePeer, destinationPeer)
 		if err2 != nil {
-			CBLogger.Debugf("Public IP is selected (due to %v)", err2)
+			// Public IP is selected (e.g., due to lack of the cloud information)
 			return destinationPeer.HostPublicIP, "inter", nil
 		}
 		return selectedDestination, peerScope, nil
 
 	default:
 		err = errors.New("unknown rule type")
-		CBLogger.Error(err)
 	}
 
-	CBLogger.Debug("End.........")
 	return destinationPeer.HostPublicIP, "inter", err
 }
 
@@ -952,9 +1200,9 @@ func selectByCostPrioritizedRule(sourcePeer model.Peer, destinationPeer model.Pe
 
 // StorePeer represents a function to add, synchronize, manage peers in local (memory)
 func (cbnetwork *CBNetwork) StorePeer(peer model.Peer) {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
-	CBLogger.Debug("Lock to update peers")
+	cbnetwork.logger.Debug("Lock to update peers")
 	cbnetwork.peersMutex.Lock()
 
 	// Store and synchronize peers to manage them in local
@@ -967,28 +1215,28 @@ func (cbnetwork *CBNetwork) StorePeer(peer model.Peer) {
 		cbnetwork.OtherPeers[peer.HostID] = peer
 	}
 
-	CBLogger.Debug("Unlock to update peers")
+	cbnetwork.logger.Debug("Unlock to update peers")
 	cbnetwork.peersMutex.Unlock()
 
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 }
 
 // GetPeer represents a function to find and return a peer in the local map (data structure)
 func (cbnetwork *CBNetwork) GetPeer(hostID string) (model.Peer, error) {
-	CBLogger.Debug("Start.........")
+	cbnetwork.logger.Debug("Start.........")
 
-	// CBLogger.Debug("Lock to update peers")
+	// cbnetwork.logger.Debug("Lock to update peers")
 	// cbnetwork.peersMutex.Lock()
 
 	if hostID == cbnetwork.HostID {
-		CBLogger.Debug("End.........")
+		cbnetwork.logger.Debug("End.........")
 		return cbnetwork.ThisPeer, nil
 	}
 	tempPeer, exist := cbnetwork.OtherPeers[hostID]
 	if !exist {
-		CBLogger.Debug("End.........")
+		cbnetwork.logger.Debug("End.........")
 		return model.Peer{}, errors.New("could not find the peer")
 	}
-	CBLogger.Debug("End.........")
+	cbnetwork.logger.Debug("End.........")
 	return tempPeer, nil
 }
@@ -0,0 +1,34 @@
+package cbnet
+
+import (
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/metrics"
+)
+
+// unknownPeer is the peer label of the packets received from a host not in the networking rule
+const unknownPeer = "unknown"
+
+// Metrics of the tunneling
+var (
+	txPackets = metrics.NewCounterVec("cbnet_agent_tx_packets_total",
+		"Packets sent to each peer through the tunnel.", "peer")
+	txBytes = metrics.NewCounterVec("cbnet_agent_tx_bytes_total",
+		"Bytes sent to each peer through the tunnel.", "peer")
+	rxPackets = metrics.NewCounterVec("cbnet_agent_rx_packets_total",
+		"Packets received from each peer through the tunnel.", "peer")
+	rxBytes = metrics.NewCounterVec("cbnet_agent_rx_bytes_total",
+		"Bytes received from each peer through the tunnel.", "peer")
+	encryptFailures = metrics.NewCounterVec("cbnet_agent_encrypt_failures_total",
+		"Failures to encrypt the packets to each peer.", "peer")
+	decryptFailures = metrics.NewCounterVec("cbnet_agent_decrypt_failures_total",
+		"Failures to decrypt the packets from each peer.", "peer")
+	droppedPackets = metrics.NewCounterVec("cbnet_agent_dropped_packets_total",
+		"Packets dropped by reason.", "reason")
+)
+
+// peerLabel returns the host ID at the index of the networking rule, or "unknown"
+func (cbnetwork *CBNetwork) peerLabel(idx int) string {
+	if idx < 0 || idx >= len(cbnetwork.NetworkingRule.HostID) {
+		return unknownPeer
+	}
+	return cbnetwork.NetworkingRule.HostID[idx]
+}
@@ -0,0 +1,11 @@
+package cbnet
+
+import "time"
+
+// AgentHeartbeat represents the latest heartbeat of a cb-network agent
+type AgentHeartbeat struct {
+	CladnetID string    `json:"cladnetId"`
+	HostID    string    `json:"hostId"`
+	State     string    `json:"state"`
+	Timestamp time.Time `json:"timestamp"`
+}
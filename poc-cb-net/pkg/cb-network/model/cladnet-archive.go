@@ -0,0 +1,32 @@
+package cbnet
+
+import "time"
+
+// CLADNetArchiveVersion is the version of the archive format of a CLADNet
+const CLADNetArchiveVersion = 1
+
+// ArchivedNetworkingRule represents a networking rule of a host in an archive
+type ArchivedNetworkingRule struct {
+	HostID string         `json:"hostId"`
+	Rule   NetworkingRule `json:"rule"`
+}
+
+// ArchivedSecret represents a secret (RSA public key) of a host in an archive
+type ArchivedSecret struct {
+	HostID    string `json:"hostId"`
+	PublicKey string `json:"publicKey"`
+}
+
+// CLADNetArchive represents the state of a CLADNet to back up and restore.
+// The transient data (e.g., join tokens, commands and test results) is not archived.
+type CLADNetArchive struct {
+	ArchiveVersion    int                      `json:"archiveVersion"`
+	SchemaVersion     int                      `json:"schemaVersion"` // Schema version of the registry on export
+	ExportedAt        time.Time                `json:"exportedAt"`
+	Specification     CLADNetSpecification     `json:"specification"`
+	Peers             []Peer                   `json:"peers"`
+	NetworkingRules   []ArchivedNetworkingRule `json:"networkingRules"`
+	Secrets           []ArchivedSecret         `json:"secrets"`
+	SecretRevocations []SecretRevocation       `json:"secretRevocations"`
+	Heartbeats        []AgentHeartbeat         `json:"heartbeats"` // Alive agents on export
+}
@@ -2,9 +2,35 @@ package cbnet
 
 // CLADNetSpecification represents the specification of a Cloud Adaptive Network (CLADNet).
 type CLADNetSpecification struct {
-	CladnetID        string `json:"cladnetId"`
-	Name             string `json:"name"`
-	Ipv4AddressSpace string `json:"ipv4AddressSpace"`
-	Description      string `json:"description"`
-	RuleType         string `json:"ruleType"`
+	CladnetID        string            `json:"cladnetId"`
+	Name             string            `json:"name"`
+	Ipv4AddressSpace string            `json:"ipv4AddressSpace"`
+	Description      string            `json:"description"`
+	RuleType         string            `json:"ruleType"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Reservations     []IPReservation   `json:"reservations,omitempty"`
+	Policies         CLADNetPolicies   `json:"policies"`
+	SchemaVersion    int               `json:"schemaVersion,omitempty"`
+}
+
+// IPReservation represents an IP address in a CLADNet reserved for a host.
+type IPReservation struct {
+	HostID string `json:"hostId" yaml:"hostId"`
+	IP     string `json:"ip" yaml:"ip"`
+}
+
+// CLADNetPolicies represents the policies to apply to the peers of a CLADNet.
+type CLADNetPolicies struct {
+	// MaxPeers is the maximum number of peers (0 for unlimited)
+	MaxPeers int `json:"maxPeers,omitempty" yaml:"maxPeers,omitempty"`
+}
+
+// GetReservedIP represents a function to return the IP address reserved for the host ("" if none)
+func (spec CLADNetSpecification) GetReservedIP(hostID string) string {
+	for _, reservation := range spec.Reservations {
+		if reservation.HostID == hostID {
+			return reservation.IP
+		}
+	}
+	return ""
 }
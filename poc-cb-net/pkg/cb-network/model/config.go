@@ -3,23 +3,67 @@ package cbnet
 import (
 	"io/ioutil"
 	"path/filepath"
+	"time"
 
 	yaml "gopkg.in/yaml.v3"
 )
 
-// A config for the both cb-network controller and agent as follows:
+// A config for the cb-network controller, service, and admin-web as follows:
 
 // ETCDConfig represents the configuration information for a etcd cluster
 type ETCDConfig struct {
-	Endpoints []string `yaml:"endpoints"`
+	Endpoints        []string      `yaml:"endpoints"`
+	CAFile           string        `yaml:"ca_file"`
+	CertFile         string        `yaml:"cert_file"`
+	KeyFile          string        `yaml:"key_file"`
+	ServerName       string        `yaml:"server_name"`
+	Username         string        `yaml:"username"`
+	Password         string        `yaml:"password"`
+	DialTimeout      time.Duration `yaml:"dial_timeout"`
+	KeepAliveTime    time.Duration `yaml:"keep_alive_time"`
+	KeepAliveTimeout time.Duration `yaml:"keep_alive_timeout"`
+	RequestTimeout   time.Duration `yaml:"request_timeout"`
 }
 
 // A config for the cb-network service and cb-network admin-web as follows:
 
 // ServiceConfig represnets the configuration information for a gRPC server
 type ServiceConfig struct {
-	Endpoint string `yaml:"endpoint"`
-	Port     string `yaml:"port"`
+	Endpoint string            `yaml:"endpoint"`
+	Port     string            `yaml:"port"`
+	TLS      ServiceTLSConfig  `yaml:"tls"`
+	Auth     ServiceAuthConfig `yaml:"auth"`
+
+	// Insecure allows the service to start without TLS or API keys (e.g., for development)
+	Insecure bool `yaml:"insecure"`
+
+	// TestResultRetention is the period to keep the test runs and their results
+	TestResultRetention time.Duration `yaml:"test_result_retention"`
+}
+
+// ServiceTLSConfig represents the TLS configuration information for the cb-network service and its clients
+type ServiceTLSConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	CAFile         string `yaml:"ca_file"`
+	CertFile       string `yaml:"cert_file"`
+	KeyFile        string `yaml:"key_file"`
+	ClientAuth     bool   `yaml:"client_auth"`
+	ClientCertFile string `yaml:"client_cert_file"`
+	ClientKeyFile  string `yaml:"client_key_file"`
+	ServerName     string `yaml:"server_name"`
+}
+
+// ServiceAuthConfig represents the token-based authentication information for the cb-network service and its clients
+type ServiceAuthConfig struct {
+	Token   string         `yaml:"token"`
+	APIKeys []APIKeyConfig `yaml:"api_keys"`
+}
+
+// APIKeyConfig represents an API key and the CLADNets which it is authorized to access ("*" for all)
+type APIKeyConfig struct {
+	Name       string   `yaml:"name"`
+	Key        string   `yaml:"key"`
+	CLADNetIDs []string `yaml:"cladnet_ids"`
 }
 
 // A config for the cb-network controller as follows:
@@ -35,15 +79,40 @@ type AdminWebConfig struct {
 // CBNetworkConfig represents the configuration information for a cloud adaptive network
 type CBNetworkConfig struct {
 	CLADNetID string     `yaml:"cladnet_id"`
+	JoinToken string     `yaml:"join_token"`
 	Host      HostConfig `yaml:"host"`
 }
 
 // HostConfig represents the configuration information for a host in a cloud adaptvie network
 type HostConfig struct {
-	Name                 string `yaml:"name"`
-	NetworkInterfaceName string `yaml:"network_interface_name"`
-	TunnelingPort        string `yaml:"tunneling_port"`
-	IsEncrypted          bool   `yaml:"is_encrypted"`
+	Name                      string           `yaml:"name"`
+	NetworkInterfaceName      string           `yaml:"network_interface_name"`
+	UnderlayIPv4InterfaceName string           `yaml:"underlay_ipv4_interface_name"`
+	UnderlayIPv6InterfaceName string           `yaml:"underlay_ipv6_interface_name"`
+	PublicIP                  string           `yaml:"public_ip"`
+	CloudInformation          CloudInformation `yaml:"cloud_information"`
+	DisableCloudDetection     bool             `yaml:"disable_cloud_detection"`
+	TunnelingPort             string           `yaml:"tunneling_port"`
+	IsEncrypted               bool             `yaml:"is_encrypted"`
+	KeyRotationInterval       time.Duration    `yaml:"key_rotation_interval"`
+	KeyRotationGracePeriod    time.Duration    `yaml:"key_rotation_grace_period"`
+}
+
+// A config for the metrics of the cb-network service, controller and agent as follows:
+
+// MetricsConfig represents the configuration information for the metrics endpoint
+type MetricsConfig struct {
+	ListenAddress string `yaml:"listen_address"`
+}
+
+// A config for the tracing of the cb-network service, controller and agent as follows:
+
+// TracingConfig represents the configuration information for tracing by OpenTelemetry
+type TracingConfig struct {
+	Exporter string `yaml:"exporter"`  // "otlp", "file" or "" (disabled)
+	Endpoint string `yaml:"endpoint"`  // OTLP/gRPC endpoint of a collector, e.g., "localhost:4317"
+	Insecure bool   `yaml:"insecure"`  // Connect to the collector without TLS
+	FilePath string `yaml:"file_path"` // File to write the spans (JSON lines) by the file exporter
 }
 
 // Config represents the configuration information for cb-network
@@ -52,6 +121,8 @@ type Config struct {
 	AdminWeb          AdminWebConfig  `yaml:"admin_web"`
 	CBNetwork         CBNetworkConfig `yaml:"cb_network"`
 	Service           ServiceConfig   `yaml:"service"`
+	Metrics           MetricsConfig   `yaml:"metrics"`
+	Tracing           TracingConfig   `yaml:"tracing"`
 	ServiceCallMethod string          `yaml:"service_call_method"`
 }
 
@@ -3,7 +3,12 @@ package cbnet
 // HostNetworkInformation represents the network information of VM, such as public IP and private networks
 type HostNetworkInformation struct {
 	HostName          string             `json:"hostName"`
+	JoinToken         string             `json:"joinToken"`
 	IsEncrypted       bool               `json:"isEncrypted"`
 	PublicIP          string             `json:"publicIPAddress"`
 	NetworkInterfaces []NetworkInterface `json:"networkInterfaces"`
+	UnderlayIPv4      UnderlayAddress    `json:"underlayIpv4"`
+	UnderlayIPv6      UnderlayAddress    `json:"underlayIpv6"`
+	CloudInformation  CloudInformation   `json:"cloudInformation"`
+	TraceContext      map[string]string  `json:"traceContext,omitempty"` // Trace context of the workflow which put the information
 }
@@ -0,0 +1,19 @@
+package cbnet
+
+import "time"
+
+// JoinToken represents a scoped, expiring token for an agent to join a Cloud Adaptive Network (CLADNet).
+// Only the hash of the token secret is stored.
+type JoinToken struct {
+	TokenID     string    `json:"tokenId"`
+	CladnetID   string    `json:"cladnetId"`
+	SecretHash  string    `json:"secretHash"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// IsExpired represents a function to check if the join token is expired or not.
+func (token JoinToken) IsExpired(now time.Time) bool {
+	return !now.Before(token.ExpiresAt)
+}
@@ -1,11 +1,23 @@
 package cbnet
 
 // NetworkInterface represents a network interface in a system with assigned IPs (Typically IPv4, and IPv6)
+// IPv4 (IPv4CIDR) and IPv6 (IPv6CIDR) are the first ones, and all of the assigned ones are in IPv4CIDRs and IPv6CIDRs.
 type NetworkInterface struct {
-	Name     string `json:"name"`
-	Version  string `json:"version"`
-	IPv4     string `json:"ipv4"`
-	IPv4CIDR string `json:"ipv4Cidr"`
-	IPv6     string `json:"ipv6"`
-	IPv6CIDR string `json:"ipv6Cidr"`
+	Name      string   `json:"name"`
+	Version   string   `json:"version"`
+	IPv4      string   `json:"ipv4"`
+	IPv4CIDR  string   `json:"ipv4Cidr"`
+	IPv6      string   `json:"ipv6"`
+	IPv6CIDR  string   `json:"ipv6Cidr"`
+	IPv4CIDRs []string `json:"ipv4Cidrs,omitempty"`
+	IPv6CIDRs []string `json:"ipv6Cidrs,omitempty"`
+}
+
+// UnderlayAddress represents an address of the underlay network interface to be used for tunneling.
+// It is the source IP address of the default route, or the one of the network interface pinned by configuration.
+type UnderlayAddress struct {
+	InterfaceName string `json:"interfaceName"`
+	IP            string `json:"ip"`
+	CIDR          string `json:"cidr"`
+	IsPinned      bool   `json:"isPinned"`
 }
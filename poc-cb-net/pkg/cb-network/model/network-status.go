@@ -1,14 +1,35 @@
 package cbnet
 
+import "time"
+
 // TestSpecification represents the specification of a Cloud Adaptive Network (CLADNet).
+// The fields except CladnetID and TrialCount are optional, and the defaults are used if omitted.
 type TestSpecification struct {
-	CladnetID  string `json:"cladnetId"`
-	TrialCount int    `json:"trialCount"`
+	CladnetID  string   `json:"cladnetId"`
+	TrialCount int      `json:"trialCount"`
+	Peers      []string `json:"peers,omitempty"`      // Host IDs of the destinations (all peers if empty)
+	Duration   int      `json:"duration,omitempty"`   // Duration (sec) of a stream, e.g., THROUGHPUT and JITTER
+	Protocol   string   `json:"protocol,omitempty"`   // "tcp" or "udp" for THROUGHPUT
+	Bitrate    int64    `json:"bitrate,omitempty"`    // Bitrate (bps) of a UDP stream
+	PacketSize int      `json:"packetSize,omitempty"` // UDP payload size (bytes) of a UDP stream
+	Ports      []int    `json:"ports,omitempty"`      // TCP ports for PORT_REACHABILITY
+	MaxMTU     int      `json:"maxMtu,omitempty"`     // Upper bound of PATH_MTU
 }
 
 // NetworkStatus represents the statistics of a Cloud Adaptive Network (CLADNet).
+// Only the results of the test type are filled in except InterHostNetworkStatus of CONNECTIVITY.
 type NetworkStatus struct {
+	TestType               string                   `json:"testType,omitempty"`
+	RunID                  string                   `json:"runId,omitempty"`       // ID of the test run
+	HostID                 string                   `json:"hostId,omitempty"`      // Host ID of the agent which reported it
+	IsEncrypted            bool                     `json:"isEncrypted,omitempty"` // Whether the encryption of the agent is enabled
+	ReportedAt             time.Time                `json:"reportedAt"`
 	InterHostNetworkStatus []InterHostNetworkStatus `json:"interHostNetworkStatus"`
+	Throughput             []ThroughputStatus       `json:"throughput,omitempty"`
+	PathMTU                []PathMTUStatus          `json:"pathMtu,omitempty"`
+	Jitter                 []JitterStatus           `json:"jitter,omitempty"`
+	PortReachability       []PortReachabilityStatus `json:"portReachability,omitempty"`
+	UnderlayOverlay        []UnderlayOverlayStatus  `json:"underlayOverlay,omitempty"`
 }
 
 // InterHostNetworkStatus represents the network performance between two virtual machines in a CLADNet.
@@ -25,3 +46,64 @@ type InterHostNetworkStatus struct {
 	PacketsLoss     int     `json:"packetLoss"`
 	BytesReceived   int     `json:"bytesReceived"`
 }
+
+// HostPair represents a source and a destination of a test in a CLADNet.
+type HostPair struct {
+	SourceIP        string `json:"sourceIP"`
+	SourceName      string `json:"sourceName"`
+	DestinationIP   string `json:"destinationIP"`
+	DestinationName string `json:"destinationName"`
+}
+
+// ThroughputStatus represents the throughput from a host to another host in a CLADNet.
+type ThroughputStatus struct {
+	HostPair
+	Protocol        string  `json:"protocol"`
+	Duration        float64 `json:"duration"` // Seconds
+	BytesSent       int64   `json:"bytesSent"`
+	BytesReceived   int64   `json:"bytesReceived"`
+	BitsPerSecond   float64 `json:"bitsPerSecond"`
+	PacketsSent     int     `json:"packetsSent,omitempty"` // UDP only
+	PacketsLoss     int     `json:"packetLoss,omitempty"`  // UDP only
+	PacketsReceived int     `json:"packetsReceived,omitempty"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// PathMTUStatus represents the path MTU from a host to another host in a CLADNet.
+type PathMTUStatus struct {
+	HostPair
+	PathMTU int    `json:"pathMtu"`
+	Error   string `json:"error,omitempty"`
+}
+
+// JitterStatus represents the jitter (RFC 3550) of a UDP stream from a host to another host in a CLADNet.
+type JitterStatus struct {
+	HostPair
+	Jitter          float64 `json:"jitter"` // Seconds
+	PacketsSent     int     `json:"packetsSent"`
+	PacketsReceived int     `json:"packetsReceived"`
+	PacketsLoss     int     `json:"packetLoss"`
+	OutOfOrder      int     `json:"outOfOrder"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// PortReachabilityStatus represents the reachability of a TCP port of another host in a CLADNet.
+type PortReachabilityStatus struct {
+	HostPair
+	Port    int     `json:"port"`
+	State   string  `json:"state"`   // "open", "closed" or "filtered"
+	Latency float64 `json:"latency"` // Seconds to connect (or to fail)
+	Error   string  `json:"error,omitempty"`
+}
+
+// UnderlayOverlayStatus represents the round-trip times on the underlay and the overlay networks to another host.
+type UnderlayOverlayStatus struct {
+	HostPair
+	UnderlayIP         string  `json:"underlayIP"`
+	UnderlayAverageRTT float64 `json:"underlayAverageRTT"`
+	UnderlayPacketLoss int     `json:"underlayPacketLoss"`
+	OverlayAverageRTT  float64 `json:"overlayAverageRTT"`
+	OverlayPacketLoss  int     `json:"overlayPacketLoss"`
+	OverheadRTT        float64 `json:"overheadRTT"` // Overlay minus underlay (seconds)
+	Error              string  `json:"error,omitempty"`
+}
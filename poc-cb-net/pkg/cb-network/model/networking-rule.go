@@ -1,74 +1,21 @@
 package cbnet
 
-import (
-	"errors"
-	"fmt"
-	"os"
-	"path/filepath"
-
-	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
-	cblog "github.com/cloud-barista/cb-log"
-	"github.com/sirupsen/logrus"
-)
-
-// CBLogger represents a logger to show execution processes according to the logging level.
-var CBLogger *logrus.Logger
-
-func init() {
-	fmt.Println("\nStart......... init() of networking-rule.go")
-
-	// Set cb-log
-	env := os.Getenv("CBLOG_ROOT")
-	if env != "" {
-		// Load cb-log config from the environment variable path (default)
-		fmt.Printf("CBLOG_ROOT: %v\n", env)
-		CBLogger = cblog.GetLogger("cb-network")
-	} else {
-
-		// Load cb-log config from the current directory (usually for the production)
-		ex, err := os.Executable()
-		if err != nil {
-			panic(err)
-		}
-		exePath := filepath.Dir(ex)
-		// fmt.Printf("exe path: %v\n", exePath)
-
-		logConfPath := filepath.Join(exePath, "config", "log_conf.yaml")
-		if file.Exists(logConfPath) {
-			fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
-			CBLogger = cblog.GetLoggerWithConfigPath("cb-network", logConfPath)
-
-		} else {
-			// Load cb-log config from the project directory (usually for development)
-			logConfPath = filepath.Join(exePath, "..", "..", "config", "log_conf.yaml")
-			if file.Exists(logConfPath) {
-				fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
-				CBLogger = cblog.GetLoggerWithConfigPath("cb-network", logConfPath)
-			} else {
-				err := errors.New("fail to load log_conf.yaml")
-				panic(err)
-			}
-		}
-	}
-	fmt.Println("End......... init() of networking-rule.go")
-	fmt.Println("")
-}
-
 // NetworkingRule represents a networking rule of the cloud adaptive network.
 // It is used for tunneling between hosts(e.g., VMs).
 type NetworkingRule struct {
-	CladnetID  string   `json:"cladnetId"`
-	HostID     []string `json:"hostId"`
-	HostName   []string `json:"hostName"`
-	PeerIP     []string `json:"peerIP"`
-	SelectedIP []string `json:"selectedIP"`
-	PeerScope  []string `json:"peerScope"`
-	State      []string `json:"state"`
+	CladnetID      string   `json:"cladnetId"`
+	HostID         []string `json:"hostId"`
+	HostName       []string `json:"hostName"`
+	PeerIP         []string `json:"peerIP"`
+	SelectedIP     []string `json:"selectedIP"`
+	PeerScope      []string `json:"peerScope"`
+	State          []string `json:"state"`
+	PreviousPeerIP []string `json:"previousPeerIP,omitempty"` // Previous peer IP kept until the re-addressing of the host is completed
+	SchemaVersion  int      `json:"schemaVersion,omitempty"`
 }
 
 // AppendRule represents a function to append a rule to the NetworkingRule
 func (netrule *NetworkingRule) AppendRule(id, name, peerIP, selectedIP, peerScope, state string) {
-	CBLogger.Tracef("A rule: {%s, %s, %s, %s, %s, %s}", id, name, peerIP, selectedIP, peerScope, state)
 	if !netrule.Contain(id) { // If HostID doesn't exists, append rule
 		netrule.HostID = append(netrule.HostID, id)
 		netrule.HostName = append(netrule.HostName, name)
@@ -81,7 +28,6 @@ func (netrule *NetworkingRule) AppendRule(id, name, peerIP, selectedIP, peerScop
 
 // UpdateRule represents a function to update a rule to the NetworkingRule
 func (netrule *NetworkingRule) UpdateRule(id, name, peerIP, selectedIP, peerScope, state string) {
-	CBLogger.Tracef("A rule: {%s, %s, %s, %s, %s, %s}", id, name, peerIP, selectedIP, peerScope, state)
 	if netrule.Contain(id) { // If HostID exists, update rule
 		index := netrule.GetIndexOfHostID(id)
 		if name != "" {
@@ -93,12 +39,50 @@ func (netrule *NetworkingRule) UpdateRule(id, name, peerIP, selectedIP, peerScop
 		if peerScope != "" {
 			netrule.PeerScope[index] = peerScope
 		}
+		if peerIP != "" { // e.g., re-addressed
+			netrule.PeerIP[index] = peerIP
+		}
 		netrule.SelectedIP[index] = selectedIP
 	} else {
 		netrule.AppendRule(id, name, peerIP, selectedIP, peerScope, state)
 	}
 }
 
+// SetPreviousPeerIP represents a function to set the previous peer IP of the host, which is also routed to the host
+// until it is cleared (i.e., set to empty)
+func (netrule *NetworkingRule) SetPreviousPeerIP(id, previousPeerIP string) {
+	index := netrule.GetIndexOfHostID(id)
+	if index < 0 {
+		return
+	}
+	for len(netrule.PreviousPeerIP) <= index {
+		netrule.PreviousPeerIP = append(netrule.PreviousPeerIP, "")
+	}
+	netrule.PreviousPeerIP[index] = previousPeerIP
+}
+
+// RemoveRule represents a function to remove a rule of the host from the NetworkingRule
+func (netrule *NetworkingRule) RemoveRule(id string) {
+	index := netrule.GetIndexOfHostID(id)
+	if index < 0 {
+		return
+	}
+
+	remove := func(a []string) []string {
+		if index >= len(a) {
+			return a
+		}
+		return append(a[:index:index], a[index+1:]...)
+	}
+	netrule.HostID = remove(netrule.HostID)
+	netrule.HostName = remove(netrule.HostName)
+	netrule.PeerIP = remove(netrule.PeerIP)
+	netrule.SelectedIP = remove(netrule.SelectedIP)
+	netrule.PeerScope = remove(netrule.PeerScope)
+	netrule.State = remove(netrule.State)
+	netrule.PreviousPeerIP = remove(netrule.PreviousPeerIP)
+}
+
 // GetIndexOfHostID represents a function to find and return an index of HostID from NetworkingRule
 func (netrule NetworkingRule) GetIndexOfHostID(id string) int {
 	return netrule.find(netrule.HostID, id)
@@ -109,9 +93,13 @@ func (netrule NetworkingRule) GetIndexOfHostName(name string) int {
 	return netrule.find(netrule.HostName, name)
 }
 
-// GetIndexOfPeerIP represents a function to find and return an index of a peer IP address from NetworkingRule
+// GetIndexOfPeerIP represents a function to find and return an index of a peer IP address from NetworkingRule.
+// The previous peer IP of a re-addressed host is also found until it is cleared.
 func (netrule NetworkingRule) GetIndexOfPeerIP(hostIPAddress string) int {
-	return netrule.find(netrule.PeerIP, hostIPAddress)
+	if index := netrule.find(netrule.PeerIP, hostIPAddress); index >= 0 || hostIPAddress == "" {
+		return index
+	}
+	return netrule.find(netrule.PreviousPeerIP, hostIPAddress)
 }
 
 // GetIndexOfSelectedIP represents a function to find and return an index of a selected IP address from NetworkingRule
@@ -0,0 +1,53 @@
+package cbnet
+
+import "testing"
+
+func TestNetworkingRulePreviousPeerIP(t *testing.T) {
+	var rule NetworkingRule
+	rule.UpdateRule("host-01", "host-01", "10.77.0.2", "192.168.0.1", "private", "tunneling")
+	rule.UpdateRule("host-02", "host-02", "10.77.0.3", "192.168.0.2", "private", "tunneling")
+
+	// host-02 is re-addressed, and both IP addresses are routed to it during the overlap
+	peer := Peer{HostID: "host-02", IP: "10.78.0.3", IPv4CIDR: "10.78.0.3/24", PreviousIPv4CIDR: "10.77.0.3/24"}
+	rule.UpdateRule(peer.HostID, "", peer.IP, "192.168.0.2", "", "")
+	rule.SetPreviousPeerIP(peer.HostID, peer.PreviousIP())
+
+	for _, tt := range []struct {
+		ip   string
+		want int
+	}{
+		{"10.77.0.2", 0},
+		{"10.78.0.3", 1},
+		{"10.77.0.3", 1},
+		{"10.77.0.4", -1},
+		{"", -1}, // Not re-addressed peers have no previous IP
+	} {
+		if got := rule.GetIndexOfPeerIP(tt.ip); got != tt.want {
+			t.Errorf("GetIndexOfPeerIP(%q) during the overlap = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+
+	// The previous IP address is not routed after the re-addressing is completed
+	peer.PreviousIPv4CIDR = ""
+	rule.SetPreviousPeerIP(peer.HostID, peer.PreviousIP())
+	if got := rule.GetIndexOfPeerIP("10.77.0.3"); got != -1 {
+		t.Errorf("GetIndexOfPeerIP(previous) after the overlap = %v, want -1", got)
+	}
+
+	// The previous IP address is removed with the rule
+	rule.SetPreviousPeerIP("host-02", "10.77.0.3")
+	rule.RemoveRule("host-01")
+	if got := rule.GetIndexOfPeerIP("10.77.0.3"); got != 0 {
+		t.Errorf("GetIndexOfPeerIP(previous) after removing another rule = %v, want 0", got)
+	}
+	rule.RemoveRule("host-02")
+	if got := rule.GetIndexOfPeerIP("10.77.0.3"); got != -1 || len(rule.PreviousPeerIP) != 0 {
+		t.Errorf("GetIndexOfPeerIP(previous) after removing the rule = %v (%v), want -1", got, rule.PreviousPeerIP)
+	}
+
+	// A host not in the rule is ignored
+	rule.SetPreviousPeerIP("host-03", "10.77.0.4")
+	if len(rule.PreviousPeerIP) != 0 {
+		t.Errorf("PreviousPeerIP = %v, want empty", rule.PreviousPeerIP)
+	}
+}
@@ -0,0 +1,42 @@
+package cbnet
+
+import "time"
+
+const (
+	// CaptureRequested is the state of a packet capture requested to an agent
+	CaptureRequested = "requested"
+
+	// CaptureCompleted is the state of a packet capture whose files are uploaded
+	CaptureCompleted = "completed"
+
+	// CaptureFailed is the state of a packet capture which an agent failed to start
+	CaptureFailed = "failed"
+)
+
+const (
+	// CaptureInner is the layer of the inner packets (i.e., read from and written to the network interface, e.g., cbnet0)
+	CaptureInner = "inner"
+
+	// CaptureOuter is the layer of the outer packets (i.e., encapsulated in UDP, encrypted if enabled)
+	CaptureOuter = "outer"
+)
+
+// PacketCapture represents the metadata of a packet capture of an agent.
+// The captured packets of each layer are stored as a pcapng file separately.
+type PacketCapture struct {
+	CladnetID    string    `json:"cladnetId"`
+	HostID       string    `json:"hostId"`
+	CaptureID    string    `json:"captureId"`
+	Filter       string    `json:"filter"`
+	Duration     int64     `json:"duration"` // Requested period in seconds
+	MaxBytes     int       `json:"maxBytes"` // Size cap of the inner and outer files in total
+	State        string    `json:"state"`
+	Message      string    `json:"message"` // e.g., the reason why the capture stopped or failed
+	InnerPackets int       `json:"innerPackets"`
+	OuterPackets int       `json:"outerPackets"`
+	InnerBytes   int       `json:"innerBytes"` // Size of the inner file
+	OuterBytes   int       `json:"outerBytes"` // Size of the outer file
+	RequestedAt  time.Time `json:"requestedAt"`
+	StartedAt    time.Time `json:"startedAt"`
+	StoppedAt    time.Time `json:"stoppedAt"`
+}
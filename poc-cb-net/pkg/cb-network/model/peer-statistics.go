@@ -0,0 +1,80 @@
+package cbnet
+
+import "time"
+
+const (
+	// DropNoRoute is the reason of the packets dropped for lack of a networking rule to the destination
+	DropNoRoute = "no_route"
+
+	// DropNoPublicKey is the reason of the packets dropped for lack of the public key of the peer
+	DropNoPublicKey = "no_public_key"
+
+	// DropEncryptError is the reason of the packets dropped by a failure to encrypt
+	DropEncryptError = "encrypt_error"
+
+	// DropDecryptError is the reason of the packets dropped by a failure to decrypt
+	DropDecryptError = "decrypt_error"
+
+	// DropSendError is the reason of the packets dropped by a failure to send to the peer
+	DropSendError = "send_error"
+
+	// DropWriteError is the reason of the packets dropped by a failure to write to the network interface
+	DropWriteError = "write_error"
+)
+
+// TrafficCounters represents the counters of the traffic through the tunnel.
+type TrafficCounters struct {
+	TxPackets     uint64 `json:"txPackets"`
+	TxBytes       uint64 `json:"txBytes"`
+	RxPackets     uint64 `json:"rxPackets"`
+	RxBytes       uint64 `json:"rxBytes"`
+	Drops         uint64 `json:"drops"`
+	EncryptErrors uint64 `json:"encryptErrors"`
+	DecryptErrors uint64 `json:"decryptErrors"`
+}
+
+// Add represents a function to add the other counters
+func (counters *TrafficCounters) Add(other TrafficCounters) {
+	counters.TxPackets += other.TxPackets
+	counters.TxBytes += other.TxBytes
+	counters.RxPackets += other.RxPackets
+	counters.RxBytes += other.RxBytes
+	counters.Drops += other.Drops
+	counters.EncryptErrors += other.EncryptErrors
+	counters.DecryptErrors += other.DecryptErrors
+}
+
+// PeerStatistics represents the traffic statistics of a peer, which is published periodically by its agent.
+// The counters are cumulative since the agent started.
+type PeerStatistics struct {
+	CladnetID string                     `json:"cladnetId"`
+	HostID    string                     `json:"hostId"`
+	Peers     map[string]TrafficCounters `json:"peers"` // By the host ID of each remote peer
+	Drops     map[string]uint64          `json:"drops"` // By reason (e.g., no_route)
+	Total     TrafficCounters            `json:"total"`
+	Timestamp time.Time                  `json:"timestamp"`
+}
+
+// CLADNetStatistics represents the traffic statistics of all peers in a CLADNet.
+type CLADNetStatistics struct {
+	CladnetID string            `json:"cladnetId"`
+	Peers     []PeerStatistics  `json:"peers"`
+	Drops     map[string]uint64 `json:"drops"` // By reason
+	Total     TrafficCounters   `json:"total"`
+}
+
+// NewCLADNetStatistics represents a function to sum up the statistics of the peers in a CLADNet
+func NewCLADNetStatistics(cladnetID string, peers []PeerStatistics) CLADNetStatistics {
+	statistics := CLADNetStatistics{
+		CladnetID: cladnetID,
+		Peers:     peers,
+		Drops:     make(map[string]uint64),
+	}
+	for _, peer := range peers {
+		statistics.Total.Add(peer.Total)
+		for reason, drops := range peer.Drops {
+			statistics.Drops[reason] += drops
+		}
+	}
+	return statistics
+}
@@ -1,17 +1,29 @@
 package cbnet
 
+import "strings"
+
 // Peer represents a host participating in a cloud adaptive network.
 type Peer struct {
-	CladnetID           string           `json:"cladnetId"`
-	HostID              string           `json:"hostId"`
-	HostName            string           `json:"hostName"`
-	HostPrivateIPv4CIDR string           `json:"hostPrivateIpv4Cidr"`
-	HostPrivateIP       string           `json:"hostPrivateIp"`
-	HostPublicIP        string           `json:"hostPublicIp"`
-	IPv4CIDR            string           `json:"ipv4Cidr"`
-	IP                  string           `json:"ip"`
-	State               string           `json:"state"`
-	Details             CloudInformation `json:"details"`
+	CladnetID               string            `json:"cladnetId"`
+	HostID                  string            `json:"hostId"`
+	HostName                string            `json:"hostName"`
+	HostPrivateIPv4CIDR     string            `json:"hostPrivateIpv4Cidr"`
+	HostPrivateIP           string            `json:"hostPrivateIp"`
+	HostPublicIP            string            `json:"hostPublicIp"`
+	IPv4CIDR                string            `json:"ipv4Cidr"`
+	IP                      string            `json:"ip"`
+	State                   string            `json:"state"`
+	Details                 CloudInformation  `json:"details"`
+	PreviousIPv4CIDR        string            `json:"previousIpv4Cidr,omitempty"`
+	PreviousIPv4CIDROverlap int64             `json:"previousIpv4CidrOverlap,omitempty"` // Seconds to keep the previous IPv4 CIDR while re-addressing
+	SchemaVersion           int               `json:"schemaVersion,omitempty"`
+	TraceContext            map[string]string `json:"traceContext,omitempty"` // Trace context of the workflow which put the peer
+}
+
+// PreviousIP returns the IP address of the previous IPv4 CIDR (empty if the peer is not being re-addressed)
+func (peer Peer) PreviousIP() string {
+	ip, _, _ := strings.Cut(peer.PreviousIPv4CIDR, "/")
+	return ip
 }
 
 // Peers represents a list of peers.
@@ -21,9 +33,9 @@ type Peers struct {
 
 // CloudInformation represents cloud information.
 type CloudInformation struct {
-	ProviderName       string `json:"providerName"`
-	RegionID           string `json:"regionId"`
-	AvailabilityZoneID string `json:"availabilityZoneId"`
-	VirtualNetworkID   string `json:"virtualNetworkId"`
-	SubnetID           string `json:"subnetId"`
+	ProviderName       string `json:"providerName" yaml:"provider_name"`
+	RegionID           string `json:"regionId" yaml:"region_id"`
+	AvailabilityZoneID string `json:"availabilityZoneId" yaml:"availability_zone_id"`
+	VirtualNetworkID   string `json:"virtualNetworkId" yaml:"virtual_network_id"`
+	SubnetID           string `json:"subnetId" yaml:"subnet_id"`
 }
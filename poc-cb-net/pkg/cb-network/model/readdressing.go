@@ -0,0 +1,58 @@
+package cbnet
+
+import "time"
+
+const (
+	// ReaddressingAssigned is the state of a peer assigned a new IP address
+	ReaddressingAssigned = "assigned"
+
+	// ReaddressingOverlapping is the state of a peer having both the previous and the new IP addresses
+	ReaddressingOverlapping = "overlapping"
+
+	// ReaddressingCompleted is the state of a peer having only the new IP address
+	ReaddressingCompleted = "completed"
+)
+
+// PeerReaddressing represents the progress of re-addressing a peer.
+type PeerReaddressing struct {
+	HostID      string    `json:"hostId"`
+	OldIPv4CIDR string    `json:"oldIpv4Cidr"`
+	NewIPv4CIDR string    `json:"newIpv4Cidr"`
+	State       string    `json:"state"`
+	Message     string    `json:"message,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// CLADNetReaddressing represents the progress of changing the IPv4 address space of a CLADNet.
+type CLADNetReaddressing struct {
+	CladnetID           string             `json:"cladnetId"`
+	OldIpv4AddressSpace string             `json:"oldIpv4AddressSpace"`
+	NewIpv4AddressSpace string             `json:"newIpv4AddressSpace"`
+	OverlapSeconds      int64              `json:"overlapSeconds"`
+	StartedAt           time.Time          `json:"startedAt"`
+	Peers               []PeerReaddressing `json:"peers"`
+}
+
+// IsCompleted represents a function to check if all peers have been re-addressed
+func (readdressing CLADNetReaddressing) IsCompleted() bool {
+	for _, peer := range readdressing.Peers {
+		if peer.State != ReaddressingCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdatePeerState represents a function to update the state of a peer.
+// It returns false if the peer is not being re-addressed.
+func (readdressing *CLADNetReaddressing) UpdatePeerState(hostID string, state string, message string, now time.Time) bool {
+	for i := range readdressing.Peers {
+		if readdressing.Peers[i].HostID == hostID {
+			readdressing.Peers[i].State = state
+			readdressing.Peers[i].Message = message
+			readdressing.Peers[i].UpdatedAt = now
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package cbnet
+
+import "time"
+
+const (
+	// SecretActionRegister represents that a host registers its first public key
+	SecretActionRegister = "register"
+	// SecretActionRotate represents that a host replaces its public key with a new one
+	SecretActionRotate = "rotate"
+	// SecretActionRotationRequest represents that key rotation is requested to a host
+	SecretActionRotationRequest = "rotation-request"
+	// SecretActionRevoke represents that a public key of a host is revoked
+	SecretActionRevoke = "revoke"
+	// SecretActionReject represents that a revoked public key is rejected
+	SecretActionReject = "reject"
+)
+
+// SecretAuditRecord represents an audit record of a change on the secrets (RSA public keys) of a Cloud Adaptive Network
+type SecretAuditRecord struct {
+	CladnetID   string    `json:"cladnetId"`
+	HostID      string    `json:"hostId"`
+	Action      string    `json:"action"`
+	Fingerprint string    `json:"fingerprint"`
+	Actor       string    `json:"actor"`
+	Reason      string    `json:"reason"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// SecretRevocation represents a revoked public key of a host
+type SecretRevocation struct {
+	CladnetID   string    `json:"cladnetId"`
+	HostID      string    `json:"hostId"`
+	Fingerprint string    `json:"fingerprint"`
+	Reason      string    `json:"reason"`
+	RevokedAt   time.Time `json:"revokedAt"`
+}
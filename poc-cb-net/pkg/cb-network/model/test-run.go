@@ -0,0 +1,16 @@
+package cbnet
+
+import "time"
+
+// TestRun represents a test requested to the agents in a CLADNet at once.
+// Each agent's result (NetworkStatus) is retained with the run ID until the retention period expires.
+type TestRun struct {
+	CladnetID   string    `json:"cladnetId"`
+	RunID       string    `json:"runId"`
+	TestType    string    `json:"testType"`
+	TestSpec    string    `json:"testSpec"`
+	Label       string    `json:"label,omitempty"`
+	RuleType    string    `json:"ruleType,omitempty"` // Rule type of the CLADNet when the test was requested
+	HostIDs     []string  `json:"hostIds"`            // Host IDs of the targeted agents
+	RequestedAt time.Time `json:"requestedAt"`
+}
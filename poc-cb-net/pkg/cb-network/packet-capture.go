@@ -0,0 +1,272 @@
+package cbnet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	capturefilter "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/capture-filter"
+	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/logger"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+)
+
+// ErrCaptureInProgress is returned if a packet capture is requested while another one is in progress
+var ErrCaptureInProgress = errors.New("another packet capture is in progress")
+
+// Directions of the captured packets
+const (
+	outbound = iota
+	inbound
+)
+
+// PacketCaptureResult represents the pcapng files and the summary of a finished packet capture
+type PacketCaptureResult struct {
+	Inner        []byte    // pcapng file of the inner packets
+	Outer        []byte    // pcapng file of the outer packets (with the synthesized IP/UDP headers)
+	InnerPackets int       // Number of the inner packets
+	OuterPackets int       // Number of the outer packets
+	StartedAt    time.Time // Time when the capture started
+	StoppedAt    time.Time // Time when the capture stopped
+	Reason       string    // Reason why the capture stopped
+}
+
+// pcapngFile represents a pcapng file being written in memory
+type pcapngFile struct {
+	buffer  bytes.Buffer
+	writer  *pcapgo.NgWriter
+	packets int
+}
+
+// newPcapngFile writes the section header and the interface description of a pcapng file
+func newPcapngFile(name string, description string, filter string) (*pcapngFile, error) {
+	file := new(pcapngFile)
+
+	intf := pcapgo.DefaultNgInterface
+	intf.Name = name
+	intf.Description = description
+	intf.Filter = filter
+	intf.LinkType = layers.LinkTypeRaw
+
+	options := pcapgo.DefaultNgWriterOptions
+	options.SectionInfo.Application = "cb-network agent"
+
+	writer, err := pcapgo.NewNgWriterInterface(&file.buffer, intf, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.Flush(); err != nil {
+		return nil, err
+	}
+	file.writer = writer
+	return file, nil
+}
+
+// packetCapture represents an ongoing capture of the packets through the tunnel.
+// The encapsulation and decapsulation check it by an atomic load, so it costs nothing else while no capture is in progress.
+type packetCapture struct {
+	filter    *capturefilter.Filter
+	maxBytes  int                      // Size cap of the inner and outer files in total
+	size      int                      // Current size of the inner and outer files in total
+	localIPv4 net.IP                   // Underlay address of this host for the outer packets
+	localIPv6 net.IP                   // Underlay address of this host for the outer packets
+	port      int                      // Port used for tunneling
+	inner     *pcapngFile              // Inner packets
+	outer     *pcapngFile              // Outer packets
+	startedAt time.Time                // Time when the capture started
+	isStopped bool                     // Status if the capture is stopped or not
+	mutex     sync.Mutex               // Mutex for the files
+	timer     *time.Timer              // Timer to stop the capture
+	result    chan PacketCaptureResult // Channel to deliver the result
+}
+
+// CapturePackets represents a function to capture the inner packets (i.e., read from and written to the network interface)
+// and the outer packets (i.e., sent and received through the UDP socket) to and from the peers into the pcapng files in memory.
+// The packets are selected by the filter on the inner packets, so the outer packets of the selected inner packets are captured.
+// The capture stops when the duration elapses or the files reach the size cap, and then the result is delivered by the channel.
+// The duration and the size cap are limited like TracePackets (see cmdtype.MaxPacketCaptureDuration and cmdtype.MaxPacketCaptureBytes).
+func (cbnetwork *CBNetwork) CapturePackets(filter *capturefilter.Filter, duration time.Duration, maxBytes int) (<-chan PacketCaptureResult, error) {
+	if duration <= 0 {
+		duration = cmdtype.DefaultPacketCaptureDuration
+	}
+	if duration > cmdtype.MaxPacketCaptureDuration {
+		duration = cmdtype.MaxPacketCaptureDuration
+	}
+	if maxBytes <= 0 {
+		maxBytes = cmdtype.DefaultPacketCaptureBytes
+	}
+	if maxBytes > cmdtype.MaxPacketCaptureBytes {
+		maxBytes = cmdtype.MaxPacketCaptureBytes
+	}
+
+	inner, err := newPcapngFile(cbnetwork.name, "inner packets of cb-network", filter.String())
+	if err != nil {
+		return nil, err
+	}
+	outer, err := newPcapngFile(fmt.Sprintf("udp:%d", cbnetwork.port), "outer packets of cb-network (encapsulated)", filter.String())
+	if err != nil {
+		return nil, err
+	}
+
+	capture := &packetCapture{
+		filter:    filter,
+		maxBytes:  maxBytes,
+		size:      inner.buffer.Len() + outer.buffer.Len(),
+		localIPv4: net.ParseIP(cbnetwork.underlayIPv4.IP).To4(),
+		localIPv6: net.ParseIP(cbnetwork.underlayIPv6.IP),
+		port:      cbnetwork.port,
+		inner:     inner,
+		outer:     outer,
+		startedAt: time.Now(),
+		result:    make(chan PacketCaptureResult, 1),
+	}
+
+	// Hold the lock so that the timer doesn't stop the capture before it is set
+	capture.mutex.Lock()
+	defer capture.mutex.Unlock()
+
+	if !cbnetwork.packetCapture.CompareAndSwap(nil, capture) {
+		return nil, ErrCaptureInProgress
+	}
+	capture.timer = time.AfterFunc(duration, func() {
+		cbnetwork.stopCapture(capture, fmt.Sprintf("the duration (%v) elapsed", duration))
+	})
+
+	cbnetwork.logger.Infof("Packet capture is started for %v (up to %d bytes, filter: %q)", duration, maxBytes, filter.String())
+	return capture.result, nil
+}
+
+// StopCapturingPackets represents a function to stop the packet capture in progress, if any, before the duration elapses
+func (cbnetwork *CBNetwork) StopCapturingPackets() {
+	if capture := cbnetwork.packetCapture.Load(); capture != nil {
+		cbnetwork.stopCapture(capture, "stopped on demand")
+	}
+}
+
+// stopCapture stops a capture and delivers the result once
+func (cbnetwork *CBNetwork) stopCapture(capture *packetCapture, reason string) {
+	capture.mutex.Lock()
+	defer capture.mutex.Unlock()
+
+	if capture.isStopped {
+		return
+	}
+	capture.isStopped = true
+	capture.timer.Stop()
+	cbnetwork.packetCapture.CompareAndSwap(capture, nil)
+
+	for _, file := range []*pcapngFile{capture.inner, capture.outer} {
+		if err := file.writer.Flush(); err != nil {
+			cbnetwork.logger.Error("could not flush the packet capture: ", err)
+		}
+	}
+
+	cbnetwork.logger.Infof("Packet capture is stopped (%v): %d inner and %d outer packets",
+		reason, capture.inner.packets, capture.outer.packets)
+
+	capture.result <- PacketCaptureResult{
+		Inner:        capture.inner.buffer.Bytes(),
+		Outer:        capture.outer.buffer.Bytes(),
+		InnerPackets: capture.inner.packets,
+		OuterPackets: capture.outer.packets,
+		StartedAt:    capture.startedAt,
+		StoppedAt:    time.Now(),
+		Reason:       reason,
+	}
+	close(capture.result)
+}
+
+// capturePacket writes an inner packet and its outer packet (i.e., the UDP payload to or from the remote address)
+// if a capture is in progress and the inner packet matches the filter
+func (cbnetwork *CBNetwork) capturePacket(direction int, peer string, innerPacket []byte, outerPayload []byte, remoteAddr *net.UDPAddr) {
+	capture := cbnetwork.packetCapture.Load()
+	if capture == nil || !capture.filter.Match(capturefilter.Decode(peer, innerPacket)) {
+		return
+	}
+
+	outerPacket, err := capture.buildOuterPacket(direction, outerPayload, remoteAddr)
+	if err != nil {
+		cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Error("could not build the outer packet: ", err)
+		return
+	}
+
+	capture.mutex.Lock()
+	if capture.isStopped {
+		capture.mutex.Unlock()
+		return
+	}
+
+	// An enhanced packet block has 32 bytes and the packet padded to 32 bits
+	blockSize := func(packet []byte) int { return 32 + (len(packet)+3)&^3 }
+	if capture.size+blockSize(innerPacket)+blockSize(outerPacket) > capture.maxBytes {
+		capture.mutex.Unlock()
+		cbnetwork.stopCapture(capture, fmt.Sprintf("the size cap (%d bytes) reached", capture.maxBytes))
+		return
+	}
+
+	now := time.Now()
+	for _, item := range []struct {
+		file   *pcapngFile
+		packet []byte
+	}{{capture.inner, innerPacket}, {capture.outer, outerPacket}} {
+		ci := gopacket.CaptureInfo{Timestamp: now, CaptureLength: len(item.packet), Length: len(item.packet)}
+		if err := item.file.writer.WritePacket(ci, item.packet); err != nil {
+			cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Error("could not write the packet capture: ", err)
+			continue
+		}
+		item.file.packets++
+		capture.size += blockSize(item.packet)
+	}
+	capture.mutex.Unlock()
+}
+
+// buildOuterPacket synthesizes the IP and UDP headers of a UDP payload, which are not exposed by the UDP socket
+func (capture *packetCapture) buildOuterPacket(direction int, payload []byte, remoteAddr *net.UDPAddr) ([]byte, error) {
+	localPort, remotePort := layers.UDPPort(capture.port), layers.UDPPort(remoteAddr.Port)
+
+	var networkLayer gopacket.NetworkLayer
+	if remoteIPv4 := remoteAddr.IP.To4(); remoteIPv4 != nil {
+		ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: capture.localIPv4, DstIP: remoteIPv4}
+		if direction == inbound {
+			ip.SrcIP, ip.DstIP = ip.DstIP, ip.SrcIP
+		}
+		ip.SrcIP, ip.DstIP = orUnspecified(ip.SrcIP, net.IPv4zero), orUnspecified(ip.DstIP, net.IPv4zero)
+		networkLayer = ip
+	} else {
+		ip := &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: layers.IPProtocolUDP, SrcIP: capture.localIPv6, DstIP: remoteAddr.IP}
+		if direction == inbound {
+			ip.SrcIP, ip.DstIP = ip.DstIP, ip.SrcIP
+		}
+		ip.SrcIP, ip.DstIP = orUnspecified(ip.SrcIP, net.IPv6unspecified), orUnspecified(ip.DstIP, net.IPv6unspecified)
+		networkLayer = ip
+	}
+
+	udp := &layers.UDP{SrcPort: localPort, DstPort: remotePort}
+	if direction == inbound {
+		udp.SrcPort, udp.DstPort = remotePort, localPort
+	}
+	if err := udp.SetNetworkLayerForChecksum(networkLayer); err != nil {
+		return nil, err
+	}
+
+	buffer := gopacket.NewSerializeBuffer()
+	options := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	err := gopacket.SerializeLayers(buffer, options, networkLayer.(gopacket.SerializableLayer), udp, gopacket.Payload(payload))
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// orUnspecified returns the unspecified address if an IP is unknown (e.g., the underlay address is not detected)
+func orUnspecified(ip net.IP, unspecified net.IP) net.IP {
+	if ip == nil {
+		return unspecified
+	}
+	return ip
+}
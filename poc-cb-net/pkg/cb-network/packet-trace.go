@@ -0,0 +1,93 @@
+package cbnet
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/logger"
+	"golang.org/x/net/ipv4"
+)
+
+// packetTrace represents the temporary tracing of the packets through the tunnel.
+// The encapsulation and decapsulation check it without a lock, so it costs an atomic load while disabled.
+type packetTrace struct {
+	expiresAt atomic.Int64 // Unix time (ns) when the tracing expires (0 if disabled)
+	peer      atomic.Value // Host ID of the remote peer to be traced (all peers if empty)
+	mutex     sync.Mutex   // Mutex for the timer
+	timer     *time.Timer  // Timer to switch off the tracing
+}
+
+// isEnabledFor checks if the packets to or from the peer are traced
+func (trace *packetTrace) isEnabledFor(peer string) bool {
+	expiresAt := trace.expiresAt.Load()
+	if expiresAt == 0 || time.Now().UnixNano() >= expiresAt {
+		return false
+	}
+	target, _ := trace.peer.Load().(string)
+	return target == "" || target == peer
+}
+
+// TracePackets represents a function to log the parsed headers of the packets to and from a peer
+// (all peers if the peer is empty) for a bounded period. The tracing switches itself off when the period expires.
+// The period is cmdtype.DefaultPacketTraceDuration if it is not positive, and is limited to cmdtype.MaxPacketTraceDuration.
+func (cbnetwork *CBNetwork) TracePackets(peer string, duration time.Duration) time.Duration {
+	if duration <= 0 {
+		duration = cmdtype.DefaultPacketTraceDuration
+	}
+	if duration > cmdtype.MaxPacketTraceDuration {
+		duration = cmdtype.MaxPacketTraceDuration
+	}
+
+	trace := cbnetwork.packetTrace
+	trace.mutex.Lock()
+	defer trace.mutex.Unlock()
+
+	trace.peer.Store(peer)
+	expiresAt := time.Now().Add(duration).UnixNano()
+	trace.expiresAt.Store(expiresAt)
+
+	// Replace the timer of the previous tracing, if any
+	if trace.timer != nil {
+		trace.timer.Stop()
+	}
+	trace.timer = time.AfterFunc(duration, func() {
+		if trace.expiresAt.CompareAndSwap(expiresAt, 0) {
+			cbnetwork.logger.Info("Packet tracing is switched off (expired)")
+		}
+	})
+
+	cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Infof("Packet tracing is switched on for %v", duration)
+	return duration
+}
+
+// StopTracingPackets represents a function to switch off the packet tracing before the period expires
+func (cbnetwork *CBNetwork) StopTracingPackets() {
+	trace := cbnetwork.packetTrace
+	trace.mutex.Lock()
+	defer trace.mutex.Unlock()
+
+	if trace.timer != nil {
+		trace.timer.Stop()
+		trace.timer = nil
+	}
+	if trace.expiresAt.Swap(0) != 0 {
+		cbnetwork.logger.Info("Packet tracing is switched off")
+	}
+}
+
+// tracePacket logs the parsed header of a packet if the packets to or from the peer are traced
+func (cbnetwork *CBNetwork) tracePacket(direction string, peer string, packet []byte) {
+	if !cbnetwork.packetTrace.isEnabledFor(peer) {
+		return
+	}
+
+	header, err := ipv4.ParseHeader(packet)
+	if err != nil {
+		cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Infof("[%s] %d bytes (unparsable header: %v)", direction, len(packet), err)
+		return
+	}
+	cbnetwork.logger.WithFields(logger.Fields{logger.Peer: peer}).Infof("[%s] %d bytes, %v -> %v, protocol: %v, ttl: %v, id: %v",
+		direction, len(packet), header.Src, header.Dst, header.Protocol, header.TTL, header.ID)
+}
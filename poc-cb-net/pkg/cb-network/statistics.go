@@ -0,0 +1,150 @@
+package cbnet
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+)
+
+// peerCounters represents the counters of the traffic to and from a peer, which are updated atomically
+// by the encapsulation and decapsulation without a lock.
+type peerCounters struct {
+	txPackets     atomic.Uint64
+	txBytes       atomic.Uint64
+	rxPackets     atomic.Uint64
+	rxBytes       atomic.Uint64
+	drops         atomic.Uint64
+	encryptErrors atomic.Uint64
+	decryptErrors atomic.Uint64
+
+	lastErrorLog atomic.Int64 // Unix time in nanoseconds when an error of the peer was logged last
+}
+
+func (counters *peerCounters) snapshot() model.TrafficCounters {
+	return model.TrafficCounters{
+		TxPackets:     counters.txPackets.Load(),
+		TxBytes:       counters.txBytes.Load(),
+		RxPackets:     counters.rxPackets.Load(),
+		RxBytes:       counters.rxBytes.Load(),
+		Drops:         counters.drops.Load(),
+		EncryptErrors: counters.encryptErrors.Load(),
+		DecryptErrors: counters.decryptErrors.Load(),
+	}
+}
+
+// errorLogInterval is the minimum interval to log the per-packet errors of a peer.
+// The errors in the meantime are counted in the traffic statistics only.
+const errorLogInterval = 10 * time.Second
+
+// trafficStatistics represents the traffic counters by peer (host ID) and the dropped packets by reason
+type trafficStatistics struct {
+	peers sync.Map // map[string]*peerCounters
+	drops sync.Map // map[string]*atomic.Uint64
+}
+
+func newTrafficStatistics() *trafficStatistics {
+	return &trafficStatistics{}
+}
+
+func (statistics *trafficStatistics) peer(hostID string) *peerCounters {
+	if counters, ok := statistics.peers.Load(hostID); ok {
+		return counters.(*peerCounters)
+	}
+	counters, _ := statistics.peers.LoadOrStore(hostID, &peerCounters{})
+	return counters.(*peerCounters)
+}
+
+func (statistics *trafficStatistics) drop(reason string) {
+	counter, ok := statistics.drops.Load(reason)
+	if !ok {
+		counter, _ = statistics.drops.LoadOrStore(reason, new(atomic.Uint64))
+	}
+	counter.(*atomic.Uint64).Add(1)
+}
+
+// shouldLogError reports whether a per-packet error of a peer is logged,
+// which is true at most once per errorLogInterval by peer.
+func (statistics *trafficStatistics) shouldLogError(peer string, now time.Time) bool {
+	counters := statistics.peer(peer)
+	last := counters.lastErrorLog.Load()
+	if last != 0 && now.UnixNano()-last < int64(errorLogInterval) {
+		return false
+	}
+	return counters.lastErrorLog.CompareAndSwap(last, now.UnixNano())
+}
+
+// countSent counts a packet sent to a peer
+func (cbnetwork *CBNetwork) countSent(peer string, bytes int) {
+	counters := cbnetwork.statistics.peer(peer)
+	counters.txPackets.Add(1)
+	counters.txBytes.Add(uint64(bytes))
+
+	txPackets.WithLabelValues(peer).Inc()
+	txBytes.WithLabelValues(peer).Add(float64(bytes))
+}
+
+// countReceived counts a packet received from a peer
+func (cbnetwork *CBNetwork) countReceived(peer string, bytes int) {
+	counters := cbnetwork.statistics.peer(peer)
+	counters.rxPackets.Add(1)
+	counters.rxBytes.Add(uint64(bytes))
+
+	rxPackets.WithLabelValues(peer).Inc()
+	rxBytes.WithLabelValues(peer).Add(float64(bytes))
+}
+
+// countDrop counts a dropped packet by reason. The peer is empty if the packet has no route to any peer.
+func (cbnetwork *CBNetwork) countDrop(peer string, reason string) {
+	if peer != "" {
+		cbnetwork.statistics.peer(peer).drops.Add(1)
+	}
+	cbnetwork.statistics.drop(reason)
+
+	droppedPackets.WithLabelValues(reason).Inc()
+}
+
+// countEncryptError counts a packet dropped by a failure to encrypt
+func (cbnetwork *CBNetwork) countEncryptError(peer string) {
+	cbnetwork.statistics.peer(peer).encryptErrors.Add(1)
+	encryptFailures.WithLabelValues(peer).Inc()
+
+	cbnetwork.countDrop(peer, model.DropEncryptError)
+}
+
+// countDecryptError counts a packet dropped by a failure to decrypt
+func (cbnetwork *CBNetwork) countDecryptError(peer string) {
+	cbnetwork.statistics.peer(peer).decryptErrors.Add(1)
+	decryptFailures.WithLabelValues(peer).Inc()
+
+	cbnetwork.countDrop(peer, model.DropDecryptError)
+}
+
+// GetPeerStatistics represents a function to get a snapshot of the traffic statistics of this peer
+func (cbnetwork *CBNetwork) GetPeerStatistics() model.PeerStatistics {
+	statistics := model.PeerStatistics{
+		CladnetID: cbnetwork.CLADNetID,
+		HostID:    cbnetwork.HostID,
+		Peers:     make(map[string]model.TrafficCounters),
+		Drops:     make(map[string]uint64),
+		Timestamp: time.Now(),
+	}
+
+	cbnetwork.statistics.peers.Range(func(key, value interface{}) bool {
+		counters := value.(*peerCounters).snapshot()
+		statistics.Peers[key.(string)] = counters
+		statistics.Total.Add(counters)
+		return true
+	})
+
+	cbnetwork.statistics.drops.Range(func(key, value interface{}) bool {
+		statistics.Drops[key.(string)] = value.(*atomic.Uint64).Load()
+		return true
+	})
+
+	// Count the packets dropped for lack of a route, which belong to no peer
+	statistics.Total.Drops += statistics.Drops[model.DropNoRoute]
+
+	return statistics
+}
@@ -0,0 +1,28 @@
+package cbnet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShouldLogError(t *testing.T) {
+	statistics := newTrafficStatistics()
+	now := time.Now()
+
+	for _, tt := range []struct {
+		peer string
+		at   time.Time
+		want bool
+	}{
+		{"host-01", now, true},
+		{"host-01", now.Add(time.Second), false},
+		{"host-02", now.Add(time.Second), true}, // By peer
+		{"host-01", now.Add(errorLogInterval - time.Nanosecond), false},
+		{"host-01", now.Add(errorLogInterval), true},
+		{"host-01", now.Add(errorLogInterval + time.Second), false},
+	} {
+		if got := statistics.shouldLogError(tt.peer, tt.at); got != tt.want {
+			t.Errorf("shouldLogError(%v) after %v = %v, want %v", tt.peer, tt.at.Sub(now), got, tt.want)
+		}
+	}
+}
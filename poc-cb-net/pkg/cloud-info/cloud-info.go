@@ -0,0 +1,306 @@
+package cloudinfo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+)
+
+// Names of the cloud service providers (used by the cost-prioritized rule)
+const (
+	AWS     = "aws"
+	GCP     = "gcp"
+	Azure   = "azure"
+	Alibaba = "alibaba"
+)
+
+// DefaultTimeout is the default time to wait for a metadata service to answer
+const DefaultTimeout = 2 * time.Second
+
+// ErrUnknownCloud is returned if no detector recognizes the cloud of this host
+var ErrUnknownCloud = errors.New("could not detect the cloud information")
+
+// Detector represents a detector of the cloud information by the instance metadata service of a provider
+type Detector interface {
+	ProviderName() string
+	Detect(ctx context.Context) (model.CloudInformation, error)
+}
+
+// DefaultDetectors returns the detectors of AWS, GCP, Azure and Alibaba Cloud with the default endpoints
+func DefaultDetectors() []Detector {
+	return []Detector{
+		AWSDetector{Endpoint: "http://169.254.169.254"},
+		GCPDetector{Endpoint: "http://metadata.google.internal"},
+		AzureDetector{Endpoint: "http://169.254.169.254"},
+		AlibabaDetector{Endpoint: "http://100.100.100.200"},
+	}
+}
+
+// Detect represents a function to detect the cloud information by the detectors concurrently, each with the timeout.
+// The preceding detector is preferred if more than one recognize the cloud.
+func Detect(ctx context.Context, detectors []Detector, timeout time.Duration) (model.CloudInformation, error) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	type result struct {
+		cloudInformation model.CloudInformation
+		err              error
+	}
+
+	results := make([]result, len(detectors))
+	var wg sync.WaitGroup
+	for i, detector := range detectors {
+		wg.Add(1)
+		go func(i int, detector Detector) {
+			defer wg.Done()
+			detectorCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			cloudInformation, err := detector.Detect(detectorCtx)
+			results[i] = result{cloudInformation: cloudInformation, err: err}
+		}(i, detector)
+	}
+	wg.Wait()
+
+	var errs []string
+	for i, r := range results {
+		if r.err == nil {
+			return r.cloudInformation, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", detectors[i].ProviderName(), r.err))
+	}
+	return model.CloudInformation{}, fmt.Errorf("%w (%s)", ErrUnknownCloud, strings.Join(errs, ", "))
+}
+
+// Override represents a function to override the detected cloud information by the non-empty fields of the configured one
+func Override(detected model.CloudInformation, configured model.CloudInformation) model.CloudInformation {
+	overridden := detected
+	if configured.ProviderName != "" {
+		overridden.ProviderName = configured.ProviderName
+	}
+	if configured.RegionID != "" {
+		overridden.RegionID = configured.RegionID
+	}
+	if configured.AvailabilityZoneID != "" {
+		overridden.AvailabilityZoneID = configured.AvailabilityZoneID
+	}
+	if configured.VirtualNetworkID != "" {
+		overridden.VirtualNetworkID = configured.VirtualNetworkID
+	}
+	if configured.SubnetID != "" {
+		overridden.SubnetID = configured.SubnetID
+	}
+	return overridden
+}
+
+// getMetadata requests a metadata and returns the trimmed response body
+func getMetadata(ctx context.Context, method string, url string, header map[string]string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return "", err
+	}
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status (%v) of %v", resp.Status, url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// AWSDetector detects the cloud information by the instance metadata service (IMDSv2) of AWS
+type AWSDetector struct {
+	Endpoint string
+}
+
+// ProviderName returns the name of the provider
+func (detector AWSDetector) ProviderName() string {
+	return AWS
+}
+
+// Detect requests the region, the availability zone, and the VPC and subnet of the primary network interface
+func (detector AWSDetector) Detect(ctx context.Context) (model.CloudInformation, error) {
+	token, err := getMetadata(ctx, http.MethodPut, detector.Endpoint+"/latest/api/token",
+		map[string]string{"X-aws-ec2-metadata-token-ttl-seconds": "60"})
+	if err != nil {
+		return model.CloudInformation{}, err
+	}
+	header := map[string]string{"X-aws-ec2-metadata-token": token}
+
+	get := func(path string) (string, error) {
+		return getMetadata(ctx, http.MethodGet, detector.Endpoint+"/latest/meta-data/"+path, header)
+	}
+
+	cloudInformation := model.CloudInformation{ProviderName: AWS}
+	if cloudInformation.RegionID, err = get("placement/region"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	if cloudInformation.AvailabilityZoneID, err = get("placement/availability-zone"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	mac, err := get("mac")
+	if err != nil {
+		return model.CloudInformation{}, err
+	}
+	if cloudInformation.VirtualNetworkID, err = get("network/interfaces/macs/" + mac + "/vpc-id"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	if cloudInformation.SubnetID, err = get("network/interfaces/macs/" + mac + "/subnet-id"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	return cloudInformation, nil
+}
+
+// GCPDetector detects the cloud information by the metadata server of GCP
+type GCPDetector struct {
+	Endpoint string
+}
+
+// ProviderName returns the name of the provider
+func (detector GCPDetector) ProviderName() string {
+	return GCP
+}
+
+// Detect requests the zone, and the network and subnetwork of the primary network interface.
+// The region is derived from the zone (e.g., us-central1 of us-central1-a).
+func (detector GCPDetector) Detect(ctx context.Context) (model.CloudInformation, error) {
+	header := map[string]string{"Metadata-Flavor": "Google"}
+
+	get := func(path string) (string, error) {
+		return getMetadata(ctx, http.MethodGet, detector.Endpoint+"/computeMetadata/v1/instance/"+path, header)
+	}
+
+	// e.g., projects/123456789012/zones/us-central1-a
+	zone, err := get("zone")
+	if err != nil {
+		return model.CloudInformation{}, err
+	}
+	zone = zone[strings.LastIndex(zone, "/")+1:]
+
+	cloudInformation := model.CloudInformation{ProviderName: GCP, AvailabilityZoneID: zone}
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		cloudInformation.RegionID = zone[:i]
+	}
+
+	// e.g., projects/123456789012/networks/default
+	if cloudInformation.VirtualNetworkID, err = get("network-interfaces/0/network"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	// e.g., projects/123456789012/regions/us-central1/subnetworks/default
+	if cloudInformation.SubnetID, err = get("network-interfaces/0/subnetwork"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	return cloudInformation, nil
+}
+
+// AzureDetector detects the cloud information by the instance metadata service of Azure
+type AzureDetector struct {
+	Endpoint string
+}
+
+// ProviderName returns the name of the provider
+func (detector AzureDetector) ProviderName() string {
+	return Azure
+}
+
+// azureInstance represents the part of the instance metadata of Azure
+type azureInstance struct {
+	Compute struct {
+		Location string `json:"location"`
+		Zone     string `json:"zone"`
+	} `json:"compute"`
+	Network struct {
+		Interface []struct {
+			IPv4 struct {
+				Subnet []struct {
+					Address string `json:"address"`
+					Prefix  string `json:"prefix"`
+				} `json:"subnet"`
+			} `json:"ipv4"`
+		} `json:"interface"`
+	} `json:"network"`
+}
+
+// Detect requests the location (region), the zone, and the subnet of the primary network interface.
+// The virtual network is not provided by the instance metadata service, so it needs to be set by configuration
+// for the cost-prioritized rule (the public IP address is used otherwise).
+func (detector AzureDetector) Detect(ctx context.Context) (model.CloudInformation, error) {
+	body, err := getMetadata(ctx, http.MethodGet, detector.Endpoint+"/metadata/instance?api-version=2021-02-01",
+		map[string]string{"Metadata": "true"})
+	if err != nil {
+		return model.CloudInformation{}, err
+	}
+
+	var instance azureInstance
+	if err := json.Unmarshal([]byte(body), &instance); err != nil {
+		return model.CloudInformation{}, err
+	}
+	if instance.Compute.Location == "" {
+		return model.CloudInformation{}, errors.New("no location in the instance metadata")
+	}
+
+	cloudInformation := model.CloudInformation{
+		ProviderName:       Azure,
+		RegionID:           instance.Compute.Location,
+		AvailabilityZoneID: instance.Compute.Zone,
+	}
+	if len(instance.Network.Interface) > 0 && len(instance.Network.Interface[0].IPv4.Subnet) > 0 {
+		subnet := instance.Network.Interface[0].IPv4.Subnet[0]
+		cloudInformation.SubnetID = subnet.Address + "/" + subnet.Prefix
+	}
+	return cloudInformation, nil
+}
+
+// AlibabaDetector detects the cloud information by the instance metadata service of Alibaba Cloud
+type AlibabaDetector struct {
+	Endpoint string
+}
+
+// ProviderName returns the name of the provider
+func (detector AlibabaDetector) ProviderName() string {
+	return Alibaba
+}
+
+// Detect requests the region, the zone, and the VPC and vSwitch (subnet)
+func (detector AlibabaDetector) Detect(ctx context.Context) (model.CloudInformation, error) {
+	get := func(path string) (string, error) {
+		return getMetadata(ctx, http.MethodGet, detector.Endpoint+"/latest/meta-data/"+path, nil)
+	}
+
+	var err error
+	cloudInformation := model.CloudInformation{ProviderName: Alibaba}
+	if cloudInformation.RegionID, err = get("region-id"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	if cloudInformation.AvailabilityZoneID, err = get("zone-id"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	if cloudInformation.VirtualNetworkID, err = get("vpc-id"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	if cloudInformation.SubnetID, err = get("vswitch-id"); err != nil {
+		return model.CloudInformation{}, err
+	}
+	return cloudInformation, nil
+}
@@ -0,0 +1,307 @@
+package cloudinfo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+)
+
+// newMetadataServer serves the paths, each with the required headers (all of them must match)
+func newMetadataServer(t *testing.T, paths map[string]string, required map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key, value := range required {
+			if r.Header.Get(key) != value {
+				http.Error(w, "missing "+key, http.StatusUnauthorized)
+				return
+			}
+		}
+		body, ok := paths[r.Method+" "+r.URL.RequestURI()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body + "\n"))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newAWSServer serves the IMDSv2 of AWS, which requires a token issued by PUT
+func newAWSServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	const token = "aws-token"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			if r.Method != http.MethodPut || r.Header.Get("X-aws-ec2-metadata-token-ttl-seconds") == "" {
+				http.Error(w, "bad token request", http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(token))
+			return
+		}
+		if r.Header.Get("X-aws-ec2-metadata-token") != token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		body, ok := map[string]string{
+			"/latest/meta-data/placement/region":                                    "ap-northeast-2",
+			"/latest/meta-data/placement/availability-zone":                         "ap-northeast-2a",
+			"/latest/meta-data/mac":                                                 "0a:1b:2c:3d:4e:5f",
+			"/latest/meta-data/network/interfaces/macs/0a:1b:2c:3d:4e:5f/vpc-id":    "vpc-0123",
+			"/latest/meta-data/network/interfaces/macs/0a:1b:2c:3d:4e:5f/subnet-id": "subnet-4567",
+		}[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newGCPServer(t *testing.T) *httptest.Server {
+	return newMetadataServer(t, map[string]string{
+		"GET /computeMetadata/v1/instance/zone":                            "projects/123456789012/zones/us-central1-a",
+		"GET /computeMetadata/v1/instance/network-interfaces/0/network":    "projects/123456789012/networks/default",
+		"GET /computeMetadata/v1/instance/network-interfaces/0/subnetwork": "projects/123456789012/regions/us-central1/subnetworks/default",
+	}, map[string]string{"Metadata-Flavor": "Google"})
+}
+
+func newAzureServer(t *testing.T, instance string) *httptest.Server {
+	return newMetadataServer(t, map[string]string{
+		"GET /metadata/instance?api-version=2021-02-01": instance,
+	}, map[string]string{"Metadata": "true"})
+}
+
+const azureInstanceJSON = `{
+  "compute": {"location": "koreacentral", "zone": "1", "vmSize": "Standard_B1s"},
+  "network": {"interface": [{"ipv4": {"subnet": [{"address": "10.0.1.0", "prefix": "24"}]}}]}
+}`
+
+func newAlibabaServer(t *testing.T) *httptest.Server {
+	return newMetadataServer(t, map[string]string{
+		"GET /latest/meta-data/region-id":  "cn-hangzhou",
+		"GET /latest/meta-data/zone-id":    "cn-hangzhou-h",
+		"GET /latest/meta-data/vpc-id":     "vpc-bp1",
+		"GET /latest/meta-data/vswitch-id": "vsw-bp1",
+	}, nil)
+}
+
+func TestDetectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		detector Detector
+		want     model.CloudInformation
+		wantErr  bool
+	}{
+		{
+			name:     "AWS",
+			detector: AWSDetector{Endpoint: newAWSServer(t).URL},
+			want: model.CloudInformation{
+				ProviderName: AWS, RegionID: "ap-northeast-2", AvailabilityZoneID: "ap-northeast-2a",
+				VirtualNetworkID: "vpc-0123", SubnetID: "subnet-4567",
+			},
+		},
+		{
+			name:     "GCP",
+			detector: GCPDetector{Endpoint: newGCPServer(t).URL},
+			want: model.CloudInformation{
+				ProviderName: GCP, RegionID: "us-central1", AvailabilityZoneID: "us-central1-a",
+				VirtualNetworkID: "projects/123456789012/networks/default",
+				SubnetID:         "projects/123456789012/regions/us-central1/subnetworks/default",
+			},
+		},
+		{
+			name:     "Azure",
+			detector: AzureDetector{Endpoint: newAzureServer(t, azureInstanceJSON).URL},
+			want: model.CloudInformation{
+				ProviderName: Azure, RegionID: "koreacentral", AvailabilityZoneID: "1", SubnetID: "10.0.1.0/24",
+			},
+		},
+		{
+			name:     "Azure without a network interface",
+			detector: AzureDetector{Endpoint: newAzureServer(t, `{"compute": {"location": "koreacentral"}}`).URL},
+			want:     model.CloudInformation{ProviderName: Azure, RegionID: "koreacentral"},
+		},
+		{
+			name:     "Azure without a location",
+			detector: AzureDetector{Endpoint: newAzureServer(t, `{"compute": {}}`).URL},
+			wantErr:  true,
+		},
+		{
+			name:     "Azure with an invalid JSON",
+			detector: AzureDetector{Endpoint: newAzureServer(t, `<html>`).URL},
+			wantErr:  true,
+		},
+		{
+			name:     "Alibaba",
+			detector: AlibabaDetector{Endpoint: newAlibabaServer(t).URL},
+			want: model.CloudInformation{
+				ProviderName: Alibaba, RegionID: "cn-hangzhou", AvailabilityZoneID: "cn-hangzhou-h",
+				VirtualNetworkID: "vpc-bp1", SubnetID: "vsw-bp1",
+			},
+		},
+		{
+			// The other providers' servers answer 404 or 401
+			name:     "AWS detector on GCP",
+			detector: AWSDetector{Endpoint: newGCPServer(t).URL},
+			wantErr:  true,
+		},
+		{
+			name:     "GCP detector on Alibaba",
+			detector: GCPDetector{Endpoint: newAlibabaServer(t).URL},
+			wantErr:  true,
+		},
+		{
+			name:     "Alibaba detector on Azure",
+			detector: AlibabaDetector{Endpoint: newAzureServer(t, azureInstanceJSON).URL},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.detector.Detect(context.Background())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Detect() = %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Detect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// unreachableEndpoint returns an endpoint of a closed server
+func unreachableEndpoint(t *testing.T) string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+// slowDetector answers after the delay unless the context is done
+type slowDetector struct {
+	delay time.Duration
+}
+
+func (detector slowDetector) ProviderName() string {
+	return "slow"
+}
+
+func (detector slowDetector) Detect(ctx context.Context) (model.CloudInformation, error) {
+	select {
+	case <-time.After(detector.delay):
+		return model.CloudInformation{ProviderName: "slow"}, nil
+	case <-ctx.Done():
+		return model.CloudInformation{}, ctx.Err()
+	}
+}
+
+func TestDetect(t *testing.T) {
+	aws := AWSDetector{Endpoint: newAWSServer(t).URL}
+	gcp := GCPDetector{Endpoint: newGCPServer(t).URL}
+	azure := AzureDetector{Endpoint: newAzureServer(t, azureInstanceJSON).URL}
+	alibaba := AlibabaDetector{Endpoint: newAlibabaServer(t).URL}
+	unreachable := unreachableEndpoint(t)
+
+	tests := []struct {
+		name      string
+		detectors []Detector
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "only one responds",
+			detectors: []Detector{AWSDetector{Endpoint: unreachable}, GCPDetector{Endpoint: unreachable}, azure, AlibabaDetector{Endpoint: unreachable}},
+			want:      Azure,
+		},
+		{
+			name:      "the preceding detector is preferred",
+			detectors: []Detector{gcp, aws, azure, alibaba},
+			want:      GCP,
+		},
+		{
+			name:      "the preceding detector is preferred even if it is slower",
+			detectors: []Detector{slowDetector{delay: 100 * time.Millisecond}, alibaba},
+			want:      "slow",
+		},
+		{
+			name:      "a timed-out detector is skipped",
+			detectors: []Detector{slowDetector{delay: 10 * time.Second}, alibaba},
+			want:      Alibaba,
+		},
+		{
+			// e.g., Azure and AWS share 169.254.169.254, but each answers its own paths only
+			name:      "a detector on the other provider's server fails",
+			detectors: []Detector{AWSDetector{Endpoint: azure.Endpoint}, AzureDetector{Endpoint: azure.Endpoint}},
+			want:      Azure,
+		},
+		{
+			name:      "all fail",
+			detectors: []Detector{AWSDetector{Endpoint: unreachable}, GCPDetector{Endpoint: alibaba.Endpoint}, AzureDetector{Endpoint: unreachable}, slowDetector{delay: 10 * time.Second}},
+			wantErr:   true,
+		},
+		{
+			name:      "no detector",
+			detectors: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			got, err := Detect(context.Background(), tt.detectors, 500*time.Millisecond)
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("Detect() took %v, which exceeds the timeout", elapsed)
+			}
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnknownCloud) {
+					t.Fatalf("Detect() = %+v, %v, want ErrUnknownCloud", got, err)
+				}
+				// The error tells why each detector failed
+				for _, detector := range tt.detectors {
+					if !strings.Contains(err.Error(), detector.ProviderName()+":") {
+						t.Errorf("Detect() error = %v, want the error of %v", err, detector.ProviderName())
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if got.ProviderName != tt.want {
+				t.Errorf("Detect() = %+v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverride(t *testing.T) {
+	detected := model.CloudInformation{ProviderName: Azure, RegionID: "koreacentral", SubnetID: "10.0.1.0/24"}
+	configured := model.CloudInformation{VirtualNetworkID: "vnet-01", RegionID: "koreasouth"}
+
+	want := model.CloudInformation{ProviderName: Azure, RegionID: "koreasouth", VirtualNetworkID: "vnet-01", SubnetID: "10.0.1.0/24"}
+	if got := Override(detected, configured); got != want {
+		t.Errorf("Override() = %+v, want %+v", got, want)
+	}
+	if got := Override(detected, model.CloudInformation{}); got != detected {
+		t.Errorf("Override() = %+v, want %+v", got, detected)
+	}
+}
@@ -1,7 +1,9 @@
 package cmdtype
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/tidwall/gjson"
 )
@@ -18,6 +20,61 @@ const (
 
 	// DisableEncryption is a constant variable for command "DISABLE_ENCRYPTION"
 	DisableEncryption string = "DISABLE_ENCRYPTION"
+
+	// RotateKey is a constant variable for command "ROTATE_KEY"
+	RotateKey string = "ROTATE_KEY"
+
+	// RevokeKey is a constant variable for command "REVOKE_KEY"
+	RevokeKey string = "REVOKE_KEY"
+
+	// SetLogLevel is a constant variable for command "SET_LOG_LEVEL" (parameter: "logLevel")
+	SetLogLevel string = "SET_LOG_LEVEL"
+
+	// TracePackets is a constant variable for command "TRACE_PACKETS" (parameters: "peer" and "duration")
+	TracePackets string = "TRACE_PACKETS"
+
+	// CapturePackets is a constant variable for command "CAPTURE_PACKETS"
+	// (parameters: "captureId", "filter", "duration" and "maxBytes")
+	CapturePackets string = "CAPTURE_PACKETS"
+)
+
+// Parameters of the commands
+const (
+	// LogLevel is the parameter of a log level (e.g., "debug")
+	LogLevel string = "logLevel"
+
+	// Peer is the parameter of the host ID of a remote peer (all peers if empty)
+	Peer string = "peer"
+
+	// Duration is the parameter of a duration in seconds
+	Duration string = "duration"
+
+	// CaptureID is the parameter of the ID of a packet capture
+	CaptureID string = "captureId"
+
+	// Filter is the parameter of a filter expression of a packet capture (see the capturefilter package)
+	Filter string = "filter"
+
+	// MaxBytes is the parameter of the maximum size (bytes) of a packet capture
+	MaxBytes string = "maxBytes"
+)
+
+const (
+	// DefaultPacketTraceDuration represents a period of the packet tracing if it is not specified.
+	DefaultPacketTraceDuration = 1 * time.Minute
+	// MaxPacketTraceDuration represents the maximum period of the packet tracing.
+	MaxPacketTraceDuration = 10 * time.Minute
+
+	// DefaultPacketCaptureDuration represents a period of the packet capture if it is not specified.
+	DefaultPacketCaptureDuration = 1 * time.Minute
+	// MaxPacketCaptureDuration represents the maximum period of the packet capture.
+	MaxPacketCaptureDuration = 10 * time.Minute
+
+	// DefaultPacketCaptureBytes represents a size cap of the packet capture if it is not specified.
+	DefaultPacketCaptureBytes = 256 * 1024
+	// MaxPacketCaptureBytes represents the maximum size cap of the packet capture (the inner and outer files in total).
+	// The files are stored in the etcd, whose request size is limited to 1.5 MiB by default.
+	MaxPacketCaptureBytes = 1024 * 1024
 )
 
 var placeHolder = `{"commandType": "%s"}`
@@ -27,8 +84,23 @@ func BuildCommandMessage(commandType string) string {
 	return fmt.Sprintf(placeHolder, commandType)
 }
 
+// BuildCommandMessageWithParameters represents a function to build a message with a command and its parameters.
+func BuildCommandMessageWithParameters(commandType string, parameters map[string]string) string {
+	message := map[string]string{"commandType": commandType}
+	for name, value := range parameters {
+		message[name] = value
+	}
+	messageBytes, _ := json.Marshal(message)
+	return string(messageBytes)
+}
+
 // ParseCommandMessage represents a function to parse a command from a message.
 func ParseCommandMessage(message string) string {
 	cmd := gjson.Get(message, "commandType").String()
 	return cmd
 }
+
+// ParseCommandParameter represents a function to parse a parameter of a command from a message.
+func ParseCommandParameter(message string, name string) string {
+	return gjson.Get(message, name).String()
+}
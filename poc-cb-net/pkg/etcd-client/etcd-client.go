@@ -0,0 +1,58 @@
+package etcdclient
+
+import (
+	"context"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	tlsutil "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tls-util"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+)
+
+// DefaultDialTimeout is used if the dial timeout is not set in the config.
+const DefaultDialTimeout = 5 * time.Second
+
+// New creates an etcd client by the config, which is shared by all cb-network components.
+// TLS is used if a CA file or a certificate file is set, and authentication is used if a username is set.
+func New(etcdConfig model.ETCDConfig) (*clientv3.Client, error) {
+
+	dialTimeout := etcdConfig.DialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = DefaultDialTimeout
+	}
+
+	clientConfig := clientv3.Config{
+		Endpoints:            etcdConfig.Endpoints,
+		DialTimeout:          dialTimeout,
+		DialKeepAliveTime:    etcdConfig.KeepAliveTime,
+		DialKeepAliveTimeout: etcdConfig.KeepAliveTimeout,
+		Username:             etcdConfig.Username,
+		Password:             etcdConfig.Password,
+	}
+
+	// Configure TLS (or mTLS)
+	if etcdConfig.CAFile != "" || etcdConfig.CertFile != "" {
+		tlsConfig, err := tlsutil.NewClientConfig(etcdConfig.CAFile, etcdConfig.CertFile, etcdConfig.KeyFile, etcdConfig.ServerName)
+		if err != nil {
+			return nil, err
+		}
+		clientConfig.TLS = tlsConfig
+	}
+
+	// Limit the time of each request (except streams, such as watch)
+	if etcdConfig.RequestTimeout > 0 {
+		clientConfig.DialOptions = append(clientConfig.DialOptions,
+			grpc.WithChainUnaryInterceptor(requestTimeoutInterceptor(etcdConfig.RequestTimeout)))
+	}
+
+	return clientv3.New(clientConfig)
+}
+
+func requestTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -1,9 +1,20 @@
 package etcdkey
 
+// CurrentSchemaVersion is the version of the key layout built and parsed by this package.
+// It must be increased when the layout (or the stored values) changes in an incompatible way,
+// and a migration step must be added to the migration package.
+//   - 1: the values are plain JSON (a registry without the schema version is regarded as 1)
+//   - 2: the values of CLADNet specifications, peers and networking rules are tagged with "schemaVersion"
+const CurrentSchemaVersion = 2
+
 const (
 	// CloudAdaptiveNetwork is a constant variable of "/registry/cloud-adaptive-network" key
 	CloudAdaptiveNetwork = "/registry/cloud-adaptive-network"
 
+	// SchemaVersion is a constant variable of "/registry/cloud-adaptive-network/schema-version" key,
+	// whose value is the version of the key layout and the stored values
+	SchemaVersion = CloudAdaptiveNetwork + "/schema-version"
+
 	// CLADNetSpecification is a constant variable of "/registry/cloud-adaptive-network/cladnet-specification" key
 	CLADNetSpecification = CloudAdaptiveNetwork + "/cladnet-specification"
 
@@ -34,6 +45,42 @@ const (
 	// Secret is a constant variable of "/registry/cloud-adaptive-network/secret" key
 	Secret = CloudAdaptiveNetwork + "/secret"
 
+	// SecretRevocation is a constant variable of "/registry/cloud-adaptive-network/secret-revocation" key
+	SecretRevocation = CloudAdaptiveNetwork + "/secret-revocation"
+
+	// SecretAudit is a constant variable of "/registry/cloud-adaptive-network/secret-audit" key
+	SecretAudit = CloudAdaptiveNetwork + "/secret-audit"
+
+	// JoinToken is a constant variable of "/registry/cloud-adaptive-network/join-token" key
+	JoinToken = CloudAdaptiveNetwork + "/join-token"
+
+	// AgentCredential is a constant variable of "/registry/cloud-adaptive-network/agent-credential" key
+	AgentCredential = CloudAdaptiveNetwork + "/agent-credential"
+
+	// AgentHeartbeat is a constant variable of "/registry/cloud-adaptive-network/agent-heartbeat" key
+	AgentHeartbeat = CloudAdaptiveNetwork + "/agent-heartbeat"
+
+	// PeerStatistics is a constant variable of "/registry/cloud-adaptive-network/peer-statistics" key
+	PeerStatistics = CloudAdaptiveNetwork + "/peer-statistics"
+
+	// Readdressing is a constant variable of "/registry/cloud-adaptive-network/readdressing" key
+	Readdressing = CloudAdaptiveNetwork + "/readdressing"
+
+	// LogLevel is a constant variable of "/registry/cloud-adaptive-network/log-level" key
+	LogLevel = CloudAdaptiveNetwork + "/log-level"
+
+	// PacketCapture is a constant variable of "/registry/cloud-adaptive-network/packet-capture" key
+	PacketCapture = CloudAdaptiveNetwork + "/packet-capture"
+
+	// PacketCaptureFile is a constant variable of "/registry/cloud-adaptive-network/packet-capture-file" key
+	PacketCaptureFile = CloudAdaptiveNetwork + "/packet-capture-file"
+
+	// TestRun is a constant variable of "/registry/cloud-adaptive-network/test-run" key
+	TestRun = CloudAdaptiveNetwork + "/test-run"
+
+	// TestResult is a constant variable of "/registry/cloud-adaptive-network/test-result" key
+	TestResult = CloudAdaptiveNetwork + "/test-result"
+
 	// DistributedLock is a constant variable of "/registry/cloud-adaptive-network/distributed-lock" key
 	DistributedLock = CloudAdaptiveNetwork + "/distributed-lock"
 
@@ -0,0 +1,383 @@
+package etcdkey
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	// ErrInvalidID is returned if an ID cannot be a segment of a key
+	ErrInvalidID = errors.New("invalid ID")
+
+	// ErrInvalidKey is returned if a key does not match the layout of a key family
+	ErrInvalidKey = errors.New("invalid key")
+)
+
+// maxIDLength is the maximum length of an ID (e.g., the length of a DNS name)
+const maxIDLength = 253
+
+// ValidateID checks if an ID (e.g., CLADNet ID and host ID) can be a segment of a key.
+// An ID must not be empty, and must not contain "/", whitespaces and control characters.
+func ValidateID(id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidID)
+	}
+	if len(id) > maxIDLength {
+		return fmt.Errorf("%w: longer than %d (%q)", ErrInvalidID, maxIDLength, id)
+	}
+	for _, r := range id {
+		if r == '/' || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return fmt.Errorf("%w: %q must not contain '/', whitespaces and control characters", ErrInvalidID, id)
+		}
+	}
+	return nil
+}
+
+// build builds a key of {family}/{id}/{id}/... after validating the IDs,
+// so that a key never collides with a key (or a prefix) of the other IDs.
+func build(family string, ids ...string) (string, error) {
+	for _, id := range ids {
+		if err := ValidateID(id); err != nil {
+			return "", err
+		}
+	}
+	return family + "/" + strings.Join(ids, "/"), nil
+}
+
+// parse parses the IDs of a key of {family}/{id}/{id}/... with the number of the IDs
+func parse(family string, key string, count int) ([]string, error) {
+	if !strings.HasPrefix(key, family+"/") {
+		return nil, fmt.Errorf("%w: %q is not under %q", ErrInvalidKey, key, family)
+	}
+
+	ids := strings.Split(strings.TrimPrefix(key, family+"/"), "/")
+	if len(ids) != count {
+		return nil, fmt.Errorf("%w: %q must have %d IDs after %q", ErrInvalidKey, key, count, family)
+	}
+
+	for _, id := range ids {
+		if err := ValidateID(id); err != nil {
+			return nil, fmt.Errorf("%w: %q (%v)", ErrInvalidKey, key, err)
+		}
+	}
+	return ids, nil
+}
+
+// parseHostKey parses a key of {family}/{cladnet-id}/{host-id}
+func parseHostKey(family string, key string) (cladnetID string, hostID string, err error) {
+	ids, err := parse(family, key, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return ids[0], ids[1], nil
+}
+
+// FamilyPrefix returns a prefix to get or watch the keys of a family in all CLADNets,
+// e.g., "/registry/cloud-adaptive-network/peer/".
+func FamilyPrefix(family string) string {
+	return family + "/"
+}
+
+// Prefix returns a prefix to get or watch the keys of a family in a CLADNet,
+// e.g., "/registry/cloud-adaptive-network/peer/{cladnet-id}/".
+// It returns a prefix of all CLADNets (see FamilyPrefix) if the CLADNet ID is empty.
+func Prefix(family string, cladnetID string) (string, error) {
+	if cladnetID == "" {
+		return FamilyPrefix(family), nil
+	}
+	key, err := build(family, cladnetID)
+	if err != nil {
+		return "", err
+	}
+	return key + "/", nil
+}
+
+// CLADNetSpecificationKey builds "/registry/cloud-adaptive-network/cladnet-specification/{cladnet-id}"
+func CLADNetSpecificationKey(cladnetID string) (string, error) {
+	return build(CLADNetSpecification, cladnetID)
+}
+
+// ParseCLADNetSpecificationKey parses "/registry/cloud-adaptive-network/cladnet-specification/{cladnet-id}"
+func ParseCLADNetSpecificationKey(key string) (cladnetID string, err error) {
+	ids, err := parse(CLADNetSpecification, key, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// HostNetworkInformationKey builds "/registry/cloud-adaptive-network/host-network-information/{cladnet-id}/{host-id}"
+func HostNetworkInformationKey(cladnetID string, hostID string) (string, error) {
+	return build(HostNetworkInformation, cladnetID, hostID)
+}
+
+// ParseHostNetworkInformationKey parses "/registry/cloud-adaptive-network/host-network-information/{cladnet-id}/{host-id}"
+func ParseHostNetworkInformationKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(HostNetworkInformation, key)
+}
+
+// PeerKey builds "/registry/cloud-adaptive-network/peer/{cladnet-id}/{host-id}"
+func PeerKey(cladnetID string, hostID string) (string, error) {
+	return build(Peer, cladnetID, hostID)
+}
+
+// ParsePeerKey parses "/registry/cloud-adaptive-network/peer/{cladnet-id}/{host-id}"
+func ParsePeerKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(Peer, key)
+}
+
+// NetworkingRuleKey builds "/registry/cloud-adaptive-network/networking-rule/{cladnet-id}/{host-id}"
+func NetworkingRuleKey(cladnetID string, hostID string) (string, error) {
+	return build(NetworkingRule, cladnetID, hostID)
+}
+
+// ParseNetworkingRuleKey parses "/registry/cloud-adaptive-network/networking-rule/{cladnet-id}/{host-id}"
+func ParseNetworkingRuleKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(NetworkingRule, key)
+}
+
+// ControlCommandKey builds "/registry/cloud-adaptive-network/control-command/{cladnet-id}/{host-id}"
+func ControlCommandKey(cladnetID string, hostID string) (string, error) {
+	return build(ControlCommand, cladnetID, hostID)
+}
+
+// ParseControlCommandKey parses "/registry/cloud-adaptive-network/control-command/{cladnet-id}/{host-id}"
+func ParseControlCommandKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(ControlCommand, key)
+}
+
+// TestRequestKey builds "/registry/cloud-adaptive-network/test-request/{cladnet-id}/{host-id}"
+func TestRequestKey(cladnetID string, hostID string) (string, error) {
+	return build(TestRequest, cladnetID, hostID)
+}
+
+// ParseTestRequestKey parses "/registry/cloud-adaptive-network/test-request/{cladnet-id}/{host-id}"
+func ParseTestRequestKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(TestRequest, key)
+}
+
+// StatusTestSpecificationKey builds "/registry/cloud-adaptive-network/status/test-specification/{cladnet-id}"
+func StatusTestSpecificationKey(cladnetID string) (string, error) {
+	return build(StatusTestSpecification, cladnetID)
+}
+
+// ParseStatusTestSpecificationKey parses "/registry/cloud-adaptive-network/status/test-specification/{cladnet-id}"
+func ParseStatusTestSpecificationKey(key string) (cladnetID string, err error) {
+	ids, err := parse(StatusTestSpecification, key, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// StatusInformationKey builds "/registry/cloud-adaptive-network/status/information/{cladnet-id}/{host-id}"
+func StatusInformationKey(cladnetID string, hostID string) (string, error) {
+	return build(StatusInformation, cladnetID, hostID)
+}
+
+// ParseStatusInformationKey parses "/registry/cloud-adaptive-network/status/information/{cladnet-id}/{host-id}"
+func ParseStatusInformationKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(StatusInformation, key)
+}
+
+// SecretKey builds "/registry/cloud-adaptive-network/secret/{cladnet-id}/{host-id}"
+func SecretKey(cladnetID string, hostID string) (string, error) {
+	return build(Secret, cladnetID, hostID)
+}
+
+// ParseSecretKey parses "/registry/cloud-adaptive-network/secret/{cladnet-id}/{host-id}"
+func ParseSecretKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(Secret, key)
+}
+
+// SecretRevocationKey builds "/registry/cloud-adaptive-network/secret-revocation/{cladnet-id}/{host-id}/{fingerprint}"
+func SecretRevocationKey(cladnetID string, hostID string, fingerprint string) (string, error) {
+	return build(SecretRevocation, cladnetID, hostID, fingerprint)
+}
+
+// ParseSecretRevocationKey parses "/registry/cloud-adaptive-network/secret-revocation/{cladnet-id}/{host-id}/{fingerprint}"
+func ParseSecretRevocationKey(key string) (cladnetID string, hostID string, fingerprint string, err error) {
+	ids, err := parse(SecretRevocation, key, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+	return ids[0], ids[1], ids[2], nil
+}
+
+// SecretAuditKey builds "/registry/cloud-adaptive-network/secret-audit/{cladnet-id}/{timestamp}-{host-id}".
+// The timestamp (Unix nanoseconds) is zero-padded so that the keys are sorted by time.
+func SecretAuditKey(cladnetID string, timestamp time.Time, hostID string) (string, error) {
+	if err := ValidateID(hostID); err != nil {
+		return "", err
+	}
+	return build(SecretAudit, cladnetID, fmt.Sprintf("%020d-%v", timestamp.UnixNano(), hostID))
+}
+
+// ParseSecretAuditKey parses "/registry/cloud-adaptive-network/secret-audit/{cladnet-id}/{timestamp}-{host-id}"
+func ParseSecretAuditKey(key string) (cladnetID string, timestamp time.Time, hostID string, err error) {
+	ids, err := parse(SecretAudit, key, 2)
+	if err != nil {
+		return "", time.Time{}, "", err
+	}
+
+	// The timestamp has the fixed length, so the host ID can contain "-"
+	const timestampLength = 20
+	record := ids[1]
+	if len(record) < timestampLength+2 || record[timestampLength] != '-' {
+		return "", time.Time{}, "", fmt.Errorf("%w: %q must end with {timestamp}-{host-id}", ErrInvalidKey, key)
+	}
+	nanoseconds, errParse := strconv.ParseInt(record[:timestampLength], 10, 64)
+	if errParse != nil {
+		return "", time.Time{}, "", fmt.Errorf("%w: %q (%v)", ErrInvalidKey, key, errParse)
+	}
+
+	return ids[0], time.Unix(0, nanoseconds), record[timestampLength+1:], nil
+}
+
+// JoinTokenKey builds "/registry/cloud-adaptive-network/join-token/{cladnet-id}/{token-id}"
+func JoinTokenKey(cladnetID string, tokenID string) (string, error) {
+	return build(JoinToken, cladnetID, tokenID)
+}
+
+// ParseJoinTokenKey parses "/registry/cloud-adaptive-network/join-token/{cladnet-id}/{token-id}"
+func ParseJoinTokenKey(key string) (cladnetID string, tokenID string, err error) {
+	return parseHostKey(JoinToken, key)
+}
+
+// AgentCredentialKey builds "/registry/cloud-adaptive-network/agent-credential/{cladnet-id}/{host-id}"
+func AgentCredentialKey(cladnetID string, hostID string) (string, error) {
+	return build(AgentCredential, cladnetID, hostID)
+}
+
+// ParseAgentCredentialKey parses "/registry/cloud-adaptive-network/agent-credential/{cladnet-id}/{host-id}"
+func ParseAgentCredentialKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(AgentCredential, key)
+}
+
+// AgentHeartbeatKey builds "/registry/cloud-adaptive-network/agent-heartbeat/{cladnet-id}/{host-id}"
+func AgentHeartbeatKey(cladnetID string, hostID string) (string, error) {
+	return build(AgentHeartbeat, cladnetID, hostID)
+}
+
+// ParseAgentHeartbeatKey parses "/registry/cloud-adaptive-network/agent-heartbeat/{cladnet-id}/{host-id}"
+func ParseAgentHeartbeatKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(AgentHeartbeat, key)
+}
+
+// PeerStatisticsKey builds "/registry/cloud-adaptive-network/peer-statistics/{cladnet-id}/{host-id}"
+func PeerStatisticsKey(cladnetID string, hostID string) (string, error) {
+	return build(PeerStatistics, cladnetID, hostID)
+}
+
+// ParsePeerStatisticsKey parses "/registry/cloud-adaptive-network/peer-statistics/{cladnet-id}/{host-id}"
+func ParsePeerStatisticsKey(key string) (cladnetID string, hostID string, err error) {
+	return parseHostKey(PeerStatistics, key)
+}
+
+// ReaddressingKey builds "/registry/cloud-adaptive-network/readdressing/{cladnet-id}"
+func ReaddressingKey(cladnetID string) (string, error) {
+	return build(Readdressing, cladnetID)
+}
+
+// ParseReaddressingKey parses "/registry/cloud-adaptive-network/readdressing/{cladnet-id}"
+func ParseReaddressingKey(key string) (cladnetID string, err error) {
+	ids, err := parse(Readdressing, key, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// ControllerComponent is the component of the controllers in LogLevelKey
+const ControllerComponent = "controller"
+
+// LogLevelKey builds "/registry/cloud-adaptive-network/log-level/{component}", e.g., ControllerComponent
+func LogLevelKey(component string) (string, error) {
+	return build(LogLevel, component)
+}
+
+// ParseLogLevelKey parses "/registry/cloud-adaptive-network/log-level/{component}"
+func ParseLogLevelKey(key string) (component string, err error) {
+	ids, err := parse(LogLevel, key, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// PacketCaptureKey builds "/registry/cloud-adaptive-network/packet-capture/{cladnet-id}/{host-id}/{capture-id}"
+func PacketCaptureKey(cladnetID string, hostID string, captureID string) (string, error) {
+	return build(PacketCapture, cladnetID, hostID, captureID)
+}
+
+// ParsePacketCaptureKey parses "/registry/cloud-adaptive-network/packet-capture/{cladnet-id}/{host-id}/{capture-id}"
+func ParsePacketCaptureKey(key string) (cladnetID string, hostID string, captureID string, err error) {
+	ids, err := parse(PacketCapture, key, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+	return ids[0], ids[1], ids[2], nil
+}
+
+// PacketCaptureFileKey builds "/registry/cloud-adaptive-network/packet-capture-file/{cladnet-id}/{host-id}/{capture-id}/{layer}",
+// where the layer is "inner" or "outer"
+func PacketCaptureFileKey(cladnetID string, hostID string, captureID string, layer string) (string, error) {
+	return build(PacketCaptureFile, cladnetID, hostID, captureID, layer)
+}
+
+// ParsePacketCaptureFileKey parses "/registry/cloud-adaptive-network/packet-capture-file/{cladnet-id}/{host-id}/{capture-id}/{layer}"
+func ParsePacketCaptureFileKey(key string) (cladnetID string, hostID string, captureID string, layer string, err error) {
+	ids, err := parse(PacketCaptureFile, key, 4)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return ids[0], ids[1], ids[2], ids[3], nil
+}
+
+// TestRunKey builds "/registry/cloud-adaptive-network/test-run/{cladnet-id}/{run-id}"
+func TestRunKey(cladnetID string, runID string) (string, error) {
+	return build(TestRun, cladnetID, runID)
+}
+
+// ParseTestRunKey parses "/registry/cloud-adaptive-network/test-run/{cladnet-id}/{run-id}"
+func ParseTestRunKey(key string) (cladnetID string, runID string, err error) {
+	ids, err := parse(TestRun, key, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return ids[0], ids[1], nil
+}
+
+// TestResultKey builds "/registry/cloud-adaptive-network/test-result/{cladnet-id}/{run-id}/{host-id}"
+func TestResultKey(cladnetID string, runID string, hostID string) (string, error) {
+	return build(TestResult, cladnetID, runID, hostID)
+}
+
+// TestResultPrefix builds "/registry/cloud-adaptive-network/test-result/{cladnet-id}/{run-id}/" to get or watch the results of a test run
+func TestResultPrefix(cladnetID string, runID string) (string, error) {
+	key, err := build(TestResult, cladnetID, runID)
+	if err != nil {
+		return "", err
+	}
+	return key + "/", nil
+}
+
+// ParseTestResultKey parses "/registry/cloud-adaptive-network/test-result/{cladnet-id}/{run-id}/{host-id}"
+func ParseTestResultKey(key string) (cladnetID string, runID string, hostID string, err error) {
+	ids, err := parse(TestResult, key, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+	return ids[0], ids[1], ids[2], nil
+}
+
+// LockKey builds a prefix of a distributed lock in a CLADNet, e.g., "/registry/cloud-adaptive-network/distributed-lock/peer/{cladnet-id}".
+// The lock family is one of LockPeer, LockNetworkingRule and LockSecret.
+// (The lock holders are put under the prefix by etcd, so there is no parser.)
+func LockKey(lockFamily string, cladnetID string) (string, error) {
+	return build(lockFamily, cladnetID)
+}
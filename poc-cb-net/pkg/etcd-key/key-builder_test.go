@@ -0,0 +1,240 @@
+package etcdkey
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{name: "valid", id: "cladnet-01"},
+		{name: "with dots and colons", id: "host.example.com:a1"},
+		{name: "max length", id: strings.Repeat("a", maxIDLength)},
+		{name: "empty", id: "", wantErr: true},
+		{name: "too long", id: strings.Repeat("a", maxIDLength+1), wantErr: true},
+		{name: "slash", id: "host/01", wantErr: true},
+		{name: "space", id: "host 01", wantErr: true},
+		{name: "tab", id: "host\t01", wantErr: true},
+		{name: "control character", id: "host\x0001", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateID(tt.id)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ValidateID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidID) {
+				t.Errorf("ValidateID(%q) error = %v, want ErrInvalidID", tt.id, err)
+			}
+		})
+	}
+}
+
+func TestHostKeyRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		family string
+		build  func(cladnetID, hostID string) (string, error)
+		parse  func(key string) (string, string, error)
+	}{
+		{"host network information", HostNetworkInformation, HostNetworkInformationKey, ParseHostNetworkInformationKey},
+		{"peer", Peer, PeerKey, ParsePeerKey},
+		{"networking rule", NetworkingRule, NetworkingRuleKey, ParseNetworkingRuleKey},
+		{"control command", ControlCommand, ControlCommandKey, ParseControlCommandKey},
+		{"test request", TestRequest, TestRequestKey, ParseTestRequestKey},
+		{"status information", StatusInformation, StatusInformationKey, ParseStatusInformationKey},
+		{"secret", Secret, SecretKey, ParseSecretKey},
+		{"join token", JoinToken, JoinTokenKey, ParseJoinTokenKey},
+		{"agent credential", AgentCredential, AgentCredentialKey, ParseAgentCredentialKey},
+		{"agent heartbeat", AgentHeartbeat, AgentHeartbeatKey, ParseAgentHeartbeatKey},
+		{"peer statistics", PeerStatistics, PeerStatisticsKey, ParsePeerStatisticsKey},
+		{"test run", TestRun, TestRunKey, ParseTestRunKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := tt.build("cladnet-01", "host-01")
+			if err != nil {
+				t.Fatalf("build error = %v", err)
+			}
+			if want := tt.family + "/cladnet-01/host-01"; key != want {
+				t.Errorf("build = %q, want %q", key, want)
+			}
+
+			cladnetID, hostID, err := tt.parse(key)
+			if err != nil || cladnetID != "cladnet-01" || hostID != "host-01" {
+				t.Errorf("parse(%q) = %q, %q, %v", key, cladnetID, hostID, err)
+			}
+
+			// An invalid ID never produces a key
+			for _, ids := range [][2]string{{"cladnet-01", "host/01"}, {"cladnet/01", "host-01"}, {"", "host-01"}, {"cladnet-01", ""}} {
+				if key, err := tt.build(ids[0], ids[1]); !errors.Is(err, ErrInvalidID) {
+					t.Errorf("build(%q, %q) = %q, %v, want ErrInvalidID", ids[0], ids[1], key, err)
+				}
+			}
+
+			// Keys of the other layouts are rejected
+			for _, key := range []string{
+				tt.family + "/cladnet-01",
+				tt.family + "/cladnet-01/host-01/extra",
+				tt.family + "/cladnet-01/",
+				tt.family + "//host-01",
+				tt.family + "-other/cladnet-01/host-01",
+				tt.family,
+			} {
+				if _, _, err := tt.parse(key); !errors.Is(err, ErrInvalidKey) {
+					t.Errorf("parse(%q) error = %v, want ErrInvalidKey", key, err)
+				}
+			}
+		})
+	}
+}
+
+func TestCLADNetKeyRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		family string
+		build  func(cladnetID string) (string, error)
+		parse  func(key string) (string, error)
+	}{
+		{"cladnet specification", CLADNetSpecification, CLADNetSpecificationKey, ParseCLADNetSpecificationKey},
+		{"status test specification", StatusTestSpecification, StatusTestSpecificationKey, ParseStatusTestSpecificationKey},
+		{"readdressing", Readdressing, ReaddressingKey, ParseReaddressingKey},
+		{"log level", LogLevel, LogLevelKey, ParseLogLevelKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := tt.build("cladnet-01")
+			if err != nil {
+				t.Fatalf("build error = %v", err)
+			}
+			if want := tt.family + "/cladnet-01"; key != want {
+				t.Errorf("build = %q, want %q", key, want)
+			}
+			if cladnetID, err := tt.parse(key); err != nil || cladnetID != "cladnet-01" {
+				t.Errorf("parse(%q) = %q, %v", key, cladnetID, err)
+			}
+
+			if key, err := tt.build("cladnet/01"); !errors.Is(err, ErrInvalidID) {
+				t.Errorf("build(%q) = %q, %v, want ErrInvalidID", "cladnet/01", key, err)
+			}
+			if _, err := tt.parse(tt.family + "/cladnet/01"); !errors.Is(err, ErrInvalidKey) {
+				t.Errorf("parse error = %v, want ErrInvalidKey", err)
+			}
+		})
+	}
+}
+
+func TestMultipleIDKeyRoundTrip(t *testing.T) {
+	key, err := SecretRevocationKey("cladnet-01", "host-01", "ab:cd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cladnetID, hostID, fingerprint, err := ParseSecretRevocationKey(key); err != nil || cladnetID != "cladnet-01" || hostID != "host-01" || fingerprint != "ab:cd" {
+		t.Errorf("ParseSecretRevocationKey(%q) = %q, %q, %q, %v", key, cladnetID, hostID, fingerprint, err)
+	}
+
+	key, err = PacketCaptureKey("cladnet-01", "host-01", "capture-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cladnetID, hostID, captureID, err := ParsePacketCaptureKey(key); err != nil || cladnetID != "cladnet-01" || hostID != "host-01" || captureID != "capture-01" {
+		t.Errorf("ParsePacketCaptureKey(%q) = %q, %q, %q, %v", key, cladnetID, hostID, captureID, err)
+	}
+
+	key, err = PacketCaptureFileKey("cladnet-01", "host-01", "capture-01", "inner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cladnetID, hostID, captureID, layer, err := ParsePacketCaptureFileKey(key); err != nil || cladnetID != "cladnet-01" || hostID != "host-01" || captureID != "capture-01" || layer != "inner" {
+		t.Errorf("ParsePacketCaptureFileKey(%q) = %q, %q, %q, %q, %v", key, cladnetID, hostID, captureID, layer, err)
+	}
+
+	key, err = TestResultKey("cladnet-01", "run-01", "host-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cladnetID, runID, hostID, err := ParseTestResultKey(key); err != nil || cladnetID != "cladnet-01" || runID != "run-01" || hostID != "host-01" {
+		t.Errorf("ParseTestResultKey(%q) = %q, %q, %q, %v", key, cladnetID, runID, hostID, err)
+	}
+	prefix, err := TestResultPrefix("cladnet-01", "run-01")
+	if err != nil || !strings.HasPrefix(key, prefix) || prefix != TestResult+"/cladnet-01/run-01/" {
+		t.Errorf("TestResultPrefix() = %q, %v", prefix, err)
+	}
+
+	// e.g., a host ID with "/" must not escape to another capture
+	if key, err := PacketCaptureFileKey("cladnet-01", "host-01/capture-01", "inner", "outer"); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("PacketCaptureFileKey() = %q, %v, want ErrInvalidID", key, err)
+	}
+	if key, err := TestResultPrefix("cladnet-01", ""); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("TestResultPrefix() = %q, %v, want ErrInvalidID", key, err)
+	}
+}
+
+func TestSecretAuditKeyRoundTrip(t *testing.T) {
+	timestamp := time.Unix(1700000000, 123456789)
+
+	// A host ID can contain "-"
+	key, err := SecretAuditKey("cladnet-01", timestamp, "host-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cladnetID, parsedTimestamp, hostID, err := ParseSecretAuditKey(key)
+	if err != nil || cladnetID != "cladnet-01" || !parsedTimestamp.Equal(timestamp) || hostID != "host-01" {
+		t.Errorf("ParseSecretAuditKey(%q) = %q, %v, %q, %v", key, cladnetID, parsedTimestamp, hostID, err)
+	}
+
+	// The keys are sorted by time
+	later, _ := SecretAuditKey("cladnet-01", timestamp.Add(time.Nanosecond), "a")
+	if later <= key {
+		t.Errorf("%q must be sorted after %q", later, key)
+	}
+
+	for _, hostID := range []string{"", "host/01"} {
+		if key, err := SecretAuditKey("cladnet-01", timestamp, hostID); !errors.Is(err, ErrInvalidID) {
+			t.Errorf("SecretAuditKey(%q) = %q, %v, want ErrInvalidID", hostID, key, err)
+		}
+	}
+	if _, _, _, err := ParseSecretAuditKey(SecretAudit + "/cladnet-01/not-a-timestamp"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("ParseSecretAuditKey() error = %v, want ErrInvalidKey", err)
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	if got := FamilyPrefix(Peer); got != Peer+"/" {
+		t.Errorf("FamilyPrefix() = %q", got)
+	}
+	if got, err := Prefix(Peer, ""); err != nil || got != Peer+"/" {
+		t.Errorf("Prefix(\"\") = %q, %v", got, err)
+	}
+
+	got, err := Prefix(Peer, "cladnet-01")
+	if err != nil || got != Peer+"/cladnet-01/" {
+		t.Errorf("Prefix() = %q, %v", got, err)
+	}
+	key, _ := PeerKey("cladnet-01", "host-01")
+	if !strings.HasPrefix(key, got) {
+		t.Errorf("%q must be under %q", key, got)
+	}
+
+	// A CLADNet ID must not be a prefix of another CLADNet ID
+	other, _ := PeerKey("cladnet-012", "host-01")
+	if strings.HasPrefix(other, got) {
+		t.Errorf("%q must not be under %q", other, got)
+	}
+
+	if got, err := Prefix(Peer, "cladnet/01"); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("Prefix() = %q, %v, want ErrInvalidID", got, err)
+	}
+	if got, err := LockKey(LockPeer, "cladnet-01"); err != nil || got != LockPeer+"/cladnet-01" {
+		t.Errorf("LockKey() = %q, %v", got, err)
+	}
+}
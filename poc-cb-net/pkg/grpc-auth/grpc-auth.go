@@ -0,0 +1,305 @@
+package grpcauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	tlsutil "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tls-util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Wildcard authorizes to access all CLADNets
+	Wildcard = "*"
+
+	authorizationKey   = "authorization"
+	bearerPrefix       = "Bearer "
+	agentCredentialKey = "cb-agent-credential"
+	joinTokenKey       = "cb-join-token"
+)
+
+// ErrInsecure is returned when the service is configured without TLS or API keys, and "insecure" is not set.
+var ErrInsecure = errors.New("the service is not secured (set 'insecure: true' to start it anyway)")
+
+type apiKeyNameContextKey struct{}
+
+// cladnetRequest is implemented by requests having a CLADNet ID
+type cladnetRequest interface {
+	GetCladnetId() string
+}
+
+// CheckServerConfig checks if the service is secured by TLS and API keys, so that it fails closed.
+// It returns nil if "insecure" is set explicitly.
+func CheckServerConfig(serviceConfig model.ServiceConfig) error {
+	if serviceConfig.Insecure {
+		return nil
+	}
+
+	var problems []string
+	if !serviceConfig.TLS.Enabled {
+		problems = append(problems, "TLS is disabled")
+	}
+	if len(serviceConfig.Auth.APIKeys) == 0 {
+		problems = append(problems, "no API keys")
+	}
+	for _, apiKey := range serviceConfig.Auth.APIKeys {
+		if apiKey.Key == "" || len(apiKey.CLADNetIDs) == 0 {
+			problems = append(problems, fmt.Sprintf("the API key '%v' has no key or CLADNets", apiKey.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %v", ErrInsecure, strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// Authorizer authenticates tokens (API keys) and authorizes access to CLADNets.
+type Authorizer struct {
+	apiKeys       []model.APIKeyConfig
+	publicMethods map[string]bool
+}
+
+// NewAuthorizer creates an authorizer with API keys. The public methods are allowed without a token.
+// A public method ending with "/" (e.g., "/cbnet.v1.AgentService/") stands for all methods of the service.
+func NewAuthorizer(apiKeys []model.APIKeyConfig, publicMethods ...string) *Authorizer {
+	authorizer := &Authorizer{
+		apiKeys:       apiKeys,
+		publicMethods: make(map[string]bool),
+	}
+	for _, method := range publicMethods {
+		authorizer.publicMethods[method] = true
+	}
+	return authorizer
+}
+
+// UnaryServerInterceptor returns an interceptor to authenticate and authorize unary calls.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if a.isPublic(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		apiKey, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := authorize(apiKey, req); err != nil {
+			return nil, err
+		}
+
+		return handler(context.WithValue(ctx, apiKeyNameContextKey{}, apiKey.Name), req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor to authenticate and authorize streaming calls.
+// A streaming call is authorized when the first request message is received.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.isPublic(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		apiKey, err := a.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		wrapped := &authorizedServerStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), apiKeyNameContextKey{}, apiKey.Name),
+			apiKey:       apiKey,
+		}
+		return handler(srv, wrapped)
+	}
+}
+
+// isPublic checks if a method, or the service of the method, is allowed without a token
+func (a *Authorizer) isPublic(fullMethod string) bool {
+	if a.publicMethods[fullMethod] {
+		return true
+	}
+	// The full method is "/package.Service/method"
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		return a.publicMethods[fullMethod[:i+1]]
+	}
+	return false
+}
+
+// authenticate finds an API key matching the bearer token in the metadata
+func (a *Authorizer) authenticate(ctx context.Context) (model.APIKeyConfig, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return model.APIKeyConfig{}, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get(authorizationKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return model.APIKeyConfig{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token := strings.TrimPrefix(values[0], bearerPrefix)
+
+	for _, apiKey := range a.apiKeys {
+		if apiKey.Key != "" && subtle.ConstantTimeCompare([]byte(token), []byte(apiKey.Key)) == 1 {
+			return apiKey, nil
+		}
+	}
+
+	return model.APIKeyConfig{}, status.Error(codes.Unauthenticated, "invalid token")
+}
+
+// authorize checks if an API key is allowed to access the CLADNet of a request.
+// Requests without a CLADNet ID (e.g., listing or creating CLADNets) require the wildcard.
+// NOTE - The scope is a CLADNet, not a host, so an API key scoped to a CLADNet can act on any host in the CLADNet.
+// The agent RPCs are bound to each host by the agent credential in addition.
+func authorize(apiKey model.APIKeyConfig, req interface{}) error {
+	cladnetID := ""
+	if r, ok := req.(cladnetRequest); ok {
+		cladnetID = r.GetCladnetId()
+	}
+
+	for _, id := range apiKey.CLADNetIDs {
+		if id == Wildcard || (cladnetID != "" && id == cladnetID) {
+			return nil
+		}
+	}
+
+	if cladnetID == "" {
+		return status.Errorf(codes.PermissionDenied, "'%v' is not allowed to access all CLADNets", apiKey.Name)
+	}
+	return status.Errorf(codes.PermissionDenied, "'%v' is not allowed to access the CLADNet (%v)", apiKey.Name, cladnetID)
+}
+
+// authorizedServerStream authorizes the first request message of a stream
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx          context.Context
+	apiKey       model.APIKeyConfig
+	isAuthorized bool
+}
+
+func (s *authorizedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if !s.isAuthorized {
+		if err := authorize(s.apiKey, m); err != nil {
+			return err
+		}
+		s.isAuthorized = true
+	}
+	return nil
+}
+
+// APIKeyNameFromContext returns the name of the API key authenticated for a call.
+func APIKeyNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(apiKeyNameContextKey{}).(string)
+	return name
+}
+
+// tokenCredentials attaches a bearer token to each call
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+// NewTokenCredentials creates per-RPC credentials with a bearer token.
+func NewTokenCredentials(token string, requireTLS bool) credentials.PerRPCCredentials {
+	return tokenCredentials{token: token, requireTLS: requireTLS}
+}
+
+func (c tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationKey: bearerPrefix + c.token}, nil
+}
+
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+// AgentCredentialFromContext returns the credential presented by an agent for a call ("" if not presented).
+func AgentCredentialFromContext(ctx context.Context) string {
+	return metadataValue(ctx, agentCredentialKey)
+}
+
+// JoinTokenFromContext returns the join token presented by an agent for a call ("" if not presented).
+func JoinTokenFromContext(ctx context.Context) string {
+	return metadataValue(ctx, joinTokenKey)
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// agentCredentials attaches the credential and the join token of an agent to each call
+type agentCredentials struct {
+	credential string
+	joinToken  string
+	requireTLS bool
+}
+
+// NewAgentCredentials creates per-RPC credentials with the credential and the join token of an agent.
+// The cb-network service binds the host ID to the credential on the first call verified by the join token.
+func NewAgentCredentials(credential string, joinToken string, requireTLS bool) credentials.PerRPCCredentials {
+	return agentCredentials{credential: credential, joinToken: joinToken, requireTLS: requireTLS}
+}
+
+func (c agentCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{agentCredentialKey: c.credential, joinTokenKey: c.joinToken}, nil
+}
+
+func (c agentCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+// DialOptions creates dial options for a client of the cb-network service,
+// including TLS (or mTLS) and the token in the config.
+func DialOptions(serviceConfig model.ServiceConfig) ([]grpc.DialOption, error) {
+
+	tlsConfig := serviceConfig.TLS
+	var options []grpc.DialOption
+
+	if tlsConfig.Enabled {
+		serverName := tlsConfig.ServerName
+		if serverName == "" {
+			serverName, _, _ = net.SplitHostPort(serviceConfig.Endpoint)
+		}
+
+		clientTLSConfig, err := tlsutil.NewClientConfig(tlsConfig.CAFile, tlsConfig.ClientCertFile, tlsConfig.ClientKeyFile, serverName)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(clientTLSConfig)))
+	} else {
+		options = append(options, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if serviceConfig.Auth.Token != "" {
+		options = append(options, grpc.WithPerRPCCredentials(NewTokenCredentials(serviceConfig.Auth.Token, tlsConfig.Enabled)))
+	}
+
+	return options, nil
+}
@@ -0,0 +1,141 @@
+package grpcauth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckServerConfig(t *testing.T) {
+	admin := model.APIKeyConfig{Name: "admin", Key: "admin-key", CLADNetIDs: []string{Wildcard}}
+	tlsEnabled := model.ServiceTLSConfig{Enabled: true}
+
+	tests := []struct {
+		name    string
+		config  model.ServiceConfig
+		wantErr bool
+	}{
+		{name: "TLS and API keys", config: model.ServiceConfig{TLS: tlsEnabled, Auth: model.ServiceAuthConfig{APIKeys: []model.APIKeyConfig{admin}}}},
+		{name: "nothing", config: model.ServiceConfig{}, wantErr: true},
+		{name: "no TLS", config: model.ServiceConfig{Auth: model.ServiceAuthConfig{APIKeys: []model.APIKeyConfig{admin}}}, wantErr: true},
+		{name: "no API keys", config: model.ServiceConfig{TLS: tlsEnabled}, wantErr: true},
+		{
+			name:    "an API key without a key",
+			config:  model.ServiceConfig{TLS: tlsEnabled, Auth: model.ServiceAuthConfig{APIKeys: []model.APIKeyConfig{admin, {Name: "empty", CLADNetIDs: []string{"cladnet-01"}}}}},
+			wantErr: true,
+		},
+		{
+			name:    "an API key without CLADNets",
+			config:  model.ServiceConfig{TLS: tlsEnabled, Auth: model.ServiceAuthConfig{APIKeys: []model.APIKeyConfig{{Name: "none", Key: "none-key"}}}},
+			wantErr: true,
+		},
+		{name: "insecure", config: model.ServiceConfig{Insecure: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckServerConfig(tt.config)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("CheckServerConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInsecure) {
+				t.Errorf("CheckServerConfig() error = %v, want ErrInsecure", err)
+			}
+		})
+	}
+}
+
+// request represents a request with a CLADNet ID (and a host ID)
+type request struct {
+	cladnetID string
+	hostID    string
+}
+
+func (r request) GetCladnetId() string {
+	return r.cladnetID
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	authorizer := NewAuthorizer([]model.APIKeyConfig{
+		{Name: "admin", Key: "admin-key", CLADNetIDs: []string{Wildcard}},
+		{Name: "agents", Key: "agents-key", CLADNetIDs: []string{"cladnet-01"}},
+		{Name: "disabled", Key: "", CLADNetIDs: []string{Wildcard}},
+	}, "/public", "/pkg.PublicService/")
+	interceptor := authorizer.UnaryServerInterceptor()
+
+	tests := []struct {
+		name     string
+		method   string
+		token    string
+		req      interface{}
+		wantCode codes.Code
+		wantName string
+	}{
+		{name: "public", method: "/public", req: request{}, wantCode: codes.OK},
+		{name: "public service", method: "/pkg.PublicService/anyMethod", req: request{cladnetID: "cladnet-02"}, wantCode: codes.OK},
+		{name: "a service prefixed by a public service", method: "/pkg.PublicServiceX/anyMethod", req: request{}, wantCode: codes.Unauthenticated},
+		{name: "a method prefixed by a public method", method: "/public/x", req: request{}, wantCode: codes.Unauthenticated},
+		{name: "no token", method: "/private", req: request{cladnetID: "cladnet-01"}, wantCode: codes.Unauthenticated},
+		{name: "invalid token", method: "/private", token: "xxxx", req: request{cladnetID: "cladnet-01"}, wantCode: codes.Unauthenticated},
+		{name: "an empty key never matches", method: "/private", token: "", req: request{cladnetID: "cladnet-01"}, wantCode: codes.Unauthenticated},
+		{name: "wildcard", method: "/private", token: "admin-key", req: request{}, wantCode: codes.OK, wantName: "admin"},
+		{name: "scoped CLADNet", method: "/private", token: "agents-key", req: request{cladnetID: "cladnet-01"}, wantCode: codes.OK, wantName: "agents"},
+		// NOTE - The scope is a CLADNet, so a key can act on any host in the CLADNet
+		{name: "any host in the scoped CLADNet", method: "/private", token: "agents-key", req: request{cladnetID: "cladnet-01", hostID: "host-99"}, wantCode: codes.OK, wantName: "agents"},
+		{name: "another CLADNet", method: "/private", token: "agents-key", req: request{cladnetID: "cladnet-02"}, wantCode: codes.PermissionDenied},
+		{name: "no CLADNet ID requires the wildcard", method: "/private", token: "agents-key", req: request{}, wantCode: codes.PermissionDenied},
+		{name: "a request without a CLADNet ID", method: "/private", token: "agents-key", req: struct{}{}, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationKey, bearerPrefix+tt.token))
+			}
+
+			var gotName string
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotName = APIKeyNameFromContext(ctx)
+				return nil, nil
+			})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("interceptor error = %v, want %v", err, tt.wantCode)
+			}
+			if gotName != tt.wantName {
+				t.Errorf("APIKeyNameFromContext() = %q, want %q", gotName, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestAgentCredentials(t *testing.T) {
+	perRPCCredentials := NewAgentCredentials("credential", "token-id.secret", true)
+	if !perRPCCredentials.RequireTransportSecurity() {
+		t.Error("RequireTransportSecurity() = false, want true")
+	}
+
+	md, err := perRPCCredentials.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The service receives the metadata sent by the agent
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	if got := AgentCredentialFromContext(ctx); got != "credential" {
+		t.Errorf("AgentCredentialFromContext() = %q", got)
+	}
+	if got := JoinTokenFromContext(ctx); got != "token-id.secret" {
+		t.Errorf("JoinTokenFromContext() = %q", got)
+	}
+
+	if got := AgentCredentialFromContext(context.Background()); got != "" {
+		t.Errorf("AgentCredentialFromContext() without metadata = %q", got)
+	}
+}
@@ -0,0 +1,109 @@
+package jointoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+)
+
+const (
+	// tokenIDSize is the number of random bytes of a token ID (public part)
+	tokenIDSize = 4
+	// secretSize is the number of random bytes of a token secret (private part)
+	secretSize = 16
+	// separator divides a token ID and a token secret in a join token
+	separator = "."
+)
+
+// ErrInvalidFormat is returned when a join token is not in the "<token-id>.<secret>" format.
+var ErrInvalidFormat = errors.New("invalid join token format")
+
+// ErrRejected is returned when a join token is not valid to join a CLADNet.
+var ErrRejected = errors.New("join token rejected")
+
+// Generate generates a new join token in the "<token-id>.<secret>" format.
+// The token ID is used as a key in etcd, and only the hash of the secret is stored.
+func Generate() (tokenID string, secret string, token string, err error) {
+	tokenID, err = randomHex(tokenIDSize)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	secret, err = randomHex(secretSize)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return tokenID, secret, tokenID + separator + secret, nil
+}
+
+// Parse splits a join token into a token ID and a secret.
+func Parse(token string) (tokenID string, secret string, err error) {
+	slicedToken := strings.Split(token, separator)
+	if len(slicedToken) != 2 {
+		return "", "", ErrInvalidFormat
+	}
+
+	tokenID, secret = slicedToken[0], slicedToken[1]
+	if len(tokenID) != tokenIDSize*2 || len(secret) != secretSize*2 {
+		return "", "", ErrInvalidFormat
+	}
+
+	return tokenID, secret, nil
+}
+
+// HashSecret returns the hex-encoded SHA-256 hash of a secret.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifySecret checks if a secret matches a hash in constant time.
+func VerifySecret(secret string, secretHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashSecret(secret)), []byte(secretHash)) == 1
+}
+
+// Verify checks if a join token is valid to join a CLADNet at the time.
+// A rejection is reported by ErrRejected, and the other errors are of the store.
+func Verify(ctx context.Context, cbnetStore store.Store, cladnetID string, token string, now time.Time) error {
+	tokenID, secret, err := Parse(token)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+
+	joinToken, err := cbnetStore.GetJoinToken(ctx, cladnetID, tokenID)
+	// The join token has been revoked or has expired if it does not exist
+	if errors.Is(err, store.ErrNotFound) {
+		return fmt.Errorf("%w: unknown, revoked or expired join token", ErrRejected)
+	}
+	if err != nil {
+		return err
+	}
+
+	if joinToken.CladnetID != cladnetID {
+		return fmt.Errorf("%w: join token scoped to another CLADNet", ErrRejected)
+	}
+	if joinToken.IsExpired(now) {
+		return fmt.Errorf("%w: expired join token", ErrRejected)
+	}
+	if !VerifySecret(secret, joinToken.SecretHash) {
+		return fmt.Errorf("%w: invalid join token", ErrRejected)
+	}
+	return nil
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
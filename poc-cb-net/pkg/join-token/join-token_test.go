@@ -0,0 +1,92 @@
+package jointoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+)
+
+func TestParse(t *testing.T) {
+	tokenID, secret, token, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsedTokenID, parsedSecret, err := Parse(token); err != nil || parsedTokenID != tokenID || parsedSecret != secret {
+		t.Errorf("Parse(%q) = %q, %q, %v", token, parsedTokenID, parsedSecret, err)
+	}
+
+	for _, token := range []string{"", tokenID, tokenID + "." + secret + ".extra", tokenID + "." + secret[1:], "." + secret} {
+		if _, _, err := Parse(token); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidFormat", token, err)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	cbnetStore := store.NewMemoryStore()
+
+	putJoinToken := func(cladnetID string, expiresAt time.Time) string {
+		t.Helper()
+		tokenID, secret, token, err := Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		joinToken := model.JoinToken{
+			TokenID:    tokenID,
+			CladnetID:  cladnetID,
+			SecretHash: HashSecret(secret),
+			CreatedAt:  now,
+			ExpiresAt:  expiresAt,
+		}
+		if err := cbnetStore.PutJoinToken(ctx, joinToken, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	valid := putJoinToken("cladnet-01", now.Add(time.Hour))
+	expired := putJoinToken("cladnet-01", now)
+	revoked := putJoinToken("cladnet-01", now.Add(time.Hour))
+	tokenID, _, _ := Parse(revoked)
+	if _, err := cbnetStore.DeleteJoinToken(ctx, "cladnet-01", tokenID); err != nil {
+		t.Fatal(err)
+	}
+	tokenID, _, _ = Parse(valid)
+	wrongSecret := tokenID + "." + "00000000000000000000000000000000"
+
+	tests := []struct {
+		name      string
+		cladnetID string
+		token     string
+		wantErr   bool
+	}{
+		{name: "valid", cladnetID: "cladnet-01", token: valid},
+		{name: "another CLADNet", cladnetID: "cladnet-02", token: valid, wantErr: true},
+		{name: "expired", cladnetID: "cladnet-01", token: expired, wantErr: true},
+		{name: "revoked", cladnetID: "cladnet-01", token: revoked, wantErr: true},
+		{name: "wrong secret", cladnetID: "cladnet-01", token: wrongSecret, wantErr: true},
+		{name: "empty", cladnetID: "cladnet-01", token: "", wantErr: true},
+		{name: "invalid format", cladnetID: "cladnet-01", token: "xxxx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(ctx, cbnetStore, tt.cladnetID, tt.token, now)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrRejected) {
+				t.Errorf("Verify() error = %v, want ErrRejected", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// Keys of the structured fields
+const (
+	// CLADNetID is the key of a CLADNet ID
+	CLADNetID = "cladnet_id"
+
+	// HostID is the key of a host ID
+	HostID = "host_id"
+
+	// Peer is the key of the host ID of a remote peer
+	Peer = "peer"
+)
+
+// Fields represents the structured fields of a log entry
+type Fields map[string]interface{}
+
+// Logger represents a leveled logger with the structured fields, which is injected into the packages
+// (e.g., by cbnet.WithLogger) instead of being loaded by the packages themselves.
+type Logger interface {
+	Trace(args ...interface{})
+	Tracef(format string, args ...interface{})
+	Debug(args ...interface{})
+	Debugf(format string, args ...interface{})
+	Info(args ...interface{})
+	Infof(format string, args ...interface{})
+	Warn(args ...interface{})
+	Warnf(format string, args ...interface{})
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+
+	// WithFields returns a logger which adds the fields to every log entry
+	WithFields(fields Fields) Logger
+}
+
+// logrusLogger adapts a logrus logger (e.g., the one of cb-log) to Logger
+type logrusLogger struct {
+	*logrus.Entry
+}
+
+// FromLogrus represents a function to adapt a logrus logger (e.g., the one of cb-log) to Logger
+func FromLogrus(logger *logrus.Logger) Logger {
+	return logrusLogger{Entry: logrus.NewEntry(logger)}
+}
+
+func (logger logrusLogger) WithFields(fields Fields) Logger {
+	return logrusLogger{Entry: logger.Entry.WithFields(logrus.Fields(fields))}
+}
+
+// discardLogger discards all log entries
+type discardLogger struct{}
+
+// Discard represents a function to get a logger which discards all log entries.
+// It is the default logger of the packages, so that they are usable as libraries and in tests.
+func Discard() Logger {
+	return discardLogger{}
+}
+
+func (discardLogger) Trace(args ...interface{})                 {}
+func (discardLogger) Tracef(format string, args ...interface{}) {}
+func (discardLogger) Debug(args ...interface{})                 {}
+func (discardLogger) Debugf(format string, args ...interface{}) {}
+func (discardLogger) Info(args ...interface{})                  {}
+func (discardLogger) Infof(format string, args ...interface{})  {}
+func (discardLogger) Warn(args ...interface{})                  {}
+func (discardLogger) Warnf(format string, args ...interface{})  {}
+func (discardLogger) Error(args ...interface{})                 {}
+func (discardLogger) Errorf(format string, args ...interface{}) {}
+func (logger discardLogger) WithFields(fields Fields) Logger    { return logger }
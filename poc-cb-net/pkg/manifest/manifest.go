@@ -0,0 +1,285 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	ruletype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/rule-type"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/validator"
+	yaml "gopkg.in/yaml.v3"
+)
+
+const (
+	// APIVersion is the version of the manifest format
+	APIVersion = "cb-network/v1"
+
+	// Kind is the kind of a CLADNet manifest
+	Kind = "CLADNet"
+)
+
+// ErrInvalidManifest is returned if a manifest cannot be parsed or validated
+var ErrInvalidManifest = errors.New("invalid manifest")
+
+// Manifest represents the desired state of a CLADNet in YAML, for example:
+//
+//	apiVersion: cb-network/v1
+//	kind: CLADNet
+//	metadata:
+//	  cladnetId: cladnet01
+//	  name: my-cladnet
+//	  labels:
+//	    env: dev
+//	spec:
+//	  ipv4AddressSpace: 10.77.0.0/24
+//	  description: A CLADNet for the development
+//	  ruleType: basic
+//	  reservations:
+//	    - hostId: host01
+//	      ip: 10.77.0.10
+//	  policies:
+//	    maxPeers: 10
+type Manifest struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Kind       string   `yaml:"kind"`
+	Metadata   Metadata `yaml:"metadata"`
+	Spec       Spec     `yaml:"spec"`
+}
+
+// Metadata represents the ID, name and labels of a CLADNet
+type Metadata struct {
+	CladnetID string            `yaml:"cladnetId"`
+	Name      string            `yaml:"name"`
+	Labels    map[string]string `yaml:"labels,omitempty"`
+}
+
+// Spec represents the specification, reservations and policies of a CLADNet
+type Spec struct {
+	Ipv4AddressSpace string                `yaml:"ipv4AddressSpace"`
+	Description      string                `yaml:"description,omitempty"`
+	RuleType         string                `yaml:"ruleType,omitempty"`
+	Reservations     []model.IPReservation `yaml:"reservations,omitempty"`
+	Policies         model.CLADNetPolicies `yaml:"policies,omitempty"`
+}
+
+// Parse parses and validates a manifest in YAML. Unknown fields are rejected.
+func Parse(data []byte) (Manifest, error) {
+	var manifest Manifest
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil {
+		return manifest, fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+	}
+
+	if manifest.Spec.RuleType == "" {
+		manifest.Spec.RuleType = ruletype.Basic
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return manifest, err
+	}
+	return manifest, nil
+}
+
+// Validate validates a manifest regardless of the current state of the CLADNet
+func (manifest Manifest) Validate() error {
+	invalid := func(format string, a ...interface{}) error {
+		return fmt.Errorf("%w: %s", ErrInvalidManifest, fmt.Sprintf(format, a...))
+	}
+
+	if manifest.APIVersion != APIVersion {
+		return invalid("unsupported apiVersion (%q), supported apiVersion: %q", manifest.APIVersion, APIVersion)
+	}
+	if manifest.Kind != Kind {
+		return invalid("unsupported kind (%q), supported kind: %q", manifest.Kind, Kind)
+	}
+	if manifest.Metadata.CladnetID == "" {
+		return invalid("metadata.cladnetId: must not be empty")
+	}
+
+	// The fields of the specification are the same as the ones of the service
+	if err := validator.CheckSpecification(manifest.Specification()); err != nil {
+		fieldError := validator.FieldErrors(err)[0]
+		field := "spec." + fieldError.Field
+		if fieldError.Field == "cladnetId" {
+			field = "metadata.cladnetId"
+		}
+		return invalid("%s: %s", field, fieldError.Description)
+	}
+
+	spec := manifest.Spec
+	_, ipv4Net, _ := net.ParseCIDR(spec.Ipv4AddressSpace)
+
+	if spec.Policies.MaxPeers < 0 {
+		return invalid("spec.policies.maxPeers: must not be negative (%d)", spec.Policies.MaxPeers)
+	}
+
+	hostIDs := make(map[string]bool)
+	ips := make(map[string]bool)
+	for i, reservation := range spec.Reservations {
+		if err := etcdkey.ValidateID(reservation.HostID); err != nil {
+			return invalid("spec.reservations[%d].hostId: %v", i, err)
+		}
+		if hostIDs[reservation.HostID] {
+			return invalid("spec.reservations[%d].hostId: duplicated (%v)", i, reservation.HostID)
+		}
+		hostIDs[reservation.HostID] = true
+
+		if !isAssignable(ipv4Net, reservation.IP) {
+			return invalid("spec.reservations[%d].ip: not an assignable IP address in %v (%q)", i, spec.Ipv4AddressSpace, reservation.IP)
+		}
+		if ips[reservation.IP] {
+			return invalid("spec.reservations[%d].ip: duplicated (%v)", i, reservation.IP)
+		}
+		ips[reservation.IP] = true
+	}
+
+	return nil
+}
+
+// isAssignable checks if an IP address can be assigned to a peer,
+// excluding the network address, the gateway address (i.e., the 1st one) and the broadcast address.
+func isAssignable(ipv4Net *net.IPNet, ipAddress string) bool {
+	ip := net.ParseIP(ipAddress).To4()
+	if ip == nil || !ipv4Net.Contains(ip) {
+		return false
+	}
+
+	firstIP := binary.BigEndian.Uint32(ipv4Net.IP.To4())
+	subnetMask := binary.BigEndian.Uint32(ipv4Net.Mask)
+	lastIP := (firstIP & subnetMask) | (subnetMask ^ 0xffffffff)
+	candidate := binary.BigEndian.Uint32(ip)
+
+	return candidate >= firstIP+2 && candidate < lastIP
+}
+
+// Specification returns the CLADNet specification of a manifest
+func (manifest Manifest) Specification() model.CLADNetSpecification {
+	return model.CLADNetSpecification{
+		CladnetID:        manifest.Metadata.CladnetID,
+		Name:             manifest.Metadata.Name,
+		Ipv4AddressSpace: manifest.Spec.Ipv4AddressSpace,
+		Description:      manifest.Spec.Description,
+		RuleType:         manifest.Spec.RuleType,
+		Labels:           manifest.Metadata.Labels,
+		Reservations:     manifest.Spec.Reservations,
+		Policies:         manifest.Spec.Policies,
+	}
+}
+
+// Change represents a change of a field of a CLADNet by a manifest
+type Change struct {
+	Field    string
+	OldValue string
+	NewValue string
+	Unsafe   bool
+	Reason   string
+}
+
+// Diff computes the changes from the current specification and peers to the desired specification.
+// The current specification is nil if the CLADNet does not exist.
+// A change is unsafe if it may break the live peers.
+func Diff(current *model.CLADNetSpecification, desired model.CLADNetSpecification, peers []model.Peer) []Change {
+	var changes []Change
+
+	if current == nil {
+		current = &model.CLADNetSpecification{CladnetID: desired.CladnetID}
+	}
+
+	addChange := func(field, oldValue, newValue string) *Change {
+		if oldValue == newValue {
+			return nil
+		}
+		changes = append(changes, Change{Field: field, OldValue: oldValue, NewValue: newValue})
+		return &changes[len(changes)-1]
+	}
+
+	addChange("name", current.Name, desired.Name)
+	if change := addChange("ipv4AddressSpace", current.Ipv4AddressSpace, desired.Ipv4AddressSpace); change != nil && len(peers) > 0 {
+		change.Unsafe = true
+		change.Reason = fmt.Sprintf("%d peer(s) have IP addresses in %v (use readdress, or force to re-address them)", len(peers), current.Ipv4AddressSpace)
+	}
+	addChange("description", current.Description, desired.Description)
+	addChange("ruleType", current.RuleType, desired.RuleType)
+
+	// Labels
+	for _, key := range sortedKeys(current.Labels, desired.Labels) {
+		addChange("labels."+key, current.Labels[key], desired.Labels[key])
+	}
+
+	// Reservations
+	peerByHostID := make(map[string]model.Peer)
+	peerByIP := make(map[string]model.Peer)
+	for _, peer := range peers {
+		peerByHostID[peer.HostID] = peer
+		peerByIP[peer.IP] = peer
+	}
+
+	currentReservations := make(map[string]string)
+	for _, reservation := range current.Reservations {
+		currentReservations[reservation.HostID] = reservation.IP
+	}
+	desiredReservations := make(map[string]string)
+	for _, reservation := range desired.Reservations {
+		desiredReservations[reservation.HostID] = reservation.IP
+	}
+
+	for _, hostID := range sortedKeys(currentReservations, desiredReservations) {
+		ip := desiredReservations[hostID]
+		change := addChange("reservations."+hostID, currentReservations[hostID], ip)
+		if change == nil || ip == "" {
+			continue
+		}
+		if peer, ok := peerByIP[ip]; ok && peer.HostID != hostID {
+			change.Unsafe = true
+			change.Reason = fmt.Sprintf("%v is assigned to another peer (%v)", ip, peer.HostID)
+		} else if peer, ok := peerByHostID[hostID]; ok && peer.IP != ip {
+			change.Unsafe = true
+			change.Reason = fmt.Sprintf("the peer (%v) has another IP address (%v)", hostID, peer.IP)
+		}
+	}
+
+	// Policies
+	if change := addChange("policies.maxPeers", strconv.Itoa(current.Policies.MaxPeers), strconv.Itoa(desired.Policies.MaxPeers)); change != nil {
+		if desired.Policies.MaxPeers > 0 && len(peers) > desired.Policies.MaxPeers {
+			change.Unsafe = true
+			change.Reason = fmt.Sprintf("the CLADNet has %d peer(s)", len(peers))
+		}
+	}
+
+	return changes
+}
+
+// HasUnsafeChange checks if any of the changes is unsafe
+func HasUnsafeChange(changes []Change) bool {
+	for _, change := range changes {
+		if change.Unsafe {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(a, b map[string]string) []string {
+	keySet := make(map[string]bool)
+	for key := range a {
+		keySet[key] = true
+	}
+	for key := range b {
+		keySet[key] = true
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
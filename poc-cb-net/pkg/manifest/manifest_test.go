@@ -0,0 +1,275 @@
+package manifest
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+)
+
+const validManifest = `apiVersion: cb-network/v1
+kind: CLADNet
+metadata:
+  cladnetId: cladnet-01
+  name: my-cladnet
+  labels:
+    env: dev
+spec:
+  ipv4AddressSpace: 10.77.0.0/24
+  description: A CLADNet for the development
+  reservations:
+    - hostId: host-01
+      ip: 10.77.0.10
+  policies:
+    maxPeers: 10
+`
+
+func TestParse(t *testing.T) {
+	manifest, err := Parse([]byte(validManifest))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := model.CLADNetSpecification{
+		CladnetID:        "cladnet-01",
+		Name:             "my-cladnet",
+		Ipv4AddressSpace: "10.77.0.0/24",
+		Description:      "A CLADNet for the development",
+		RuleType:         "basic", // by default
+		Labels:           map[string]string{"env": "dev"},
+		Reservations:     []model.IPReservation{{HostID: "host-01", IP: "10.77.0.10"}},
+		Policies:         model.CLADNetPolicies{MaxPeers: 10},
+	}
+	if got := manifest.Specification(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Specification() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		old       string
+		new       string
+		wantField string
+	}{
+		{name: "YAML syntax", old: "kind: CLADNet", new: "kind: [CLADNet", wantField: "yaml"},
+		{name: "unknown field", old: "  description:", new: "  descriptions:", wantField: "descriptions"},
+		{name: "wrong type", old: "maxPeers: 10", new: "maxPeers: ten", wantField: "yaml"},
+		{name: "apiVersion", old: "cb-network/v1", new: "cb-network/v2", wantField: "apiVersion"},
+		{name: "kind", old: "kind: CLADNet", new: "kind: Network", wantField: "kind"},
+		{name: "no cladnetId", old: "cladnetId: cladnet-01", new: "cladnetId: \"\"", wantField: "metadata.cladnetId"},
+		{name: "invalid cladnetId", old: "cladnetId: cladnet-01", new: "cladnetId: cladnet/01", wantField: "metadata.cladnetId"},
+		{name: "public address space", old: "ipv4AddressSpace: 10.77.0.0/24", new: "ipv4AddressSpace: 8.8.8.0/24", wantField: "spec.ipv4AddressSpace"},
+		{name: "unknown rule type", old: "  description:", new: "  ruleType: fastest\n  description:", wantField: "spec.ruleType"},
+		{name: "negative maxPeers", old: "maxPeers: 10", new: "maxPeers: -1", wantField: "spec.policies.maxPeers"},
+		{name: "reservation out of the range", old: "ip: 10.77.0.10", new: "ip: 10.78.0.10", wantField: "spec.reservations[0].ip"},
+		{name: "reservation of the gateway", old: "ip: 10.77.0.10", new: "ip: 10.77.0.1", wantField: "spec.reservations[0].ip"},
+		{name: "duplicated reservation host", old: "      ip: 10.77.0.10\n", new: "      ip: 10.77.0.10\n    - hostId: host-01\n      ip: 10.77.0.11\n", wantField: "spec.reservations[1].hostId"},
+		{name: "duplicated reservation IP", old: "      ip: 10.77.0.10\n", new: "      ip: 10.77.0.10\n    - hostId: host-02\n      ip: 10.77.0.10\n", wantField: "spec.reservations[1].ip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := strings.Replace(validManifest, tt.old, tt.new, 1)
+			if data == validManifest {
+				t.Fatalf("the manifest is not changed by %q", tt.old)
+			}
+
+			_, err := Parse([]byte(data))
+			if !errors.Is(err, ErrInvalidManifest) {
+				t.Fatalf("Parse() error = %v, want ErrInvalidManifest", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantField) {
+				t.Errorf("Parse() error = %v, want the field %v", err, tt.wantField)
+			}
+		})
+	}
+}
+
+// newSpec returns a specification of a CLADNet changed by a function
+func newSpec(change func(spec *model.CLADNetSpecification)) model.CLADNetSpecification {
+	spec := model.CLADNetSpecification{
+		CladnetID:        "cladnet-01",
+		Name:             "my-cladnet",
+		Ipv4AddressSpace: "10.77.0.0/24",
+		Description:      "dev",
+		RuleType:         "basic",
+		Labels:           map[string]string{"env": "dev", "team": "a"},
+		Reservations:     []model.IPReservation{{HostID: "host-01", IP: "10.77.0.10"}},
+		Policies:         model.CLADNetPolicies{MaxPeers: 10},
+	}
+	if change != nil {
+		change(&spec)
+	}
+	return spec
+}
+
+func TestDiff(t *testing.T) {
+	current := newSpec(nil)
+	peers := []model.Peer{
+		{CladnetID: "cladnet-01", HostID: "host-01", IP: "10.77.0.10"},
+		{CladnetID: "cladnet-01", HostID: "host-02", IP: "10.77.0.2"},
+	}
+
+	tests := []struct {
+		name    string
+		current *model.CLADNetSpecification
+		desired model.CLADNetSpecification
+		peers   []model.Peer
+		want    []Change
+	}{
+		{
+			name:    "no change",
+			current: &current,
+			desired: newSpec(nil),
+			peers:   peers,
+			want:    nil,
+		},
+		{
+			name:    "new CLADNet",
+			current: nil,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Description, spec.Labels, spec.Reservations, spec.Policies = "", nil, nil, model.CLADNetPolicies{}
+			}),
+			want: []Change{
+				{Field: "name", NewValue: "my-cladnet"},
+				{Field: "ipv4AddressSpace", NewValue: "10.77.0.0/24"},
+				{Field: "ruleType", NewValue: "basic"},
+			},
+		},
+		{
+			name:    "spec fields",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Name, spec.Description, spec.RuleType = "renamed", "prod", "cost-prioritized"
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "name", OldValue: "my-cladnet", NewValue: "renamed"},
+				{Field: "description", OldValue: "dev", NewValue: "prod"},
+				{Field: "ruleType", OldValue: "basic", NewValue: "cost-prioritized"},
+			},
+		},
+		{
+			name:    "address space without peers",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Ipv4AddressSpace = "10.78.0.0/24"
+			}),
+			want: []Change{
+				{Field: "ipv4AddressSpace", OldValue: "10.77.0.0/24", NewValue: "10.78.0.0/24"},
+			},
+		},
+		{
+			name:    "address space with peers",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Ipv4AddressSpace = "10.78.0.0/24"
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "ipv4AddressSpace", OldValue: "10.77.0.0/24", NewValue: "10.78.0.0/24", Unsafe: true},
+			},
+		},
+		{
+			name:    "labels",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Labels = map[string]string{"env": "prod", "owner": "b"}
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "labels.env", OldValue: "dev", NewValue: "prod"},
+				{Field: "labels.owner", NewValue: "b"},
+				{Field: "labels.team", OldValue: "a"},
+			},
+		},
+		{
+			name:    "reservations",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Reservations = []model.IPReservation{{HostID: "host-03", IP: "10.77.0.30"}}
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "reservations.host-01", OldValue: "10.77.0.10"},
+				{Field: "reservations.host-03", NewValue: "10.77.0.30"},
+			},
+		},
+		{
+			name:    "reservation of an IP address assigned to another peer",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Reservations = append(spec.Reservations, model.IPReservation{HostID: "host-03", IP: "10.77.0.2"})
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "reservations.host-03", NewValue: "10.77.0.2", Unsafe: true},
+			},
+		},
+		{
+			name:    "reservation changed for a peer having another IP address",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Reservations = []model.IPReservation{{HostID: "host-01", IP: "10.77.0.11"}}
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "reservations.host-01", OldValue: "10.77.0.10", NewValue: "10.77.0.11", Unsafe: true},
+			},
+		},
+		{
+			name:    "reservation changed without peers",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Reservations = []model.IPReservation{{HostID: "host-01", IP: "10.77.0.11"}}
+			}),
+			want: []Change{
+				{Field: "reservations.host-01", OldValue: "10.77.0.10", NewValue: "10.77.0.11"},
+			},
+		},
+		{
+			name:    "policies",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Policies.MaxPeers = 0
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "policies.maxPeers", OldValue: "10", NewValue: "0"},
+			},
+		},
+		{
+			name:    "policies below the peers",
+			current: &current,
+			desired: newSpec(func(spec *model.CLADNetSpecification) {
+				spec.Policies.MaxPeers = 1
+			}),
+			peers: peers,
+			want: []Change{
+				{Field: "policies.maxPeers", OldValue: "10", NewValue: "1", Unsafe: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.current, tt.desired, tt.peers)
+
+			// The reasons are explained only for the unsafe changes
+			for i, change := range got {
+				if (change.Reason != "") != change.Unsafe {
+					t.Errorf("Diff()[%d] = %+v, want a reason only if unsafe", i, change)
+				}
+				got[i].Reason = ""
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %+v, want %+v", got, tt.want)
+			}
+			if HasUnsafeChange(got) != HasUnsafeChange(tt.want) {
+				t.Errorf("HasUnsafeChange() = %v, want %v", HasUnsafeChange(got), HasUnsafeChange(tt.want))
+			}
+		})
+	}
+}
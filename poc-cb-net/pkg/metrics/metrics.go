@@ -0,0 +1,391 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the default upper bounds (seconds) of a histogram for latencies
+var DefaultBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// SizeBuckets are the upper bounds (bytes) of a histogram for sizes
+var SizeBuckets = []float64{64, 256, 1024, 4096, 16384, 65536, 262144, 1048576}
+
+// DefaultRegistry is the registry of the metrics created by NewCounterVec, NewGaugeVec and NewHistogramVec
+var DefaultRegistry = NewRegistry()
+
+// collector represents a metric family to be exposed
+type collector interface {
+	name() string
+	write(w *bufio.Writer)
+}
+
+// Registry represents a set of metric families exposed in the Prometheus text format
+type Registry struct {
+	mutex      sync.RWMutex
+	collectors map[string]collector
+}
+
+// NewRegistry represents a constructor of Registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]collector)}
+}
+
+// register registers a metric family. It panics if the name is already registered.
+func (registry *Registry) register(c collector) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if _, ok := registry.collectors[c.name()]; ok {
+		panic(fmt.Sprintf("duplicate metric: %v", c.name()))
+	}
+	registry.collectors[c.name()] = c
+}
+
+// ServeHTTP exposes the metrics in the Prometheus text format (version 0.0.4)
+func (registry *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	registry.mutex.RLock()
+	names := make([]string, 0, len(registry.collectors))
+	for name := range registry.collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	collectors := make([]collector, 0, len(names))
+	for _, name := range names {
+		collectors = append(collectors, registry.collectors[name])
+	}
+	registry.mutex.RUnlock()
+
+	writer := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(writer)
+	}
+	writer.Flush()
+}
+
+// ListenAndServe represents a function to serve the metrics of the default registry on "/metrics"
+func ListenAndServe(address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", DefaultRegistry)
+	server := &http.Server{Addr: address, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	return server.ListenAndServe()
+}
+
+// family represents the common part of a metric family with labels
+type family struct {
+	metricName string
+	help       string
+	metricType string
+	labelNames []string
+
+	mutex    sync.Mutex
+	children map[string]interface{}
+	order    []string
+}
+
+func (f *family) name() string {
+	return f.metricName
+}
+
+// child returns the metric of the label values, which is created by the function if not exists
+func (f *family) child(labelValues []string, create func() interface{}) interface{} {
+	if len(labelValues) != len(f.labelNames) {
+		panic(fmt.Sprintf("%v: %d label value(s) for %d label(s)", f.metricName, len(labelValues), len(f.labelNames)))
+	}
+	key := strings.Join(labelValues, "\xff")
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	c, ok := f.children[key]
+	if !ok {
+		c = create()
+		f.children[key] = c
+		f.order = append(f.order, key)
+		sort.Strings(f.order)
+	}
+	return c
+}
+
+// remove removes the metric of the label values
+func (f *family) remove(labelValues []string) {
+	key := strings.Join(labelValues, "\xff")
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if _, ok := f.children[key]; !ok {
+		return
+	}
+	delete(f.children, key)
+	for i, k := range f.order {
+		if k == key {
+			f.order = append(f.order[:i], f.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// each calls the function with the label values and the metric in order
+func (f *family) each(fn func(labelValues []string, c interface{})) {
+	f.mutex.Lock()
+	keys := append([]string(nil), f.order...)
+	children := make([]interface{}, len(keys))
+	for i, key := range keys {
+		children[i] = f.children[key]
+	}
+	f.mutex.Unlock()
+
+	for i, key := range keys {
+		var labelValues []string
+		if len(f.labelNames) > 0 {
+			labelValues = strings.Split(key, "\xff")
+		}
+		fn(labelValues, children[i])
+	}
+}
+
+func (f *family) writeHeader(w *bufio.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n", f.metricName, strings.NewReplacer("\\", `\\`, "\n", `\n`).Replace(f.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.metricName, f.metricType)
+}
+
+// formatLabels formats the labels, with an extra label (e.g., "le") if given
+func formatLabels(labelNames []string, labelValues []string, extraName string, extraValue string) string {
+	var pairs []string
+	escaper := strings.NewReplacer("\\", `\\`, "\n", `\n`, `"`, `\"`)
+	for i, labelName := range labelNames {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labelName, escaper.Replace(labelValues[i])))
+	}
+	if extraName != "" {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, extraName, extraValue))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// value represents a float64 value updated concurrently
+type value struct {
+	mutex sync.Mutex
+	v     float64
+}
+
+func (v *value) add(delta float64) {
+	v.mutex.Lock()
+	v.v += delta
+	v.mutex.Unlock()
+}
+
+func (v *value) set(newValue float64) {
+	v.mutex.Lock()
+	v.v = newValue
+	v.mutex.Unlock()
+}
+
+func (v *value) get() float64 {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	return v.v
+}
+
+// Counter represents a value only increasing
+type Counter struct {
+	value
+}
+
+// Inc increases the counter by 1
+func (c *Counter) Inc() {
+	c.add(1)
+}
+
+// Add increases the counter by a non-negative delta
+func (c *Counter) Add(delta float64) {
+	if delta < 0 {
+		return
+	}
+	c.add(delta)
+}
+
+// Value returns the current value of the counter
+func (c *Counter) Value() float64 {
+	return c.get()
+}
+
+// CounterVec represents counters partitioned by labels
+type CounterVec struct {
+	family
+}
+
+// NewCounterVec represents a constructor of CounterVec registered on the default registry
+func NewCounterVec(name string, help string, labelNames ...string) *CounterVec {
+	counterVec := &CounterVec{family{metricName: name, help: help, metricType: "counter", labelNames: labelNames, children: make(map[string]interface{})}}
+	DefaultRegistry.register(counterVec)
+	return counterVec
+}
+
+// WithLabelValues returns the counter of the label values
+func (counterVec *CounterVec) WithLabelValues(labelValues ...string) *Counter {
+	return counterVec.child(labelValues, func() interface{} { return &Counter{} }).(*Counter)
+}
+
+// Delete deletes the counter of the label values (e.g., of a removed peer)
+func (counterVec *CounterVec) Delete(labelValues ...string) {
+	counterVec.remove(labelValues)
+}
+
+func (counterVec *CounterVec) write(w *bufio.Writer) {
+	counterVec.writeHeader(w)
+	counterVec.each(func(labelValues []string, c interface{}) {
+		fmt.Fprintf(w, "%s%s %s\n", counterVec.metricName, formatLabels(counterVec.labelNames, labelValues, "", ""), formatFloat(c.(*Counter).Value()))
+	})
+}
+
+// Gauge represents a value going up and down
+type Gauge struct {
+	value
+}
+
+// Set sets the gauge
+func (g *Gauge) Set(v float64) {
+	g.set(v)
+}
+
+// Add adds a delta (which may be negative) to the gauge
+func (g *Gauge) Add(delta float64) {
+	g.add(delta)
+}
+
+// Value returns the current value of the gauge
+func (g *Gauge) Value() float64 {
+	return g.get()
+}
+
+// GaugeVec represents gauges partitioned by labels
+type GaugeVec struct {
+	family
+}
+
+// NewGaugeVec represents a constructor of GaugeVec registered on the default registry
+func NewGaugeVec(name string, help string, labelNames ...string) *GaugeVec {
+	gaugeVec := &GaugeVec{family{metricName: name, help: help, metricType: "gauge", labelNames: labelNames, children: make(map[string]interface{})}}
+	DefaultRegistry.register(gaugeVec)
+	return gaugeVec
+}
+
+// WithLabelValues returns the gauge of the label values
+func (gaugeVec *GaugeVec) WithLabelValues(labelValues ...string) *Gauge {
+	return gaugeVec.child(labelValues, func() interface{} { return &Gauge{} }).(*Gauge)
+}
+
+// Delete deletes the gauge of the label values
+func (gaugeVec *GaugeVec) Delete(labelValues ...string) {
+	gaugeVec.remove(labelValues)
+}
+
+func (gaugeVec *GaugeVec) write(w *bufio.Writer) {
+	gaugeVec.writeHeader(w)
+	gaugeVec.each(func(labelValues []string, g interface{}) {
+		fmt.Fprintf(w, "%s%s %s\n", gaugeVec.metricName, formatLabels(gaugeVec.labelNames, labelValues, "", ""), formatFloat(g.(*Gauge).Value()))
+	})
+}
+
+// Histogram represents the distribution of observations in buckets
+type Histogram struct {
+	mutex        sync.Mutex
+	upperBounds  []float64
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+}
+
+// Observe adds an observation
+func (h *Histogram) Observe(v float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for i, upperBound := range h.upperBounds {
+		if v <= upperBound {
+			h.bucketCounts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// ObserveSince adds the elapsed time (seconds) from the start
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// HistogramVec represents histograms partitioned by labels
+type HistogramVec struct {
+	family
+	buckets []float64
+}
+
+// NewHistogramVec represents a constructor of HistogramVec registered on the default registry.
+// DefaultBuckets are used if buckets are nil.
+func NewHistogramVec(name string, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	histogramVec := &HistogramVec{
+		family:  family{metricName: name, help: help, metricType: "histogram", labelNames: labelNames, children: make(map[string]interface{})},
+		buckets: buckets,
+	}
+	DefaultRegistry.register(histogramVec)
+	return histogramVec
+}
+
+// WithLabelValues returns the histogram of the label values
+func (histogramVec *HistogramVec) WithLabelValues(labelValues ...string) *Histogram {
+	return histogramVec.child(labelValues, func() interface{} {
+		return &Histogram{upperBounds: histogramVec.buckets, bucketCounts: make([]uint64, len(histogramVec.buckets))}
+	}).(*Histogram)
+}
+
+func (histogramVec *HistogramVec) write(w *bufio.Writer) {
+	histogramVec.writeHeader(w)
+	histogramVec.each(func(labelValues []string, c interface{}) {
+		h := c.(*Histogram)
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+
+		// The buckets are cumulative
+		var cumulative uint64
+		for i, upperBound := range h.upperBounds {
+			cumulative += h.bucketCounts[i]
+			fmt.Fprintf(w, "%s_bucket%s %d\n", histogramVec.metricName,
+				formatLabels(histogramVec.labelNames, labelValues, "le", formatFloat(upperBound)), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", histogramVec.metricName,
+			formatLabels(histogramVec.labelNames, labelValues, "le", "+Inf"), h.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", histogramVec.metricName, formatLabels(histogramVec.labelNames, labelValues, "", ""), formatFloat(h.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", histogramVec.metricName, formatLabels(histogramVec.labelNames, labelValues, "", ""), h.count)
+	})
+}
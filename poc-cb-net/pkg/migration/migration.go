@@ -0,0 +1,350 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+)
+
+var (
+	// ErrMigrationRequired is returned if the registry has an older schema version than this build
+	ErrMigrationRequired = errors.New("migration required")
+
+	// ErrNewerSchema is returned if the registry has a newer schema version than this build
+	ErrNewerSchema = errors.New("newer schema version")
+
+	// ErrConflict is returned if an item has been changed while migrating
+	ErrConflict = errors.New("changed while migrating")
+)
+
+// legacySchemaVersion is the version of a registry which does not record the schema version
+const legacySchemaVersion = 1
+
+// upgradeFunc upgrades a value to the next schema version
+type upgradeFunc func(value string) (string, error)
+
+// Step upgrades the stored values from a schema version to the next version
+type Step struct {
+	FromVersion int
+	Description string
+	// Upgrades by the key prefixes of the values to upgrade
+	Upgrades map[string]upgradeFunc
+}
+
+// steps are the migration steps in order, one per schema version (see etcdkey.CurrentSchemaVersion)
+var steps = []Step{
+	{
+		FromVersion: 1,
+		Description: "Tag the values of CLADNet specifications, peers and networking rules with the schema version",
+		Upgrades: map[string]upgradeFunc{
+			etcdkey.FamilyPrefix(etcdkey.CLADNetSpecification): func(value string) (string, error) {
+				var spec model.CLADNetSpecification
+				return tag(value, &spec, func() { spec.SchemaVersion = 2 })
+			},
+			etcdkey.FamilyPrefix(etcdkey.Peer): func(value string) (string, error) {
+				var peer model.Peer
+				return tag(value, &peer, func() { peer.SchemaVersion = 2 })
+			},
+			etcdkey.FamilyPrefix(etcdkey.NetworkingRule): func(value string) (string, error) {
+				var rule model.NetworkingRule
+				return tag(value, &rule, func() { rule.SchemaVersion = 2 })
+			},
+		},
+	},
+}
+
+// tag unmarshals a value to a model, sets the schema version, and marshals it again
+func tag(value string, v interface{}, setVersion func()) (string, error) {
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		return "", err
+	}
+	setVersion()
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// Change represents a change of a value by a migration
+type Change struct {
+	Key      string
+	Revision int64 // Modification revision read on planning
+	OldValue string
+	NewValue string
+}
+
+// Plan represents changes to migrate the registry from a schema version to another
+type Plan struct {
+	FromVersion int
+	ToVersion   int
+	Steps       []Step
+	Changes     []Change
+}
+
+// Snapshot represents the values before a migration, by which the migration can be rolled back
+type Snapshot struct {
+	FromVersion int              `json:"fromVersion"`
+	ToVersion   int              `json:"toVersion"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	KeyValues   []store.KeyValue `json:"keyValues"`
+}
+
+// DetectSchemaVersion returns the schema version of the registry.
+// If the version is not recorded, the registry is regarded as a legacy one if it has any CLADNet,
+// otherwise as a new one (i.e., the current version).
+func DetectSchemaVersion(ctx context.Context, cbnetStore store.Store) (version int, isRecorded bool, err error) {
+	version, err = cbnetStore.GetSchemaVersion(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if version != 0 {
+		return version, true, nil
+	}
+
+	specs, err := cbnetStore.ListKeyValues(ctx, etcdkey.FamilyPrefix(etcdkey.CLADNetSpecification))
+	if err != nil {
+		return 0, false, err
+	}
+	if len(specs) != 0 {
+		return legacySchemaVersion, false, nil
+	}
+	return etcdkey.CurrentSchemaVersion, false, nil
+}
+
+// CheckSchemaVersion checks if the registry has the schema version of this build.
+// It records the version if the registry is a new one.
+func CheckSchemaVersion(ctx context.Context, cbnetStore store.Store) error {
+	version, isRecorded, err := DetectSchemaVersion(ctx, cbnetStore)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case version < etcdkey.CurrentSchemaVersion:
+		return fmt.Errorf("%w: the registry has schema version %d, but %d is required (run 'cb-network migrate')",
+			ErrMigrationRequired, version, etcdkey.CurrentSchemaVersion)
+	case version > etcdkey.CurrentSchemaVersion:
+		return fmt.Errorf("%w: the registry has schema version %d, but this build supports up to %d",
+			ErrNewerSchema, version, etcdkey.CurrentSchemaVersion)
+	case !isRecorded:
+		return cbnetStore.PutSchemaVersion(ctx, version)
+	}
+	return nil
+}
+
+// NewPlan plans the changes to migrate the registry to a schema version.
+// Nothing is changed until the plan is applied.
+func NewPlan(ctx context.Context, cbnetStore store.Store, toVersion int) (*Plan, error) {
+	fromVersion, _, err := DetectSchemaVersion(ctx, cbnetStore)
+	if err != nil {
+		return nil, err
+	}
+
+	if toVersion > etcdkey.CurrentSchemaVersion {
+		return nil, fmt.Errorf("%w: this build supports up to schema version %d", ErrNewerSchema, etcdkey.CurrentSchemaVersion)
+	}
+	if toVersion < fromVersion {
+		return nil, fmt.Errorf("downgrade from schema version %d to %d is not supported (roll back by a snapshot)", fromVersion, toVersion)
+	}
+
+	plan := &Plan{FromVersion: fromVersion, ToVersion: toVersion}
+
+	// Upgrade the values step by step in memory
+	items := make(map[string]*Change)
+	for version := fromVersion; version < toVersion; version++ {
+		step, err := findStep(version)
+		if err != nil {
+			return nil, err
+		}
+		plan.Steps = append(plan.Steps, step)
+
+		for prefix, upgrade := range step.Upgrades {
+			kvs, err := cbnetStore.ListKeyValues(ctx, prefix)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, kv := range kvs {
+				item, ok := items[kv.Key]
+				if !ok {
+					item = &Change{Key: kv.Key, Revision: kv.Revision, OldValue: kv.Value, NewValue: kv.Value}
+					items[kv.Key] = item
+				}
+
+				upgraded, err := upgrade(item.NewValue)
+				if err != nil {
+					return nil, fmt.Errorf("failed to upgrade %v from schema version %d: %v", kv.Key, version, err)
+				}
+				item.NewValue = upgraded
+			}
+		}
+	}
+
+	for _, item := range items {
+		if item.NewValue != item.OldValue {
+			plan.Changes = append(plan.Changes, *item)
+		}
+	}
+	sort.Slice(plan.Changes, func(i, j int) bool { return plan.Changes[i].Key < plan.Changes[j].Key })
+
+	return plan, nil
+}
+
+func findStep(fromVersion int) (Step, error) {
+	for _, step := range steps {
+		if step.FromVersion == fromVersion {
+			return step, nil
+		}
+	}
+	return Step{}, fmt.Errorf("no migration step from schema version %d", fromVersion)
+}
+
+// String returns a human-readable description of a plan (e.g., for dry-run)
+func (plan *Plan) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Schema version: %d -> %d\n", plan.FromVersion, plan.ToVersion)
+	for _, step := range plan.Steps {
+		fmt.Fprintf(&sb, "Step %d -> %d: %s\n", step.FromVersion, step.FromVersion+1, step.Description)
+	}
+	for _, change := range plan.Changes {
+		fmt.Fprintf(&sb, "~ %s\n", change.Key)
+		fmt.Fprintf(&sb, "  - %s\n", change.OldValue)
+		fmt.Fprintf(&sb, "  + %s\n", change.NewValue)
+	}
+	fmt.Fprintf(&sb, "%d item(s) to change\n", len(plan.Changes))
+
+	return sb.String()
+}
+
+// Apply takes a snapshot in the directory, and applies the plan to the registry.
+// It returns the path of the snapshot, by which the migration can be rolled back.
+func (plan *Plan) Apply(ctx context.Context, cbnetStore store.Store, snapshotDir string) (string, error) {
+
+	// Check if the registry has not been migrated since the plan was made
+	version, _, err := DetectSchemaVersion(ctx, cbnetStore)
+	if err != nil {
+		return "", err
+	}
+	if version != plan.FromVersion {
+		return "", fmt.Errorf("%w: the schema version is %d, but the plan is from %d", ErrConflict, version, plan.FromVersion)
+	}
+
+	snapshot := Snapshot{
+		FromVersion: plan.FromVersion,
+		ToVersion:   plan.ToVersion,
+		CreatedAt:   time.Now(),
+	}
+	for _, change := range plan.Changes {
+		snapshot.KeyValues = append(snapshot.KeyValues, store.KeyValue{Key: change.Key, Value: change.OldValue, Revision: change.Revision})
+	}
+
+	snapshotPath, err := saveSnapshot(snapshotDir, snapshot)
+	if err != nil {
+		return "", err
+	}
+
+	// Put each value only if it has not been changed since the plan was made
+	for i, change := range plan.Changes {
+		isPut, err := cbnetStore.CompareAndPutKeyValue(ctx, store.KeyValue{Key: change.Key, Revision: change.Revision}, change.NewValue)
+		if err != nil {
+			return snapshotPath, err
+		}
+		if !isPut {
+			// Undo the values put so far, so that the registry is left as it was (except the change in the meantime)
+			if err := undo(ctx, cbnetStore, plan.Changes[:i]); err != nil {
+				return snapshotPath, fmt.Errorf("%w: %v, and failed to undo (roll back by %v): %v", ErrConflict, change.Key, snapshotPath, err)
+			}
+			return snapshotPath, fmt.Errorf("%w: %v (nothing has been migrated, so plan and apply again)", ErrConflict, change.Key)
+		}
+	}
+
+	if err := cbnetStore.PutSchemaVersion(ctx, plan.ToVersion); err != nil {
+		return snapshotPath, err
+	}
+	return snapshotPath, nil
+}
+
+// undo puts the old values of the changes back, only if each value has not been changed since it was put
+func undo(ctx context.Context, cbnetStore store.Store, changes []Change) error {
+	var changed []string
+	for _, change := range changes {
+		kvs, err := cbnetStore.ListKeyValues(ctx, change.Key)
+		if err != nil {
+			return err
+		}
+
+		isUndone := false
+		for _, kv := range kvs {
+			if kv.Key != change.Key || kv.Value != change.NewValue {
+				continue
+			}
+			isUndone, err = cbnetStore.CompareAndPutKeyValue(ctx, kv, change.OldValue)
+			if err != nil {
+				return err
+			}
+		}
+		if !isUndone {
+			changed = append(changed, change.Key)
+		}
+	}
+
+	if len(changed) > 0 {
+		return fmt.Errorf("changed after migrated: %v", strings.Join(changed, ", "))
+	}
+	return nil
+}
+
+// saveSnapshot saves a snapshot as a JSON file in the directory
+func saveSnapshot(snapshotDir string, snapshot Snapshot) (string, error) {
+	if err := os.MkdirAll(snapshotDir, 0700); err != nil {
+		return "", err
+	}
+
+	bytes, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	fileName := fmt.Sprintf("schema-v%d-to-v%d-%s.json", snapshot.FromVersion, snapshot.ToVersion, snapshot.CreatedAt.Format("20060102T150405"))
+	snapshotPath := filepath.Join(snapshotDir, fileName)
+	if err := os.WriteFile(snapshotPath, bytes, 0600); err != nil {
+		return "", err
+	}
+	return snapshotPath, nil
+}
+
+// LoadSnapshot loads a snapshot from a JSON file
+func LoadSnapshot(snapshotPath string) (Snapshot, error) {
+	var snapshot Snapshot
+
+	bytes, err := os.ReadFile(snapshotPath)
+	if err != nil {
+		return snapshot, err
+	}
+	if err := json.Unmarshal(bytes, &snapshot); err != nil {
+		return snapshot, fmt.Errorf("invalid snapshot (%v): %v", snapshotPath, err)
+	}
+	return snapshot, nil
+}
+
+// Rollback restores the values and the schema version of a snapshot
+func Rollback(ctx context.Context, cbnetStore store.Store, snapshot Snapshot) error {
+	for _, kv := range snapshot.KeyValues {
+		if err := cbnetStore.PutKeyValue(ctx, kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+	return cbnetStore.PutSchemaVersion(ctx, snapshot.FromVersion)
+}
@@ -0,0 +1,221 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/store"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// newLegacyStore creates a memory store having the values of a legacy registry (i.e., without the schema version),
+// and returns the values by key
+func newLegacyStore(t *testing.T) (store.Store, map[string]string) {
+	t.Helper()
+	cbnetStore := store.NewMemoryStore()
+	return cbnetStore, putLegacyValues(t, cbnetStore)
+}
+
+// putLegacyValues puts the values of a legacy registry to a store, and returns the values by key
+func putLegacyValues(t *testing.T, cbnetStore store.Store) map[string]string {
+	t.Helper()
+	ctx := context.Background()
+
+	specKey, _ := etcdkey.CLADNetSpecificationKey("cladnet-01")
+	peerKey1, _ := etcdkey.PeerKey("cladnet-01", "host-01")
+	peerKey2, _ := etcdkey.PeerKey("cladnet-01", "host-02")
+	ruleKey, _ := etcdkey.NetworkingRuleKey("cladnet-01", "host-01")
+	values := map[string]string{
+		specKey:  `{"cladnetId":"cladnet-01","ipv4AddressSpace":"10.0.0.0/24"}`,
+		peerKey1: `{"cladnetId":"cladnet-01","hostId":"host-01","ip":"10.0.0.2"}`,
+		peerKey2: `{"cladnetId":"cladnet-01","hostId":"host-02","ip":"10.0.0.3"}`,
+		ruleKey:  `{"cladnetId":"cladnet-01","hostId":["host-01","host-02"]}`,
+	}
+	for key, value := range values {
+		if err := cbnetStore.PutKeyValue(ctx, key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return values
+}
+
+// checkValues checks the values by key
+func checkValues(t *testing.T, cbnetStore store.Store, want map[string]string) {
+	t.Helper()
+	for key, wantValue := range want {
+		kvs, err := cbnetStore.ListKeyValues(context.Background(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 1 || kvs[0].Value != wantValue {
+			t.Errorf("the value of %v = %+v, want %v", key, kvs, wantValue)
+		}
+	}
+}
+
+// schemaVersionOf returns the schema version tagged in a value
+func schemaVersionOf(t *testing.T, value string) int {
+	t.Helper()
+	var v struct {
+		SchemaVersion int `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v.SchemaVersion
+}
+
+func TestMigrationRoundTrip(t *testing.T) {
+	cbnetStore, legacyValues := newLegacyStore(t)
+	testMigrationRoundTrip(t, cbnetStore, legacyValues)
+}
+
+// testMigrationRoundTrip plans, applies and rolls back a migration of a legacy registry
+func testMigrationRoundTrip(t *testing.T, cbnetStore store.Store, legacyValues map[string]string) {
+	ctx := context.Background()
+
+	if version, isRecorded, err := DetectSchemaVersion(ctx, cbnetStore); err != nil || version != 1 || isRecorded {
+		t.Fatalf("DetectSchemaVersion() = %v, %v, %v, want 1 (not recorded)", version, isRecorded, err)
+	}
+	if err := CheckSchemaVersion(ctx, cbnetStore); !errors.Is(err, ErrMigrationRequired) {
+		t.Errorf("CheckSchemaVersion() error = %v, want ErrMigrationRequired", err)
+	}
+
+	if _, err := NewPlan(ctx, cbnetStore, etcdkey.CurrentSchemaVersion+1); !errors.Is(err, ErrNewerSchema) {
+		t.Errorf("NewPlan() to a newer version error = %v, want ErrNewerSchema", err)
+	}
+
+	// Planning changes nothing
+	plan, err := NewPlan(ctx, cbnetStore, etcdkey.CurrentSchemaVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.FromVersion != 1 || plan.ToVersion != etcdkey.CurrentSchemaVersion || len(plan.Changes) != len(legacyValues) {
+		t.Fatalf("plan = %v -> %v with %d changes, want 1 -> %v with %d changes", plan.FromVersion, plan.ToVersion, len(plan.Changes), etcdkey.CurrentSchemaVersion, len(legacyValues))
+	}
+	checkValues(t, cbnetStore, legacyValues)
+
+	// Apply the plan
+	snapshotPath, err := plan.Apply(ctx, cbnetStore, t.TempDir())
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	migratedValues := map[string]string{}
+	for _, change := range plan.Changes {
+		if got := schemaVersionOf(t, change.NewValue); got != 2 {
+			t.Errorf("the schema version of %v = %v, want 2", change.Key, got)
+		}
+		migratedValues[change.Key] = change.NewValue
+	}
+	checkValues(t, cbnetStore, migratedValues)
+	if err := CheckSchemaVersion(ctx, cbnetStore); err != nil {
+		t.Errorf("CheckSchemaVersion() after migration error = %v", err)
+	}
+
+	// The plan is applied only once
+	if _, err := plan.Apply(ctx, cbnetStore, t.TempDir()); !errors.Is(err, ErrConflict) {
+		t.Errorf("Apply() again error = %v, want ErrConflict", err)
+	}
+
+	// Roll back by the snapshot
+	snapshot, err := LoadSnapshot(snapshotPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Rollback(ctx, cbnetStore, snapshot); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+	checkValues(t, cbnetStore, legacyValues)
+	if version, isRecorded, err := DetectSchemaVersion(ctx, cbnetStore); err != nil || version != 1 || !isRecorded {
+		t.Errorf("DetectSchemaVersion() after rollback = %v, %v, %v, want 1 (recorded)", version, isRecorded, err)
+	}
+}
+
+func TestMigrationConflict(t *testing.T) {
+	ctx := context.Background()
+	cbnetStore, legacyValues := newLegacyStore(t)
+
+	plan, err := NewPlan(ctx, cbnetStore, etcdkey.CurrentSchemaVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last value is changed after planning, so the values before it are put and then undone
+	changedKey := plan.Changes[len(plan.Changes)-1].Key
+	changedValue := `{"cladnetId":"cladnet-01","changed":true}`
+	if err := cbnetStore.PutKeyValue(ctx, changedKey, changedValue); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotPath, err := plan.Apply(ctx, cbnetStore, t.TempDir())
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("Apply() error = %v, want ErrConflict", err)
+	}
+	if snapshotPath == "" {
+		t.Error("Apply() returns no snapshot")
+	}
+
+	// Nothing has been migrated, and the change in the meantime is kept
+	wantValues := map[string]string{}
+	for key, value := range legacyValues {
+		wantValues[key] = value
+	}
+	wantValues[changedKey] = changedValue
+	checkValues(t, cbnetStore, wantValues)
+	if version, isRecorded, err := DetectSchemaVersion(ctx, cbnetStore); err != nil || version != 1 || isRecorded {
+		t.Errorf("DetectSchemaVersion() after the conflict = %v, %v, %v, want 1 (not recorded)", version, isRecorded, err)
+	}
+
+	// Plan and apply again
+	plan, err = NewPlan(ctx, cbnetStore, etcdkey.CurrentSchemaVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := plan.Apply(ctx, cbnetStore, t.TempDir()); err != nil {
+		t.Fatalf("Apply() again error = %v", err)
+	}
+	if err := CheckSchemaVersion(ctx, cbnetStore); err != nil {
+		t.Errorf("CheckSchemaVersion() error = %v", err)
+	}
+}
+
+// etcdEndpointsEnv is the environment variable of the etcd endpoints (comma-separated) to test a migration on etcd.
+// NOTE: An embedded etcd (go.etcd.io/etcd/server/v3/embed) is not a dependency of this module,
+// so the test is skipped unless an etcd is given. The whole registry is migrated, so the etcd must have no registry.
+const etcdEndpointsEnv = "CBNET_TEST_ETCD_ENDPOINTS"
+
+func TestMigrationOnEtcd(t *testing.T) {
+	ctx := context.Background()
+
+	endpoints := os.Getenv(etcdEndpointsEnv)
+	if endpoints == "" {
+		t.Skipf("%v is not set", etcdEndpointsEnv)
+	}
+
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(endpoints, ","),
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cbnetStore := store.NewEtcdStore(client)
+	defer cbnetStore.Close()
+
+	resp, err := client.Get(ctx, etcdkey.CloudAdaptiveNetwork, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 0 {
+		t.Skipf("the etcd has a registry (%d keys under %v)", resp.Count, etcdkey.CloudAdaptiveNetwork)
+	}
+	defer client.Delete(ctx, etcdkey.CloudAdaptiveNetwork, clientv3.WithPrefix())
+
+	testMigrationRoundTrip(t, cbnetStore, putLegacyValues(t, cbnetStore))
+}
@@ -1,15 +1,15 @@
 package nethelper
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
-	"math"
+	"math/bits"
 	"net"
 	"sort"
 )
 
 var privateNetworks []*net.IPNet
-var ip10, ip172, ip192 net.IP
 var ipnet10, ipnet172, ipnet192 *net.IPNet
 
 func init() {
@@ -32,11 +32,14 @@ func init() {
 	}
 
 	// Initialize IPs and networks of each private network
-	ip10, ipnet10, _ = net.ParseCIDR("10.0.0.0/8")
-	ip172, ipnet172, _ = net.ParseCIDR("172.16.0.0/12")
-	ip192, ipnet192, _ = net.ParseCIDR("192.168.0.0/16")
+	_, ipnet10, _ = net.ParseCIDR("10.0.0.0/8")
+	_, ipnet172, _ = net.ParseCIDR("172.16.0.0/12")
+	_, ipnet192, _ = net.ParseCIDR("192.168.0.0/16")
 }
 
+// ErrNoAvailableIPv4PrivateAddressSpace is returned if no free block fits the needed IPv4 address space
+var ErrNoAvailableIPv4PrivateAddressSpace = errors.New("no appropriate IPv4PrivateAddressSpace exists")
+
 // Models
 
 // IPNetworks represents a list of IP Network, such as 10.10.10.10/10, 172.16.10.10/16, and 192.168.10.10/24.
@@ -46,10 +49,11 @@ type IPNetworks struct {
 
 // AvailableIPv4PrivateAddressSpaces represents the specification of a Cloud Adaptive Network (CLADNet).
 type AvailableIPv4PrivateAddressSpaces struct {
-	RecommendedIPv4PrivateAddressSpace string   `json:"recommendedIPv4PrivateAddressSpace"`
-	AddressSpace10s                    []string `json:"addressSpace10S"`
-	AddressSpace172s                   []string `json:"addressSpace172S"`
-	AddressSpace192s                   []string `json:"addressSpace192S"`
+	RecommendedIPv4PrivateAddressSpace string          `json:"recommendedIPv4PrivateAddressSpace"`
+	AddressSpace10s                    []string        `json:"addressSpace10S"`
+	AddressSpace172s                   []string        `json:"addressSpace172S"`
+	AddressSpace192s                   []string        `json:"addressSpace192S"`
+	FreeBlocks                         []FreeIPv4Block `json:"freeBlocks"`
 }
 
 // Functions
@@ -66,176 +70,255 @@ func IncrementIP(ip net.IP, inc uint) net.IP {
 	return net.IPv4(v0, v1, v2, v3)
 }
 
-// initMap initializes a map with an integer key starting at 1
-func initMap(keyFrom int, keyTo int, initValue bool) map[int]bool {
-	m := make(map[int]bool)
-	for i := keyFrom; i <= keyTo; i++ {
-		m[i] = initValue
+// Destinations to look up the default routes (no packet is sent to them)
+const (
+	defaultRouteLookupIPv4 = "192.0.2.1:9"     // TEST-NET-1 (RFC5737)
+	defaultRouteLookupIPv6 = "[2001:db8::1]:9" // Documentation (RFC3849)
+)
+
+// DefaultRouteSourceIP represents a function to get the source IP address of the default route of an IP version
+// (i.e., "IPv4" or "IPv6"), which is chosen by the kernel routing table (including the policy routing).
+// No packet is sent because a UDP socket is only connected.
+func DefaultRouteSourceIP(version string) (net.IP, error) {
+	network, destination := "udp4", defaultRouteLookupIPv4
+	if version == "IPv6" {
+		network, destination = "udp6", defaultRouteLookupIPv6
 	}
-	return m
-}
 
-// getDescendingOrderedKeysOfMap
-func getDescendingOrderedKeysOfMap(m map[int]bool) []int {
-	// 	m := map[string]int{
-	// 	"Alice": 2,
-	// 	"Cecil": 1,
-	// 	"Bob":   3,
-	// }
+	conn, err := net.Dial(network, destination)
+	if err != nil {
+		return nil, fmt.Errorf("no default route for %v: %w", version, err)
+	}
+	defer conn.Close()
 
-	sortedKeys := make([]int, 0, len(m))
+	return conn.LocalAddr().(*net.UDPAddr).IP, nil
+}
 
-	for k := range m {
-		// fmt.Println("k", k)
-		sortedKeys = append(sortedKeys, k)
+// ReaddressIPv4 represents a function to map an IP address in an IPv4 address space to another address space.
+// The offset of the IP address from the network address is kept, so the mapping is deterministic.
+// It returns an error if the offset does not fit in the new address space
+// (excluding the network address, the 1st one for the gateway and the broadcast address).
+func ReaddressIPv4(ip string, oldIPv4CIDR string, newIPv4CIDR string) (newIP string, newIPWithPrefix string, err error) {
+	_, oldNet, err := net.ParseCIDR(oldIPv4CIDR)
+	if err != nil || oldNet.IP.To4() == nil {
+		return "", "", fmt.Errorf("not an IPv4 address space (%v)", oldIPv4CIDR)
+	}
+	_, newNet, err := net.ParseCIDR(newIPv4CIDR)
+	if err != nil || newNet.IP.To4() == nil {
+		return "", "", fmt.Errorf("not an IPv4 address space (%v)", newIPv4CIDR)
 	}
 
-	// Sort keys in descending order
-	sort.Sort(sort.Reverse(sort.IntSlice(sortedKeys)))
-
-	return sortedKeys
-}
+	parsedIP := net.ParseIP(ip).To4()
+	if parsedIP == nil || !oldNet.Contains(parsedIP) {
+		return "", "", fmt.Errorf("%v is not in %v", ip, oldIPv4CIDR)
+	}
 
-// GetAvailableIPv4PrivateAddressSpaces represents a function to check and return the available IPv4 private address spaces
-func GetAvailableIPv4PrivateAddressSpaces(strIPv4CIDRs []string) *AvailableIPv4PrivateAddressSpaces {
-	// CBLogger.Debug("Start.........")
+	toUint32 := func(b []byte) uint32 {
+		return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	}
+	offset := toUint32(parsedIP) - toUint32(oldNet.IP.To4())
 
-	// CBLogger.Tracef("IPs: %v", ips)
+	newPrefix, bits := newNet.Mask.Size()
+	size := uint64(1) << uint(bits-newPrefix)
+	if offset < 2 || uint64(offset) >= size-1 {
+		return "", "", fmt.Errorf("%v (offset: %d) does not fit in %v", ip, offset, newIPv4CIDR)
+	}
 
-	prefixMap10 := initMap(9, 32, true)
-	prefixMap172 := initMap(13, 32, true)
-	prefixMap192 := initMap(17, 32, true)
+	mapped := IncrementIP(newNet.IP, uint(offset)).To4()
+	return mapped.String(), fmt.Sprintf("%s/%d", mapped, newPrefix), nil
+}
 
-	// Mark a CIDR prefix in the received list of IP networks
-	for _, ipStr := range strIPv4CIDRs {
-		// CBLogger.Tracef("i: %v", i)
-		// CBLogger.Tracef("IP: %v", ipStr)
+// FreeIPv4Block represents a free IPv4 address block (CIDR block) in an IPv4 private address space
+type FreeIPv4Block struct {
+	CIDR                string `json:"cidr"`
+	Size                uint32 `json:"size"`
+	PrivateAddressSpace string `json:"privateAddressSpace"`
+}
 
-		ip, ipnet, _ := net.ParseCIDR(ipStr)
-		// Get CIDR Prefix
-		cidrPrefix, _ := ipnet.Mask.Size()
+// ipv4Range represents a range of IPv4 addresses from the first to the last (inclusive)
+type ipv4Range struct {
+	first uint32
+	last  uint32
+}
 
-		if ipnet10.Contains(ip) {
-			// CBLogger.Tracef("'%s' contains '%s/%v'", ipnet10, ip, cidrPrefix)
-			prefixMap10[cidrPrefix] = false
+func ipv4ToUint32(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
 
-		} else if ipnet172.Contains(ip) {
-			// CBLogger.Tracef("'%s' contains '%s/%v'", ipnet172, ip, cidrPrefix)
-			prefixMap172[cidrPrefix] = false
+func uint32ToIPv4(v uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, v)
+	return ip
+}
 
-		} else if ipnet192.Contains(ip) {
-			// CBLogger.Tracef("'%s' contains '%s/%v'", ipnet192, ip, cidrPrefix)
-			prefixMap192[cidrPrefix] = false
+// toIPv4Range returns the range of an IPv4 network
+func toIPv4Range(ipNet *net.IPNet) ipv4Range {
+	prefix, _ := ipNet.Mask.Size()
+	first := ipv4ToUint32(ipNet.IP)
+	return ipv4Range{first: first, last: first + uint32((uint64(1)<<uint(32-prefix))-1)}
+}
 
-		} else {
-			// CBLogger.Tracef("Nothing contains '%s/%v'", ip, cidrPrefix)
-			fmt.Printf("Nothing contains '%s/%v'\n", ip, cidrPrefix)
+// FreeIPv4PrivateAddressSpaces represents a function to calculate the free IPv4 address blocks
+// in the IPv4 private address spaces (10.0.0.0/8, 172.16.0.0/12 and 192.168.0.0/16) by subtracting the used ones.
+// The free blocks are concrete, non-overlapping and maximal CIDR blocks,
+// in the order of 192.168.0.0/16, 172.16.0.0/12 and 10.0.0.0/8, and by address in each.
+func FreeIPv4PrivateAddressSpaces(usedIPv4CIDRs []string) ([]FreeIPv4Block, error) {
+	var usedRanges []ipv4Range
+	for _, usedIPv4CIDR := range usedIPv4CIDRs {
+		_, usedNet, err := net.ParseCIDR(usedIPv4CIDR)
+		if err != nil || usedNet.IP.To4() == nil {
+			return nil, fmt.Errorf("not an IPv4 CIDR block (%v)", usedIPv4CIDR)
 		}
+		usedRanges = append(usedRanges, toIPv4Range(usedNet))
 	}
 
-	// net10
-	availableIPNet10s := []string{}
-	// Get sorted keys of a map because the Go map doesn't support ordering
-	sortedCIDRPrefixes10s := getDescendingOrderedKeysOfMap(prefixMap10)
-	for _, cidrPrefix := range sortedCIDRPrefixes10s {
-		if prefixMap10[cidrPrefix] {
-			ipNet := fmt.Sprint(ip10, "/", cidrPrefix)
-			// CBLogger.Tracef("'%s' is possible for a virtual network.", ipNet)
-			availableIPNet10s = append(availableIPNet10s, ipNet)
+	var freeBlocks []FreeIPv4Block
+	for _, privateNet := range []*net.IPNet{ipnet192, ipnet172, ipnet10} {
+		privateRange := toIPv4Range(privateNet)
+
+		// Clip the used ranges into the private address space
+		var clippedRanges []ipv4Range
+		for _, usedRange := range usedRanges {
+			if usedRange.last < privateRange.first || usedRange.first > privateRange.last {
+				continue
+			}
+			clipped := usedRange
+			if clipped.first < privateRange.first {
+				clipped.first = privateRange.first
+			}
+			if clipped.last > privateRange.last {
+				clipped.last = privateRange.last
+			}
+			clippedRanges = append(clippedRanges, clipped)
 		}
-	}
-
-	// net172
-	availableIPNet172s := []string{}
-	// Get sorted keys of a map because the Go map doesn't support ordering
-	sortedCIDRPrefixes172s := getDescendingOrderedKeysOfMap(prefixMap172)
-	for _, cidrPrefix := range sortedCIDRPrefixes172s {
-		if prefixMap172[cidrPrefix] {
-			ipNet := fmt.Sprint(ip172, "/", cidrPrefix)
-			// CBLogger.Tracef("'%s' is possible for a virtual network.", ipNet)
-			availableIPNet172s = append(availableIPNet172s, ipNet)
+		sort.Slice(clippedRanges, func(i, j int) bool { return clippedRanges[i].first < clippedRanges[j].first })
+
+		// Split the gaps between the used ranges into CIDR blocks
+		next := uint64(privateRange.first)
+		for _, clipped := range clippedRanges {
+			if uint64(clipped.first) > next {
+				freeBlocks = append(freeBlocks, splitIntoBlocks(uint32(next), clipped.first-1, privateNet)...)
+			}
+			if uint64(clipped.last)+1 > next {
+				next = uint64(clipped.last) + 1
+			}
 		}
-	}
-
-	// net192
-	availableIPNet192s := []string{}
-	// Get sorted keys of a map because the Go map doesn't support ordering
-	sortedCIDRPrefixes192s := getDescendingOrderedKeysOfMap(prefixMap192)
-	for _, cidrPrefix := range sortedCIDRPrefixes192s {
-		if prefixMap172[cidrPrefix] {
-			ipNet := fmt.Sprint(ip192, "/", cidrPrefix)
-			// CBLogger.Tracef("'%s' is possible for a virtual network.", ipNet)
-			availableIPNet192s = append(availableIPNet192s, ipNet)
+		if next <= uint64(privateRange.last) {
+			freeBlocks = append(freeBlocks, splitIntoBlocks(uint32(next), privateRange.last, privateNet)...)
 		}
 	}
 
-	// CBLogger.Tracef("Available IPNets in 10.0.0.0/8 : %v", availableIPNet10)
-	// CBLogger.Tracef("Available IPNets in 172.16.0.0/12 : %v", availableIPNet172)
-	// CBLogger.Tracef("Available IPNets in 192.168.0.0/16 : %v", availableIPNet192)
+	return freeBlocks, nil
+}
 
-	fmt.Printf("Available IPNets in 10.0.0.0/8 : %v\n", availableIPNet10s)
-	fmt.Printf("Available IPNets in 172.16.0.0/12 : %v\n", availableIPNet172s)
-	fmt.Printf("Available IPNets in 192.168.0.0/16 : %v\n", availableIPNet192s)
+// splitIntoBlocks splits a range of IPv4 addresses into the largest aligned CIDR blocks
+func splitIntoBlocks(first uint32, last uint32, privateNet *net.IPNet) []FreeIPv4Block {
+	var blocks []FreeIPv4Block
+	for current := uint64(first); current <= uint64(last); {
+		// The largest block aligned to the current address and not exceeding the last one
+		size := uint64(1)
+		for current%(size*2) == 0 && current+size*2-1 <= uint64(last) {
+			size *= 2
+		}
+		prefix := 32 - bits.TrailingZeros64(size)
+		blocks = append(blocks, FreeIPv4Block{
+			CIDR:                fmt.Sprintf("%s/%d", uint32ToIPv4(uint32(current)), prefix),
+			Size:                uint32(size),
+			PrivateAddressSpace: privateNet.String(),
+		})
+		current += size
+	}
+	return blocks
+}
 
-	availableIPv4PrivateAddressSpaces := &AvailableIPv4PrivateAddressSpaces{}
+// NeededIPv4Prefix represents a function to calculate the prefix length of an IPv4 address space
+// for the expected hosts plus the growth headroom (in percent).
+// The network address, the gateway address and the broadcast address are also counted.
+// The prefix length is up to /30.
+func NeededIPv4Prefix(expectedHosts int, headroomPercent int) int {
+	if expectedHosts < 0 {
+		expectedHosts = 0
+	}
+	if headroomPercent < 0 {
+		headroomPercent = 0
+	}
 
-	availableIPv4PrivateAddressSpaces.AddressSpace10s = availableIPNet10s
-	availableIPv4PrivateAddressSpaces.AddressSpace172s = availableIPNet172s
-	availableIPv4PrivateAddressSpaces.AddressSpace192s = availableIPNet192s
+	// Round up the hosts with the headroom, e.g., 10 hosts + 25% = 13 hosts
+	hosts := (expectedHosts*(100+headroomPercent) + 99) / 100
+	needed := uint64(hosts) + 3
 
-	// Recommend an IPv4AddressSpace
-	numberOfHosts := len(strIPv4CIDRs) + 3 // Network address, Broadcase address, and a reserved IP for gateway
-	fmt.Printf("NumberOfHosts: %v\n", numberOfHosts)
-	neededPrefix := 32 - int(math.Ceil(math.Log2(float64(numberOfHosts))))
-	fmt.Printf("NeededPrefix: %v\n", neededPrefix)
-	strIPNet, err := recommendAnIPv4AddressSpace(neededPrefix, availableIPv4PrivateAddressSpaces)
-	if err != nil {
-		fmt.Print(err)
+	// /0 (i.e., more than the IPv4 address space) never fits in a free block
+	prefix := 32
+	for prefix > 0 && (uint64(1)<<uint(32-prefix)) < needed {
+		prefix--
+	}
+	if prefix > 30 {
+		prefix = 30
 	}
+	return prefix
+}
 
-	availableIPv4PrivateAddressSpaces.RecommendedIPv4PrivateAddressSpace = strIPNet
+// RecommendIPv4PrivateAddressSpace represents a function to recommend an IPv4 address space with a prefix length
+// from the free blocks. It is carved out of the smallest free block that fits,
+// and the preceding block is preferred if there are more than one (i.e., 192.168.0.0/16 first).
+func RecommendIPv4PrivateAddressSpace(freeBlocks []FreeIPv4Block, neededPrefix int) (string, error) {
+	neededSize := uint64(1) << uint(32-neededPrefix)
 
-	fmt.Printf("Available IPv4 private address spaces: %v\n", availableIPv4PrivateAddressSpaces)
+	bestIndex := -1
+	for i, freeBlock := range freeBlocks {
+		if uint64(freeBlock.Size) < neededSize {
+			continue
+		}
+		if bestIndex == -1 || freeBlock.Size < freeBlocks[bestIndex].Size {
+			bestIndex = i
+		}
+	}
+	if bestIndex == -1 {
+		return "", ErrNoAvailableIPv4PrivateAddressSpace
+	}
 
-	// CBLogger.Debug("End.........")
-	return availableIPv4PrivateAddressSpaces
+	// A free block is aligned to its size, so is the first sub-block of it
+	ip, _, _ := net.ParseCIDR(freeBlocks[bestIndex].CIDR)
+	return fmt.Sprintf("%s/%d", ip.To4(), neededPrefix), nil
 }
 
-func recommendAnIPv4AddressSpace(neededPrefix int, availableIPv4PrivateAddressSpaces *AvailableIPv4PrivateAddressSpaces) (string, error) {
+// GetAvailableIPv4PrivateAddressSpaces represents a function to check and return the available IPv4 private address spaces.
+// The used IPv4 CIDR blocks (e.g., the underlay networks of the hosts) are subtracted from the private address spaces,
+// and an IPv4 address space is recommended for the expected hosts plus the growth headroom (in percent).
+// The number of the used IPv4 CIDR blocks is the expected hosts if it is not given (i.e., 0).
+// The free blocks are returned with an error if no IPv4 address space can be recommended.
+func GetAvailableIPv4PrivateAddressSpaces(strIPv4CIDRs []string, expectedHosts int, headroomPercent int) (*AvailableIPv4PrivateAddressSpaces, error) {
 
-	// Recommended IPv4 address space from small space to large space
-
-	// Find and return a recommended IPv4 address space under 192.168.0.0/16
-	for _, ipnetStr := range availableIPv4PrivateAddressSpaces.AddressSpace192s {
-		_, ipnet, _ := net.ParseCIDR(ipnetStr)
-		// Get CIDR Prefix
-		cidrPrefix, _ := ipnet.Mask.Size()
-		if cidrPrefix <= neededPrefix {
-			fmt.Printf("Recommended an IPv4 address space: %v\n", ipnetStr)
-			return ipnetStr, nil
-		}
+	freeBlocks, err := FreeIPv4PrivateAddressSpaces(strIPv4CIDRs)
+	if err != nil {
+		return nil, err
 	}
 
-	// Find and return a recommended IPv4 address space under 172.16.0.0/12
-	for _, ipnetStr := range availableIPv4PrivateAddressSpaces.AddressSpace172s {
-		_, ipnet, _ := net.ParseCIDR(ipnetStr)
-		// Get CIDR Prefix
-		cidrPrefix, _ := ipnet.Mask.Size()
-		if cidrPrefix <= neededPrefix {
-			fmt.Printf("Recommended an IPv4 address space: %v\n", ipnetStr)
-			return ipnetStr, nil
+	availableIPv4PrivateAddressSpaces := &AvailableIPv4PrivateAddressSpaces{
+		AddressSpace10s:  []string{},
+		AddressSpace172s: []string{},
+		AddressSpace192s: []string{},
+		FreeBlocks:       freeBlocks,
+	}
+	for _, freeBlock := range freeBlocks {
+		switch freeBlock.PrivateAddressSpace {
+		case ipnet10.String():
+			availableIPv4PrivateAddressSpaces.AddressSpace10s = append(availableIPv4PrivateAddressSpaces.AddressSpace10s, freeBlock.CIDR)
+		case ipnet172.String():
+			availableIPv4PrivateAddressSpaces.AddressSpace172s = append(availableIPv4PrivateAddressSpaces.AddressSpace172s, freeBlock.CIDR)
+		case ipnet192.String():
+			availableIPv4PrivateAddressSpaces.AddressSpace192s = append(availableIPv4PrivateAddressSpaces.AddressSpace192s, freeBlock.CIDR)
 		}
 	}
 
-	// Find and return a recommended IPv4 address space under 10.0.0.0/8
-	for _, ipnetStr := range availableIPv4PrivateAddressSpaces.AddressSpace10s {
-		_, ipnet, _ := net.ParseCIDR(ipnetStr)
-		// Get CIDR Prefix
-		cidrPrefix, _ := ipnet.Mask.Size()
-		if cidrPrefix <= neededPrefix {
-			fmt.Printf("Recommended an IPv4 address space: %v\n", ipnetStr)
-			return ipnetStr, nil
-		}
+	// Recommend an IPv4AddressSpace
+	if expectedHosts == 0 {
+		expectedHosts = len(strIPv4CIDRs)
 	}
-	return "", errors.New("no appropriate IPv4PrivateAddressSpace exists")
+	neededPrefix := NeededIPv4Prefix(expectedHosts, headroomPercent)
+
+	recommended, err := RecommendIPv4PrivateAddressSpace(freeBlocks, neededPrefix)
+	availableIPv4PrivateAddressSpaces.RecommendedIPv4PrivateAddressSpace = recommended
+
+	return availableIPv4PrivateAddressSpaces, err
 }
@@ -0,0 +1,339 @@
+package nethelper
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func parseIP(t *testing.T, s string) net.IP {
+	t.Helper()
+	ip := net.ParseIP(s)
+	if ip == nil {
+		t.Fatalf("not an IP address (%q)", s)
+	}
+	return ip
+}
+
+func cidrsOf(blocks []FreeIPv4Block) []string {
+	cidrs := []string{}
+	for _, block := range blocks {
+		cidrs = append(cidrs, block.CIDR)
+	}
+	return cidrs
+}
+
+func TestFreeIPv4PrivateAddressSpaces(t *testing.T) {
+	tests := []struct {
+		name    string
+		used    []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			used: nil,
+			want: []string{"192.168.0.0/16", "172.16.0.0/12", "10.0.0.0/8"},
+		},
+		{
+			name: "fully used",
+			used: []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"},
+			want: []string{},
+		},
+		{
+			name: "fully used by a larger block",
+			used: []string{"0.0.0.0/0"},
+			want: []string{},
+		},
+		{
+			name: "used blocks across the boundaries of the private address spaces",
+			used: []string{"172.0.0.0/8", "192.168.0.0/15"},
+			want: []string{"10.0.0.0/8"},
+		},
+		{
+			name: "used blocks out of the private address spaces",
+			used: []string{"8.8.8.0/24", "100.64.0.0/10"},
+			want: []string{"192.168.0.0/16", "172.16.0.0/12", "10.0.0.0/8"},
+		},
+		{
+			name: "first block used",
+			used: []string{"192.168.0.0/24"},
+			want: []string{
+				"192.168.1.0/24", "192.168.2.0/23", "192.168.4.0/22", "192.168.8.0/21",
+				"192.168.16.0/20", "192.168.32.0/19", "192.168.64.0/18", "192.168.128.0/17",
+				"172.16.0.0/12", "10.0.0.0/8",
+			},
+		},
+		{
+			name: "non-aligned gaps",
+			used: []string{"172.16.1.0/24"},
+			want: []string{
+				"192.168.0.0/16",
+				"172.16.0.0/24", "172.16.2.0/23", "172.16.4.0/22", "172.16.8.0/21",
+				"172.16.16.0/20", "172.16.32.0/19", "172.16.64.0/18", "172.16.128.0/17",
+				"172.17.0.0/16", "172.18.0.0/15", "172.20.0.0/14", "172.24.0.0/13",
+				"10.0.0.0/8",
+			},
+		},
+		{
+			name: "host bits in a used block",
+			used: []string{"192.168.128.77/17"},
+			want: []string{"192.168.0.0/17", "172.16.0.0/12", "10.0.0.0/8"},
+		},
+		{
+			name: "adjacent used blocks",
+			used: []string{"192.168.128.0/17", "192.168.0.0/17"},
+			want: []string{"172.16.0.0/12", "10.0.0.0/8"},
+		},
+		{
+			name: "overlapping used blocks",
+			used: []string{"10.0.0.0/9", "10.0.0.0/16", "10.64.0.0/10", "10.0.0.0/9"},
+			want: []string{"192.168.0.0/16", "172.16.0.0/12", "10.128.0.0/9"},
+		},
+		{
+			name: "last addresses used",
+			used: []string{"10.255.255.255/32"},
+			want: []string{
+				"192.168.0.0/16", "172.16.0.0/12",
+				"10.0.0.0/9", "10.128.0.0/10", "10.192.0.0/11", "10.224.0.0/12", "10.240.0.0/13",
+				"10.248.0.0/14", "10.252.0.0/15", "10.254.0.0/16", "10.255.0.0/17", "10.255.128.0/18",
+				"10.255.192.0/19", "10.255.224.0/20", "10.255.240.0/21", "10.255.248.0/22", "10.255.252.0/23",
+				"10.255.254.0/24", "10.255.255.0/25", "10.255.255.128/26", "10.255.255.192/27",
+				"10.255.255.224/28", "10.255.255.240/29", "10.255.255.248/30", "10.255.255.252/31",
+				"10.255.255.254/32",
+			},
+		},
+		{
+			name:    "not a CIDR block",
+			used:    []string{"10.0.0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "IPv6 CIDR block",
+			used:    []string{"fd00::/8"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FreeIPv4PrivateAddressSpaces(tt.used)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("FreeIPv4PrivateAddressSpaces() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FreeIPv4PrivateAddressSpaces() error = %v", err)
+			}
+			if cidrs := cidrsOf(got); !reflect.DeepEqual(cidrs, tt.want) {
+				t.Errorf("FreeIPv4PrivateAddressSpaces() = %v, want %v", cidrs, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitIntoBlocks(t *testing.T) {
+	tests := []struct {
+		name      string
+		first     string
+		last      string
+		want      []string
+		wantSizes []uint32
+	}{
+		{
+			name:      "single address",
+			first:     "10.0.0.5",
+			last:      "10.0.0.5",
+			want:      []string{"10.0.0.5/32"},
+			wantSizes: []uint32{1},
+		},
+		{
+			name:      "aligned range",
+			first:     "10.0.0.0",
+			last:      "10.0.0.255",
+			want:      []string{"10.0.0.0/24"},
+			wantSizes: []uint32{256},
+		},
+		{
+			name:      "non-aligned range",
+			first:     "10.0.0.3",
+			last:      "10.0.0.12",
+			want:      []string{"10.0.0.3/32", "10.0.0.4/30", "10.0.0.8/30", "10.0.0.12/32"},
+			wantSizes: []uint32{1, 4, 4, 1},
+		},
+		{
+			name:      "whole private address space",
+			first:     "10.0.0.0",
+			last:      "10.255.255.255",
+			want:      []string{"10.0.0.0/8"},
+			wantSizes: []uint32{1 << 24},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitIntoBlocks(ipv4ToUint32(parseIP(t, tt.first)), ipv4ToUint32(parseIP(t, tt.last)), ipnet10)
+
+			var sizes []uint32
+			for _, block := range got {
+				sizes = append(sizes, block.Size)
+				if block.PrivateAddressSpace != "10.0.0.0/8" {
+					t.Errorf("PrivateAddressSpace = %v, want 10.0.0.0/8", block.PrivateAddressSpace)
+				}
+			}
+			if cidrs := cidrsOf(got); !reflect.DeepEqual(cidrs, tt.want) {
+				t.Errorf("splitIntoBlocks() = %v, want %v", cidrs, tt.want)
+			}
+			if !reflect.DeepEqual(sizes, tt.wantSizes) {
+				t.Errorf("sizes = %v, want %v", sizes, tt.wantSizes)
+			}
+		})
+	}
+}
+
+func TestNeededIPv4Prefix(t *testing.T) {
+	tests := []struct {
+		name            string
+		expectedHosts   int
+		headroomPercent int
+		want            int
+	}{
+		{name: "no host", expectedHosts: 0, want: 30},
+		{name: "negative", expectedHosts: -5, headroomPercent: -10, want: 30},
+		{name: "1 host", expectedHosts: 1, want: 30},
+		{name: "2 hosts", expectedHosts: 2, want: 29},
+		{name: "10 hosts with 25% headroom", expectedHosts: 10, headroomPercent: 25, want: 28},
+		{name: "13 hosts", expectedHosts: 13, want: 28},
+		{name: "14 hosts", expectedHosts: 14, want: 27},
+		{name: "fits in /16", expectedHosts: 1<<16 - 3, want: 16},
+		{name: "exceeds /16", expectedHosts: 1<<16 - 2, want: 15},
+		{name: "fits in /12", expectedHosts: 1<<20 - 3, want: 12},
+		{name: "exceeds /12", expectedHosts: 1<<20 - 2, want: 11},
+		{name: "fits in /8", expectedHosts: 1<<24 - 3, want: 8},
+		{name: "exceeds /8", expectedHosts: 1<<24 - 2, want: 7},
+		{name: "exceeds /8 by the headroom", expectedHosts: 1 << 23, headroomPercent: 100, want: 7},
+		{name: "exceeds the IPv4 address space", expectedHosts: 1 << 33, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeededIPv4Prefix(tt.expectedHosts, tt.headroomPercent); got != tt.want {
+				t.Errorf("NeededIPv4Prefix(%d, %d) = %d, want %d", tt.expectedHosts, tt.headroomPercent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecommendIPv4PrivateAddressSpace(t *testing.T) {
+	tests := []struct {
+		name         string
+		used         []string
+		neededPrefix int
+		want         string
+		wantErr      bool
+	}{
+		{name: "/16 from 192.168.0.0/16", used: nil, neededPrefix: 16, want: "192.168.0.0/16"},
+		{name: "/24 from 192.168.0.0/16 first", used: nil, neededPrefix: 24, want: "192.168.0.0/24"},
+		{name: "/12 from 172.16.0.0/12", used: nil, neededPrefix: 12, want: "172.16.0.0/12"},
+		{name: "/8 from 10.0.0.0/8", used: nil, neededPrefix: 8, want: "10.0.0.0/8"},
+		{name: "more than /8", used: nil, neededPrefix: 7, wantErr: true},
+		{name: "smallest free block that fits", used: []string{"192.168.0.0/24"}, neededPrefix: 24, want: "192.168.1.0/24"},
+		{name: "smallest free block across the spaces", used: []string{"192.168.0.0/17", "172.16.0.0/13"}, neededPrefix: 17, want: "192.168.128.0/17"},
+		{name: "next space if the former is too small", used: []string{"192.168.0.0/17"}, neededPrefix: 16, want: "172.16.0.0/16"},
+		{name: "/8 used", used: []string{"10.0.0.1/32"}, neededPrefix: 8, wantErr: true},
+		{name: "fully used", used: []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}, neededPrefix: 30, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			freeBlocks, err := FreeIPv4PrivateAddressSpaces(tt.used)
+			if err != nil {
+				t.Fatalf("FreeIPv4PrivateAddressSpaces() error = %v", err)
+			}
+
+			got, err := RecommendIPv4PrivateAddressSpace(freeBlocks, tt.neededPrefix)
+			if tt.wantErr {
+				if !errors.Is(err, ErrNoAvailableIPv4PrivateAddressSpace) {
+					t.Fatalf("RecommendIPv4PrivateAddressSpace() = %q, %v, want ErrNoAvailableIPv4PrivateAddressSpace", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RecommendIPv4PrivateAddressSpace() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RecommendIPv4PrivateAddressSpace() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAvailableIPv4PrivateAddressSpaces(t *testing.T) {
+	used := []string{"192.168.0.0/17", "172.16.0.0/12", "10.1.0.0/16"}
+
+	// The number of the used blocks (3) is the expected hosts if it is not given
+	got, err := GetAvailableIPv4PrivateAddressSpaces(used, 0, 0)
+	if err != nil {
+		t.Fatalf("GetAvailableIPv4PrivateAddressSpaces() error = %v", err)
+	}
+	if got.RecommendedIPv4PrivateAddressSpace != "192.168.128.0/29" {
+		t.Errorf("RecommendedIPv4PrivateAddressSpace = %q, want 192.168.128.0/29", got.RecommendedIPv4PrivateAddressSpace)
+	}
+	if want := []string{"192.168.128.0/17"}; !reflect.DeepEqual(got.AddressSpace192s, want) {
+		t.Errorf("AddressSpace192s = %v, want %v", got.AddressSpace192s, want)
+	}
+	if len(got.AddressSpace172s) != 0 {
+		t.Errorf("AddressSpace172s = %v, want none", got.AddressSpace172s)
+	}
+	if want := []string{"10.0.0.0/16", "10.2.0.0/15", "10.4.0.0/14", "10.8.0.0/13", "10.16.0.0/12", "10.32.0.0/11", "10.64.0.0/10", "10.128.0.0/9"}; !reflect.DeepEqual(got.AddressSpace10s, want) {
+		t.Errorf("AddressSpace10s = %v, want %v", got.AddressSpace10s, want)
+	}
+
+	// The free blocks are returned with the error
+	got, err = GetAvailableIPv4PrivateAddressSpaces(used, 1<<24, 0)
+	if !errors.Is(err, ErrNoAvailableIPv4PrivateAddressSpace) {
+		t.Fatalf("GetAvailableIPv4PrivateAddressSpaces() error = %v, want ErrNoAvailableIPv4PrivateAddressSpace", err)
+	}
+	if got == nil || len(got.FreeBlocks) == 0 || got.RecommendedIPv4PrivateAddressSpace != "" {
+		t.Errorf("GetAvailableIPv4PrivateAddressSpaces() = %+v, want the free blocks only", got)
+	}
+}
+
+func TestReaddressIPv4(t *testing.T) {
+	tests := []struct {
+		name    string
+		ip      string
+		oldCIDR string
+		newCIDR string
+		want    string
+		wantErr bool
+	}{
+		{name: "same offset", ip: "10.0.0.5", oldCIDR: "10.0.0.0/24", newCIDR: "192.168.7.0/24", want: "192.168.7.5/24"},
+		{name: "to a larger space", ip: "10.0.0.200", oldCIDR: "10.0.0.0/24", newCIDR: "172.16.0.0/16", want: "172.16.0.200/16"},
+		{name: "does not fit", ip: "10.0.0.200", oldCIDR: "10.0.0.0/24", newCIDR: "192.168.7.0/25", wantErr: true},
+		{name: "broadcast address", ip: "10.0.0.127", oldCIDR: "10.0.0.0/24", newCIDR: "192.168.7.0/25", wantErr: true},
+		{name: "gateway address", ip: "10.0.0.1", oldCIDR: "10.0.0.0/24", newCIDR: "192.168.7.0/24", wantErr: true},
+		{name: "not in the old space", ip: "10.0.1.5", oldCIDR: "10.0.0.0/24", newCIDR: "192.168.7.0/24", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got, err := ReaddressIPv4(tt.ip, tt.oldCIDR, tt.newCIDR)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ReaddressIPv4() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReaddressIPv4() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ReaddressIPv4() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,4 +16,7 @@ const (
 
 	// Failed is const for the failed state
 	Failed = "failed"
+
+	// Rejected is const for the rejected state (e.g., by an invalid join token)
+	Rejected = "rejected"
 )
@@ -0,0 +1,313 @@
+package nettest
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"syscall"
+	"time"
+)
+
+// ErrNoResponse represents an error that the responder of a peer doesn't respond
+var ErrNoResponse = errors.New("no response from the responder")
+
+// Port states of a TCP port reachability test
+const (
+	PortOpen     = "open"
+	PortClosed   = "closed"
+	PortFiltered = "filtered"
+)
+
+const (
+	// MinMTU is the minimum MTU of IPv4
+	MinMTU = 68
+	// ipv4UDPHeaderSize is the size of the IPv4 and UDP headers
+	ipv4UDPHeaderSize = 28
+
+	tcpChunkSize   = 128 * 1024
+	replyTimeout   = time.Second
+	probeTimeout   = 300 * time.Millisecond
+	probeRetries   = 3
+	reportRetries  = 3
+	drainingPeriod = 500 * time.Millisecond
+)
+
+// ThroughputResult represents a result of a TCP throughput test
+type ThroughputResult struct {
+	BytesSent     int64
+	BytesReceived int64
+	Elapsed       time.Duration
+}
+
+// BitsPerSecond represents a function to get the throughput (bps) measured by the receiver
+func (result ThroughputResult) BitsPerSecond() float64 {
+	if result.Elapsed <= 0 {
+		return 0
+	}
+	return float64(result.BytesReceived*8) / result.Elapsed.Seconds()
+}
+
+// StreamResult represents a result of a UDP stream (i.e., a UDP throughput or jitter test)
+type StreamResult struct {
+	PacketsSent     int
+	PacketsReceived int
+	PacketsLost     int
+	OutOfOrder      int
+	BytesReceived   int64
+	Jitter          time.Duration
+	Elapsed         time.Duration
+}
+
+// BitsPerSecond represents a function to get the throughput (bps) measured by the receiver
+func (result StreamResult) BitsPerSecond() float64 {
+	if result.Elapsed <= 0 {
+		return 0
+	}
+	return float64(result.BytesReceived*8) / result.Elapsed.Seconds()
+}
+
+func clampDuration(duration time.Duration) time.Duration {
+	if duration <= 0 {
+		return time.Second
+	}
+	if duration > MaxDuration {
+		return MaxDuration
+	}
+	return duration
+}
+
+// TCPThroughput represents a function to send bytes to the responder as much as possible during the duration.
+func TCPThroughput(ctx context.Context, address string, duration time.Duration) (ThroughputResult, error) {
+	var result ThroughputResult
+	duration = clampDuration(duration)
+
+	dialer := net.Dialer{Timeout: replyTimeout * 3}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return result, err
+	}
+	defer conn.Close()
+
+	chunk := make([]byte, tcpChunkSize)
+	start := time.Now()
+	deadline := start.Add(duration)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetWriteDeadline(deadline)
+
+	for time.Now().Before(deadline) {
+		n, err := conn.Write(chunk)
+		result.BytesSent += int64(n)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			break
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+
+	// The responder replies the received bytes after the writing is closed
+	if err := conn.(*net.TCPConn).CloseWrite(); err != nil {
+		return result, err
+	}
+	conn.SetReadDeadline(time.Now().Add(replyTimeout * 10))
+	reply := make([]byte, 8)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return result, fmt.Errorf("%w: %v", ErrNoResponse, err)
+	}
+
+	result.BytesReceived = int64(binary.BigEndian.Uint64(reply))
+	result.Elapsed = time.Since(start)
+	return result, nil
+}
+
+// UDPStream represents a function to send the UDP packets (the payload size) to the responder at the bitrate (bps)
+// during the duration, and to get the statistics (e.g., loss and jitter) from the responder.
+func UDPStream(ctx context.Context, address string, duration time.Duration, bitrate int64, packetSize int) (StreamResult, error) {
+	var result StreamResult
+	duration = clampDuration(duration)
+	if packetSize < dataHeaderSize {
+		packetSize = dataHeaderSize
+	}
+	if bitrate <= 0 {
+		return result, fmt.Errorf("invalid bitrate (%v)", bitrate)
+	}
+
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return result, err
+	}
+	defer conn.Close()
+
+	id := rand.Uint32()
+	interval := time.Duration(float64(packetSize*8) / float64(bitrate) * float64(time.Second))
+
+	message := make([]byte, packetSize)
+	message[0] = messageData
+	binary.BigEndian.PutUint32(message[1:5], id)
+
+	start := time.Now()
+	for seq := uint32(1); ; seq++ {
+		elapsed := time.Since(start)
+		if elapsed >= duration {
+			break
+		}
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		// Pace the packets by the bitrate
+		if due := time.Duration(seq-1) * interval; due > elapsed {
+			time.Sleep(due - elapsed)
+		}
+
+		binary.BigEndian.PutUint32(message[5:9], seq)
+		binary.BigEndian.PutUint64(message[9:17], uint64(time.Now().UnixNano()))
+		if _, err := conn.Write(message); err != nil {
+			// e.g., the buffer is full or the message is too large
+			continue
+		}
+		result.PacketsSent++
+	}
+	result.Elapsed = time.Since(start)
+
+	// Wait for the packets in flight
+	time.Sleep(drainingPeriod)
+
+	request := make([]byte, 5)
+	request[0] = messageReport
+	binary.BigEndian.PutUint32(request[1:5], id)
+	reply := make([]byte, 64)
+
+	for i := 0; i < reportRetries; i++ {
+		if _, err := conn.Write(request); err != nil {
+			return result, err
+		}
+
+		conn.SetReadDeadline(time.Now().Add(replyTimeout))
+		for {
+			n, err := conn.Read(reply)
+			if err != nil {
+				break
+			}
+			if n < reportReplySize || reply[0] != messageReportReply || binary.BigEndian.Uint32(reply[1:5]) != id {
+				continue
+			}
+
+			result.PacketsReceived = int(binary.BigEndian.Uint32(reply[5:9]))
+			result.BytesReceived = int64(binary.BigEndian.Uint64(reply[9:17]))
+			result.OutOfOrder = int(binary.BigEndian.Uint32(reply[21:25]))
+			result.Jitter = time.Duration(int64(binary.BigEndian.Uint64(reply[25:33])))
+			if lost := result.PacketsSent - result.PacketsReceived; lost > 0 {
+				result.PacketsLost = lost
+			}
+			return result, nil
+		}
+	}
+
+	return result, ErrNoResponse
+}
+
+// PathMTU represents a function to discover the path MTU to the responder (up to maxMTU)
+// by the binary search of the echoes with the DF (Don't Fragment) bit.
+func PathMTU(ctx context.Context, address string, maxMTU int) (int, error) {
+	if maxMTU < MinMTU {
+		return 0, fmt.Errorf("invalid maximum MTU (%v)", maxMTU)
+	}
+
+	conn, err := net.Dial("udp4", address)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if err := setDontFragment(conn.(*net.UDPConn)); err != nil {
+		return 0, err
+	}
+
+	var seq uint32
+	probe := func(mtu int) bool {
+		message := make([]byte, mtu-ipv4UDPHeaderSize)
+		message[0] = messageEcho
+		reply := make([]byte, len(message)+1)
+
+		for i := 0; i < probeRetries && ctx.Err() == nil; i++ {
+			seq++
+			binary.BigEndian.PutUint32(message[1:5], seq)
+			if _, err := conn.Write(message); err != nil {
+				// EMSGSIZE if it exceeds the MTU known by this host
+				return false
+			}
+
+			conn.SetReadDeadline(time.Now().Add(probeTimeout))
+			for {
+				n, err := conn.Read(reply)
+				if err != nil {
+					break
+				}
+				if n == len(message) && reply[0] == messageEchoReply && binary.BigEndian.Uint32(reply[1:5]) == seq {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	low, high := MinMTU, maxMTU
+	if !probe(low) {
+		return 0, ErrNoResponse
+	}
+	for low < high {
+		mid := (low + high + 1) / 2
+		if probe(mid) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return low, ctx.Err()
+}
+
+// setDontFragment sets the DF bit, and prevents this host from fragmenting the packets
+func setDontFragment(conn *net.UDPConn) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	var errSetsockopt error
+	err = rawConn.Control(func(fd uintptr) {
+		errSetsockopt = syscall.SetsockoptInt(int(fd), syscall.IPPROTO_IP, syscall.IP_MTU_DISCOVER, syscall.IP_PMTUDISC_DO)
+	})
+	if err != nil {
+		return err
+	}
+	return errSetsockopt
+}
+
+// CheckTCPPort represents a function to check if a TCP port is open, closed or filtered.
+func CheckTCPPort(ctx context.Context, address string, timeout time.Duration) (string, time.Duration, error) {
+	dialer := net.Dialer{Timeout: timeout}
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	elapsed := time.Since(start)
+	if err == nil {
+		conn.Close()
+		return PortOpen, elapsed, nil
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return PortClosed, elapsed, nil
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return PortFiltered, elapsed, nil
+	}
+	return "", elapsed, err
+}
@@ -0,0 +1,245 @@
+package nettest
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/logger"
+)
+
+// DefaultPort is the port (TCP and UDP) of the responders of the network tests
+const DefaultPort = 8056
+
+// MaxDuration is the maximum duration of a stream (e.g., a throughput test)
+const MaxDuration = 30 * time.Second
+
+// Types of the UDP messages
+const (
+	messageData        byte = 0x01 // [type][session uint32][seq uint32][sent unix-ns int64][padding]
+	messageReport      byte = 0x02 // [type][session uint32]
+	messageReportReply byte = 0x03 // [type][session uint32][received uint32][bytes uint64][max seq uint32][out of order uint32][jitter ns int64]
+	messageEcho        byte = 0x04 // [type][padding]
+	messageEchoReply   byte = 0x05 // [type][padding] (the same size as the echo)
+)
+
+const (
+	dataHeaderSize  = 17
+	reportReplySize = 33
+
+	// sessionTimeout is the idle time after which a UDP stream session is discarded
+	sessionTimeout = time.Minute
+	// maxSessions is the maximum number of the UDP stream sessions at once
+	maxSessions = 256
+)
+
+// session represents the statistics of a UDP stream from a sender
+type session struct {
+	received    uint32
+	bytes       uint64
+	maxSeq      uint32
+	outOfOrder  uint32
+	jitter      float64 // Interarrival jitter (ns) in RFC 3550
+	prevTransit int64
+	lastSeen    time.Time
+}
+
+type sessionKey struct {
+	addr string
+	id   uint32
+}
+
+// Responder serves the other agents' network tests, i.e., a TCP sink for the throughput,
+// and a UDP sink and echo for the throughput, the jitter and the path MTU.
+type Responder struct {
+	port      int
+	isAllowed func(ip net.IP) bool
+	logger    logger.Logger
+	sessions  map[sessionKey]*session
+	mutex     sync.Mutex
+}
+
+// NewResponder represents a constructor of Responder, which responds only to the allowed sources (e.g., the peers)
+func NewResponder(port int, isAllowed func(ip net.IP) bool, logger logger.Logger) *Responder {
+	if port == 0 {
+		port = DefaultPort
+	}
+	return &Responder{
+		port:      port,
+		isAllowed: isAllowed,
+		logger:    logger,
+		sessions:  make(map[sessionKey]*session),
+	}
+}
+
+// Serve represents a function to serve the network tests until the context is done
+func (responder *Responder) Serve(ctx context.Context) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", responder.port))
+	if err != nil {
+		return err
+	}
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{Port: responder.port})
+	if err != nil {
+		listener.Close()
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+		udpConn.Close()
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		responder.serveTCP(listener)
+	}()
+	go func() {
+		defer wg.Done()
+		responder.serveUDP(udpConn)
+	}()
+	wg.Wait()
+
+	return nil
+}
+
+// serveTCP counts the bytes of each connection, and replies the count when the sender closes the writing
+func (responder *Responder) serveTCP(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				responder.logger.Error(err)
+			}
+			return
+		}
+
+		go func() {
+			defer conn.Close()
+			if !responder.isAllowed(conn.RemoteAddr().(*net.TCPAddr).IP) {
+				return
+			}
+
+			conn.SetDeadline(time.Now().Add(MaxDuration + 10*time.Second))
+			received, err := io.Copy(io.Discard, conn)
+			if err != nil {
+				responder.logger.Debug(err)
+				return
+			}
+
+			reply := make([]byte, 8)
+			binary.BigEndian.PutUint64(reply, uint64(received))
+			if _, err := conn.Write(reply); err != nil {
+				responder.logger.Debug(err)
+			}
+		}()
+	}
+}
+
+// serveUDP updates the statistics of the streams, and replies the reports and the echoes
+func (responder *Responder) serveUDP(conn *net.UDPConn) {
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				responder.logger.Error(err)
+			}
+			return
+		}
+		if n == 0 || !responder.isAllowed(addr.IP) {
+			continue
+		}
+
+		switch buf[0] {
+		case messageData:
+			if n >= dataHeaderSize {
+				responder.receiveData(addr, buf[:n], time.Now())
+			}
+
+		case messageReport:
+			if n >= 5 {
+				reply := responder.report(addr, binary.BigEndian.Uint32(buf[1:5]))
+				if _, err := conn.WriteToUDP(reply, addr); err != nil {
+					responder.logger.Debug(err)
+				}
+			}
+
+		case messageEcho:
+			buf[0] = messageEchoReply
+			if _, err := conn.WriteToUDP(buf[:n], addr); err != nil {
+				responder.logger.Debug(err)
+			}
+		}
+	}
+}
+
+func (responder *Responder) receiveData(addr *net.UDPAddr, message []byte, arrival time.Time) {
+	key := sessionKey{addr: addr.String(), id: binary.BigEndian.Uint32(message[1:5])}
+	seq := binary.BigEndian.Uint32(message[5:9])
+	sent := int64(binary.BigEndian.Uint64(message[9:17]))
+
+	responder.mutex.Lock()
+	defer responder.mutex.Unlock()
+
+	s, ok := responder.sessions[key]
+	if !ok {
+		responder.expireSessions(arrival)
+		if len(responder.sessions) >= maxSessions {
+			return
+		}
+		s = &session{prevTransit: arrival.UnixNano() - sent}
+		responder.sessions[key] = s
+	}
+
+	s.received++
+	s.bytes += uint64(len(message))
+	if seq >= s.maxSeq {
+		s.maxSeq = seq
+	} else {
+		s.outOfOrder++
+	}
+
+	// The clock offset between the hosts is canceled by the difference of the transit times
+	transit := arrival.UnixNano() - sent
+	d := float64(transit - s.prevTransit)
+	if d < 0 {
+		d = -d
+	}
+	s.jitter += (d - s.jitter) / 16
+	s.prevTransit = transit
+	s.lastSeen = arrival
+}
+
+// expireSessions discards the idle sessions (the mutex must be held)
+func (responder *Responder) expireSessions(now time.Time) {
+	for key, s := range responder.sessions {
+		if now.Sub(s.lastSeen) > sessionTimeout {
+			delete(responder.sessions, key)
+		}
+	}
+}
+
+func (responder *Responder) report(addr *net.UDPAddr, id uint32) []byte {
+	responder.mutex.Lock()
+	defer responder.mutex.Unlock()
+
+	reply := make([]byte, reportReplySize)
+	reply[0] = messageReportReply
+	binary.BigEndian.PutUint32(reply[1:5], id)
+	if s, ok := responder.sessions[sessionKey{addr: addr.String(), id: id}]; ok {
+		binary.BigEndian.PutUint32(reply[5:9], s.received)
+		binary.BigEndian.PutUint64(reply[9:17], s.bytes)
+		binary.BigEndian.PutUint32(reply[17:21], s.maxSeq)
+		binary.BigEndian.PutUint32(reply[21:25], s.outOfOrder)
+		binary.BigEndian.PutUint64(reply[25:33], uint64(int64(s.jitter)))
+	}
+	return reply
+}
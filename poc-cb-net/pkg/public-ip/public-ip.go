@@ -0,0 +1,424 @@
+package publicip
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout is the default time to wait for a source to answer
+const DefaultTimeout = 2 * time.Second
+
+// ErrNoPublicIP is returned if no tier of sources resolves the public IP address
+var ErrNoPublicIP = errors.New("could not resolve the public IP address")
+
+// PublicIPResolver represents a resolver of the public IP address of this host
+type PublicIPResolver interface {
+	ResolvePublicIP(ctx context.Context) (string, error)
+}
+
+// Source represents a source of the public IP address, such as a metadata service, STUN or an HTTP echo service
+type Source interface {
+	Name() string
+	LookupPublicIP(ctx context.Context) (net.IP, error)
+}
+
+// Tier represents sources of the same priority.
+// A public IP address is accepted if at least Quorum sources in the tier agree on it.
+type Tier struct {
+	Sources []Source
+	Quorum  int
+}
+
+// PrioritizedResolver resolves the public IP address by the tiers in order.
+// The sources in a tier are looked up concurrently, each with the timeout.
+type PrioritizedResolver struct {
+	Tiers   []Tier
+	Timeout time.Duration
+}
+
+// NewDefaultResolver represents a constructor of the default resolver, which tries
+// a static public IP address (if not empty), the instance metadata services of AWS, GCP, Azure and Alibaba Cloud,
+// and STUN and HTTP echo services with the consensus of two sources.
+func NewDefaultResolver(staticIP string) *PrioritizedResolver {
+	var tiers []Tier
+
+	if staticIP != "" {
+		tiers = append(tiers, Tier{Sources: []Source{StaticSource{IP: staticIP}}, Quorum: 1})
+	}
+
+	tiers = append(tiers, Tier{
+		Sources: []Source{
+			AWSMetadataSource(),
+			GCPMetadataSource(),
+			AzureMetadataSource(),
+			AlibabaMetadataSource(),
+		},
+		Quorum: 1,
+	})
+
+	tiers = append(tiers, Tier{
+		Sources: []Source{
+			STUNSource{Server: "stun.l.google.com:19302"},
+			STUNSource{Server: "stun.cloudflare.com:3478"},
+			HTTPSource{SourceName: "ifconfig.co", URL: "https://ifconfig.co/ip"},
+			HTTPSource{SourceName: "ipify", URL: "https://api.ipify.org?format=text"},
+			HTTPSource{SourceName: "ident.me", URL: "http://api.ident.me"},
+		},
+		Quorum: 2,
+	})
+
+	return &PrioritizedResolver{Tiers: tiers, Timeout: DefaultTimeout}
+}
+
+// answer represents an answer of a source
+type answer struct {
+	ip  net.IP
+	err error
+}
+
+// ResolvePublicIP represents a function to resolve the public IP address by the tiers in order
+func (resolver *PrioritizedResolver) ResolvePublicIP(ctx context.Context) (string, error) {
+	var errs []string
+
+	for i, tier := range resolver.Tiers {
+		ip, err := resolver.resolveByTier(ctx, tier)
+		if err == nil {
+			return ip, nil
+		}
+		errs = append(errs, fmt.Sprintf("tier %d: %v", i, err))
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return "", fmt.Errorf("%w (%s)", ErrNoPublicIP, strings.Join(errs, "; "))
+}
+
+// resolveByTier looks up the sources in a tier concurrently, and returns the public IP address
+// agreed by the quorum. The preceding source is preferred if more than one address reaches the quorum.
+func (resolver *PrioritizedResolver) resolveByTier(ctx context.Context, tier Tier) (string, error) {
+	timeout := resolver.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	quorum := tier.Quorum
+	if quorum <= 0 {
+		quorum = 1
+	}
+
+	answers := make([]answer, len(tier.Sources))
+	var wg sync.WaitGroup
+	for i, source := range tier.Sources {
+		wg.Add(1)
+		go func(i int, source Source) {
+			defer wg.Done()
+			sourceCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			ip, err := source.LookupPublicIP(sourceCtx)
+			if err == nil && (ip == nil || ip.IsUnspecified() || ip.IsLoopback()) {
+				err = fmt.Errorf("not a routable IP address (%v)", ip)
+			}
+			answers[i] = answer{ip: ip, err: err}
+		}(i, source)
+	}
+	wg.Wait()
+
+	// Count the votes for each IP address
+	votes := make(map[string]int)
+	var errs []string
+	for i, a := range answers {
+		if a.err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", tier.Sources[i].Name(), a.err))
+			continue
+		}
+		votes[a.ip.String()]++
+	}
+
+	for _, a := range answers {
+		if a.err == nil && votes[a.ip.String()] >= quorum {
+			return a.ip.String(), nil
+		}
+	}
+
+	if len(votes) > 0 {
+		return "", fmt.Errorf("no consensus of %d source(s) on %v", quorum, votes)
+	}
+	return "", errors.New(strings.Join(errs, ", "))
+}
+
+// StaticSource returns a public IP address given by configuration
+type StaticSource struct {
+	IP string
+}
+
+// Name returns the name of the source
+func (source StaticSource) Name() string {
+	return "static"
+}
+
+// LookupPublicIP returns the static public IP address
+func (source StaticSource) LookupPublicIP(ctx context.Context) (net.IP, error) {
+	ip := net.ParseIP(strings.TrimSpace(source.IP))
+	if ip == nil {
+		return nil, fmt.Errorf("not an IP address (%q)", source.IP)
+	}
+	return ip, nil
+}
+
+// HTTPSource looks up a public IP address in the response body of an HTTP GET request,
+// such as an instance metadata service or an HTTP echo service.
+// If TokenURL is set, a session token is issued by a PUT request to it first (e.g., AWS IMDSv2).
+type HTTPSource struct {
+	SourceName  string
+	URL         string
+	Header      map[string]string
+	TokenURL    string
+	TokenHeader map[string]string
+	TokenName   string // Header name to put the issued token
+}
+
+// Name returns the name of the source
+func (source HTTPSource) Name() string {
+	return source.SourceName
+}
+
+// LookupPublicIP requests and parses the public IP address
+func (source HTTPSource) LookupPublicIP(ctx context.Context) (net.IP, error) {
+	header := make(map[string]string)
+	for key, value := range source.Header {
+		header[key] = value
+	}
+
+	if source.TokenURL != "" {
+		token, err := doHTTPRequest(ctx, http.MethodPut, source.TokenURL, source.TokenHeader)
+		if err != nil {
+			return nil, fmt.Errorf("could not issue a token: %w", err)
+		}
+		header[source.TokenName] = token
+	}
+
+	body, err := doHTTPRequest(ctx, http.MethodGet, source.URL, header)
+	if err != nil {
+		return nil, err
+	}
+
+	ip := net.ParseIP(body)
+	if ip == nil {
+		return nil, fmt.Errorf("not an IP address (%q)", body)
+	}
+	return ip, nil
+}
+
+// doHTTPRequest requests and returns the trimmed response body
+func doHTTPRequest(ctx context.Context, method string, url string, header map[string]string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return "", err
+	}
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status (%v)", resp.Status)
+	}
+
+	// An IP address is short enough
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// AWSMetadataSource returns a source of the instance metadata service (IMDSv2) of AWS
+func AWSMetadataSource() HTTPSource {
+	return HTTPSource{
+		SourceName:  "aws-metadata",
+		URL:         "http://169.254.169.254/latest/meta-data/public-ipv4",
+		TokenURL:    "http://169.254.169.254/latest/api/token",
+		TokenHeader: map[string]string{"X-aws-ec2-metadata-token-ttl-seconds": "60"},
+		TokenName:   "X-aws-ec2-metadata-token",
+	}
+}
+
+// GCPMetadataSource returns a source of the metadata server of GCP
+func GCPMetadataSource() HTTPSource {
+	return HTTPSource{
+		SourceName: "gcp-metadata",
+		URL:        "http://metadata.google.internal/computeMetadata/v1/instance/network-interfaces/0/access-configs/0/external-ip",
+		Header:     map[string]string{"Metadata-Flavor": "Google"},
+	}
+}
+
+// AzureMetadataSource returns a source of the instance metadata service of Azure
+func AzureMetadataSource() HTTPSource {
+	return HTTPSource{
+		SourceName: "azure-metadata",
+		URL:        "http://169.254.169.254/metadata/instance/network/interface/0/ipv4/ipAddress/0/publicIpAddress?api-version=2021-02-01&format=text",
+		Header:     map[string]string{"Metadata": "true"},
+	}
+}
+
+// AlibabaMetadataSource returns a source of the instance metadata service of Alibaba Cloud
+func AlibabaMetadataSource() HTTPSource {
+	return HTTPSource{
+		SourceName: "alibaba-metadata",
+		URL:        "http://100.100.100.200/latest/meta-data/eipv4",
+	}
+}
+
+// STUN (RFC5389) message types, attributes and the magic cookie
+const (
+	stunBindingRequest      = 0x0001
+	stunBindingSuccess      = 0x0101
+	stunMappedAddress       = 0x0001
+	stunXORMappedAddress    = 0x0020
+	stunMagicCookie         = 0x2112A442
+	stunHeaderSize          = 20
+	stunAddressFamilyIPv4   = 0x01
+	stunAddressFamilyIPv6   = 0x02
+	stunMaxResponseByteSize = 1500
+)
+
+// STUNSource looks up a public IP address by a binding request to a STUN server
+type STUNSource struct {
+	Server string // host:port
+}
+
+// Name returns the name of the source
+func (source STUNSource) Name() string {
+	return "stun:" + source.Server
+}
+
+// LookupPublicIP sends a binding request and parses the mapped address in the response
+func (source STUNSource) LookupPublicIP(ctx context.Context) (net.IP, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp4", source.Server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	request := make([]byte, stunHeaderSize)
+	binary.BigEndian.PutUint16(request[0:2], stunBindingRequest)
+	binary.BigEndian.PutUint16(request[2:4], 0)
+	binary.BigEndian.PutUint32(request[4:8], stunMagicCookie)
+	if _, err := rand.Read(request[8:20]); err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.Write(request); err != nil {
+		return nil, err
+	}
+
+	response := make([]byte, stunMaxResponseByteSize)
+	n, err := conn.Read(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSTUNBindingResponse(response[:n], request[8:20])
+}
+
+// ParseSTUNBindingResponse parses the (XOR-)MAPPED-ADDRESS of a STUN binding success response
+// of the transaction ID.
+func ParseSTUNBindingResponse(response []byte, transactionID []byte) (net.IP, error) {
+	if len(response) < stunHeaderSize {
+		return nil, errors.New("too short STUN response")
+	}
+	if binary.BigEndian.Uint16(response[0:2]) != stunBindingSuccess {
+		return nil, fmt.Errorf("not a STUN binding success response (type: %#04x)", binary.BigEndian.Uint16(response[0:2]))
+	}
+	if binary.BigEndian.Uint32(response[4:8]) != stunMagicCookie || string(response[8:20]) != string(transactionID) {
+		return nil, errors.New("not a STUN response of the transaction")
+	}
+
+	length := int(binary.BigEndian.Uint16(response[2:4]))
+	if stunHeaderSize+length > len(response) {
+		return nil, errors.New("truncated STUN response")
+	}
+	attributes := response[stunHeaderSize : stunHeaderSize+length]
+
+	var mappedIP net.IP
+	for len(attributes) >= 4 {
+		attributeType := binary.BigEndian.Uint16(attributes[0:2])
+		attributeLength := int(binary.BigEndian.Uint16(attributes[2:4]))
+		// Attributes are padded to a multiple of 4 bytes
+		paddedLength := (attributeLength + 3) / 4 * 4
+		if 4+paddedLength > len(attributes) {
+			return nil, errors.New("truncated STUN attribute")
+		}
+		value := attributes[4 : 4+attributeLength]
+
+		switch attributeType {
+		case stunXORMappedAddress:
+			// XOR-MAPPED-ADDRESS is preferred
+			return parseSTUNAddress(value, response[4:20])
+		case stunMappedAddress:
+			if ip, err := parseSTUNAddress(value, nil); err == nil {
+				mappedIP = ip
+			}
+		}
+
+		attributes = attributes[4+paddedLength:]
+	}
+
+	if mappedIP == nil {
+		return nil, errors.New("no mapped address in the STUN response")
+	}
+	return mappedIP, nil
+}
+
+// parseSTUNAddress parses the value of a (XOR-)MAPPED-ADDRESS attribute.
+// The address is XOR-ed with the magic cookie and the transaction ID if xorKey is given.
+func parseSTUNAddress(value []byte, xorKey []byte) (net.IP, error) {
+	if len(value) < 4 {
+		return nil, errors.New("too short STUN address")
+	}
+
+	var size int
+	switch value[1] {
+	case stunAddressFamilyIPv4:
+		size = net.IPv4len
+	case stunAddressFamilyIPv6:
+		size = net.IPv6len
+	default:
+		return nil, fmt.Errorf("unknown STUN address family (%#02x)", value[1])
+	}
+	if len(value) < 4+size {
+		return nil, errors.New("too short STUN address")
+	}
+
+	ip := make(net.IP, size)
+	copy(ip, value[4:4+size])
+	if xorKey != nil {
+		for i := range ip {
+			ip[i] ^= xorKey[i]
+		}
+	}
+	return ip, nil
+}
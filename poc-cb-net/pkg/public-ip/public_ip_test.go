@@ -0,0 +1,357 @@
+package publicip
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testTransactionID = []byte("0123456789ab")
+
+// stunAttribute builds an attribute, padded to a multiple of 4 bytes if pad is true
+func stunAttribute(attributeType uint16, value []byte, pad bool) []byte {
+	attribute := make([]byte, 4, 4+len(value)+3)
+	binary.BigEndian.PutUint16(attribute[0:2], attributeType)
+	binary.BigEndian.PutUint16(attribute[2:4], uint16(len(value)))
+	attribute = append(attribute, value...)
+	if pad {
+		for len(attribute)%4 != 0 {
+			attribute = append(attribute, 0)
+		}
+	}
+	return attribute
+}
+
+// stunResponse builds a binding success response of testTransactionID
+func stunResponse(attributes ...[]byte) []byte {
+	var body []byte
+	for _, attribute := range attributes {
+		body = append(body, attribute...)
+	}
+	response := make([]byte, stunHeaderSize, stunHeaderSize+len(body))
+	binary.BigEndian.PutUint16(response[0:2], stunBindingSuccess)
+	binary.BigEndian.PutUint16(response[2:4], uint16(len(body)))
+	binary.BigEndian.PutUint32(response[4:8], stunMagicCookie)
+	copy(response[8:20], testTransactionID)
+	return append(response, body...)
+}
+
+func mappedAddress(ip net.IP) []byte {
+	ip4 := ip.To4()
+	value := []byte{0, stunAddressFamilyIPv4, 0x12, 0x34}
+	return append(value, ip4...)
+}
+
+func xorMappedAddress(ip net.IP) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint32(key[0:4], stunMagicCookie)
+	copy(key[4:16], testTransactionID)
+
+	ip4 := ip.To4()
+	value := []byte{0, stunAddressFamilyIPv4, 0x12, 0x34}
+	for i := range ip4 {
+		value = append(value, ip4[i]^key[i])
+	}
+	return value
+}
+
+func TestParseSTUNBindingResponse(t *testing.T) {
+	mapped := net.ParseIP("198.51.100.1")
+	xorMapped := net.ParseIP("203.0.113.7")
+
+	tests := []struct {
+		name     string
+		response []byte
+		want     net.IP
+		wantErr  bool
+	}{
+		{
+			name:     "mapped address",
+			response: stunResponse(stunAttribute(stunMappedAddress, mappedAddress(mapped), true)),
+			want:     mapped,
+		},
+		{
+			name:     "xor-mapped address",
+			response: stunResponse(stunAttribute(stunXORMappedAddress, xorMappedAddress(xorMapped), true)),
+			want:     xorMapped,
+		},
+		{
+			name: "xor-mapped address is preferred to mapped address",
+			response: stunResponse(
+				stunAttribute(stunMappedAddress, mappedAddress(mapped), true),
+				stunAttribute(stunXORMappedAddress, xorMappedAddress(xorMapped), true),
+			),
+			want: xorMapped,
+		},
+		{
+			name: "unknown attribute with padding is skipped",
+			response: stunResponse(
+				stunAttribute(0x8022, []byte("agent"), true),
+				stunAttribute(stunMappedAddress, mappedAddress(mapped), true),
+			),
+			want: mapped,
+		},
+		{
+			name:     "unpadded final attribute",
+			response: stunResponse(stunAttribute(stunMappedAddress, append(mappedAddress(mapped), 0), false)),
+			wantErr:  true,
+		},
+		{
+			name: "unpadded attribute followed by another",
+			response: stunResponse(
+				stunAttribute(0x8022, []byte("agent"), false),
+				stunAttribute(stunMappedAddress, mappedAddress(mapped), true),
+			),
+			wantErr: true,
+		},
+		{
+			name:     "truncated attribute",
+			response: stunResponse(stunAttribute(stunMappedAddress, mappedAddress(mapped), true))[:stunHeaderSize+6],
+			wantErr:  true,
+		},
+		{
+			name:     "too short address",
+			response: stunResponse(stunAttribute(stunXORMappedAddress, []byte{0, stunAddressFamilyIPv4, 0, 0}, true)),
+			wantErr:  true,
+		},
+		{
+			name:     "no mapped address",
+			response: stunResponse(stunAttribute(0x8022, []byte("agent"), true)),
+			wantErr:  true,
+		},
+		{
+			name:     "too short response",
+			response: make([]byte, stunHeaderSize-1),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The length in the header is kept, so a cut response is also truncated
+			got, err := ParseSTUNBindingResponse(tt.response, testTransactionID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseSTUNBindingResponse() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSTUNBindingResponse() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseSTUNBindingResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSTUNBindingResponseOfAnotherTransaction(t *testing.T) {
+	response := stunResponse(stunAttribute(stunMappedAddress, mappedAddress(net.ParseIP("198.51.100.1")), true))
+	if _, err := ParseSTUNBindingResponse(response, []byte("ba9876543210")); err == nil {
+		t.Error("ParseSTUNBindingResponse() accepted a response of another transaction")
+	}
+}
+
+// fakeSource answers the IP address or the error after the delay
+type fakeSource struct {
+	name  string
+	ip    string
+	err   error
+	delay time.Duration
+}
+
+func (source fakeSource) Name() string {
+	return source.name
+}
+
+func (source fakeSource) LookupPublicIP(ctx context.Context) (net.IP, error) {
+	select {
+	case <-time.After(source.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if source.err != nil {
+		return nil, source.err
+	}
+	return net.ParseIP(source.ip), nil
+}
+
+func TestPrioritizedResolver(t *testing.T) {
+	errDown := errors.New("down")
+
+	tests := []struct {
+		name    string
+		tiers   []Tier
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "first tier wins",
+			tiers: []Tier{
+				{Sources: []Source{fakeSource{name: "a", ip: "198.51.100.1"}}, Quorum: 1},
+				{Sources: []Source{fakeSource{name: "b", ip: "198.51.100.2"}}, Quorum: 1},
+			},
+			want: "198.51.100.1",
+		},
+		{
+			name: "falls back to the next tier",
+			tiers: []Tier{
+				{Sources: []Source{fakeSource{name: "a", err: errDown}}, Quorum: 1},
+				{Sources: []Source{fakeSource{name: "b", ip: "198.51.100.2"}}, Quorum: 1},
+			},
+			want: "198.51.100.2",
+		},
+		{
+			name: "quorum reached",
+			tiers: []Tier{
+				{Sources: []Source{
+					fakeSource{name: "a", ip: "198.51.100.1"},
+					fakeSource{name: "b", ip: "198.51.100.9"},
+					fakeSource{name: "c", ip: "198.51.100.9"},
+				}, Quorum: 2},
+			},
+			want: "198.51.100.9",
+		},
+		{
+			name: "no consensus",
+			tiers: []Tier{
+				{Sources: []Source{
+					fakeSource{name: "a", ip: "198.51.100.1"},
+					fakeSource{name: "b", ip: "198.51.100.2"},
+					fakeSource{name: "c", err: errDown},
+				}, Quorum: 2},
+			},
+			wantErr: true,
+		},
+		{
+			name: "preceding source is preferred on a tie",
+			tiers: []Tier{
+				{Sources: []Source{
+					fakeSource{name: "a", ip: "198.51.100.2", delay: 50 * time.Millisecond},
+					fakeSource{name: "b", ip: "198.51.100.1"},
+				}, Quorum: 1},
+			},
+			want: "198.51.100.2",
+		},
+		{
+			name: "loopback and unspecified addresses are rejected",
+			tiers: []Tier{
+				{Sources: []Source{
+					fakeSource{name: "a", ip: "127.0.0.1"},
+					fakeSource{name: "b", ip: "0.0.0.0"},
+				}, Quorum: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "slow source times out",
+			tiers: []Tier{
+				{Sources: []Source{fakeSource{name: "a", ip: "198.51.100.1", delay: time.Second}}, Quorum: 1},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &PrioritizedResolver{Tiers: tt.tiers, Timeout: 200 * time.Millisecond}
+			got, err := resolver.ResolvePublicIP(context.Background())
+			if tt.wantErr {
+				if !errors.Is(err, ErrNoPublicIP) {
+					t.Fatalf("ResolvePublicIP() = %q, %v, want ErrNoPublicIP", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolvePublicIP() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolvePublicIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPSource(t *testing.T) {
+	const token = "issued-token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			if r.Method != http.MethodPut || r.Header.Get("X-ttl") != "60" {
+				http.Error(w, "bad token request", http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(token))
+		case "/ip":
+			if r.Header.Get("X-token") != token || r.Header.Get("Metadata") != "true" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(" 203.0.113.7\n"))
+		case "/garbage":
+			w.Write([]byte("<html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tokenSource := HTTPSource{
+		SourceName:  "token",
+		URL:         server.URL + "/ip",
+		Header:      map[string]string{"Metadata": "true"},
+		TokenURL:    server.URL + "/token",
+		TokenHeader: map[string]string{"X-ttl": "60"},
+		TokenName:   "X-token",
+	}
+
+	tests := []struct {
+		name    string
+		source  HTTPSource
+		want    string
+		wantErr bool
+	}{
+		{name: "token flow", source: tokenSource, want: "203.0.113.7"},
+		{name: "without token", source: HTTPSource{SourceName: "no-token", URL: server.URL + "/ip", Header: map[string]string{"Metadata": "true"}}, wantErr: true},
+		{name: "not an IP address", source: HTTPSource{SourceName: "garbage", URL: server.URL + "/garbage"}, wantErr: true},
+		{name: "not found", source: HTTPSource{SourceName: "missing", URL: server.URL + "/missing"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.source.LookupPublicIP(context.Background())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("LookupPublicIP() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LookupPublicIP() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("LookupPublicIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// An HTTP source in a tier with a failing one
+	resolver := &PrioritizedResolver{
+		Tiers: []Tier{
+			{Sources: []Source{HTTPSource{SourceName: "missing", URL: server.URL + "/missing"}}, Quorum: 1},
+			{Sources: []Source{tokenSource, fakeSource{name: "fake", ip: "203.0.113.7"}}, Quorum: 2},
+		},
+		Timeout: time.Second,
+	}
+	got, err := resolver.ResolvePublicIP(context.Background())
+	if err != nil || got != "203.0.113.7" {
+		t.Errorf("ResolvePublicIP() = %q, %v, want 203.0.113.7", got, err)
+	}
+}
@@ -7,3 +7,12 @@ const (
 	// CostPrioritized is a constant variable for the cost prioritized rule
 	CostPrioritized = "cost-prioritized"
 )
+
+// Exists checks if a rule type is one of the rule types above
+func Exists(ruleType string) bool {
+	switch ruleType {
+	case Basic, CostPrioritized:
+		return true
+	}
+	return false
+}
@@ -3,86 +3,32 @@ package secutil
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
 	"errors"
-	"fmt"
 	"io/ioutil"
 	"os"
-	"path/filepath"
-
-	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/file"
-	cblog "github.com/cloud-barista/cb-log"
-	"github.com/sirupsen/logrus"
 )
 
-// CBLogger represents a logger to show execution processes according to the logging level.
-var CBLogger *logrus.Logger
-
-func init() {
-	fmt.Println("\nStart......... init() of secret-util.go")
-
-	// Set cb-log
-	env := os.Getenv("CBLOG_ROOT")
-	if env != "" {
-		// Load cb-log config from the environment variable path (default)
-		fmt.Printf("CBLOG_ROOT: %v\n", env)
-		CBLogger = cblog.GetLogger("cb-network")
-	} else {
-
-		// Load cb-log config from the current directory (usually for the production)
-		ex, err := os.Executable()
-		if err != nil {
-			panic(err)
-		}
-		exePath := filepath.Dir(ex)
-		// fmt.Printf("exe path: %v\n", exePath)
-
-		logConfPath := filepath.Join(exePath, "config", "log_conf.yaml")
-		if file.Exists(logConfPath) {
-			fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
-			CBLogger = cblog.GetLoggerWithConfigPath("cb-network", logConfPath)
-
-		} else {
-			// Load cb-log config from the project directory (usually for development)
-			logConfPath = filepath.Join(exePath, "..", "..", "config", "log_conf.yaml")
-			if file.Exists(logConfPath) {
-				fmt.Printf("path of log_conf.yaml: %v\n", logConfPath)
-				CBLogger = cblog.GetLoggerWithConfigPath("cb-network", logConfPath)
-			} else {
-				err := errors.New("fail to load log_conf.yaml")
-				panic(err)
-			}
-		}
-		CBLogger.Debugf("Load %v", logConfPath)
-	}
-
-	fmt.Println("End......... init() of secret-util.go")
-	fmt.Println("")
-}
-
 const (
 	rsaKeySize = 2048
 )
 
 // GenerateRSAKey generates a pair of RSA private and public keys.
 func GenerateRSAKey() (*rsa.PrivateKey, *rsa.PublicKey, error) {
-	CBLogger.Debug("Start.........")
-
 	// Generate RSA key
 	privateKey, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	CBLogger.Debug("End.........")
 	return privateKey, &privateKey.PublicKey, nil
 }
 
 // RSAKeyToBytes converts a pair of RSA private and public keys to []byte.
 func RSAKeyToBytes(privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey) ([]byte, []byte, error) {
-	CBLogger.Debug("Start.........")
 	privateKeyBytes, err := PrivateKeyToBytes(privateKey)
 	if err != nil {
 		return nil, nil, err
@@ -93,42 +39,31 @@ func RSAKeyToBytes(privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey) ([]byte
 		return nil, nil, err
 	}
 
-	CBLogger.Debug("End.........")
 	return privateKeyBytes, publicKeyBytes, nil
 }
 
 // PrivateKeyToBytes converts a pair of RSA private key to []byte.
 func PrivateKeyToBytes(privateKey *rsa.PrivateKey) ([]byte, error) {
-	CBLogger.Debug("Start.........")
-
 	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
 	if err != nil {
 		return nil, err
 	}
-	CBLogger.Tracef("privateKeyBytes: %+v", privateKeyBytes)
 
-	CBLogger.Debug("End.........")
 	return privateKeyBytes, nil
 }
 
 // PublicKeyToBytes converts a pair of RSA public key to []byte.
 func PublicKeyToBytes(publicKey *rsa.PublicKey) ([]byte, error) {
-	CBLogger.Debug("Start.........")
-
 	publicKeyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
 	if err != nil {
 		return nil, err
 	}
-	CBLogger.Tracef("publicKeyBytes: %+v", publicKeyBytes)
 
-	CBLogger.Debug("End.........")
 	return publicKeyBytes, nil
 }
 
 // SaveRSAKeyToFile saves a pair of RSA private and public keys to each key file.
 func SaveRSAKeyToFile(privateKeyBytes []byte, pemPath string, publicKeyBytes []byte, pubPath string) error {
-	CBLogger.Debug("Start.........")
-
 	if err := SavePrivateKeyToFile(privateKeyBytes, pemPath); err != nil {
 		return err
 	}
@@ -137,14 +72,11 @@ func SaveRSAKeyToFile(privateKeyBytes []byte, pemPath string, publicKeyBytes []b
 		return err
 	}
 
-	CBLogger.Debug("End.........")
 	return nil
 }
 
 // SavePrivateKeyToFile saves a RSA private key to a key file.
 func SavePrivateKeyToFile(privateKeyBytes []byte, pemPath string) error {
-	CBLogger.Debug("Start.........")
-
 	// Save private key to file
 	privateKeyBlock := &pem.Block{
 		Type:  "RSA PRIVATE KEY",
@@ -159,14 +91,11 @@ func SavePrivateKeyToFile(privateKeyBytes []byte, pemPath string) error {
 		return err
 	}
 
-	CBLogger.Debug("End.........")
 	return nil
 }
 
 // SavePublicKeyToFile saves a RSA public key to a key file.
 func SavePublicKeyToFile(publicKeyBytes []byte, pubPath string) error {
-	CBLogger.Debug("Start.........")
-
 	// Save public key to file
 	publicKeyBlock := &pem.Block{
 		Type:  "RSA PUBLIC KEY",
@@ -182,14 +111,11 @@ func SavePublicKeyToFile(publicKeyBytes []byte, pubPath string) error {
 		return err
 	}
 
-	CBLogger.Debug("End.........")
 	return nil
 }
 
 // LoadPrivateKeyFromFile loads a RSA private key from a key file.
 func LoadPrivateKeyFromFile(pemPath string) (*rsa.PrivateKey, error) {
-	CBLogger.Debug("Start.........")
-
 	// Load private key from file
 	privateKeyBytes, err := ioutil.ReadFile(pemPath)
 	if err != nil {
@@ -212,14 +138,11 @@ func LoadPrivateKeyFromFile(pemPath string) (*rsa.PrivateKey, error) {
 	// 	privPemBytes = privateKeyPem.Bytes
 	// }
 
-	CBLogger.Debug("End.........")
 	return PrivateKeyFromBytes(privateKeyPem.Bytes)
 }
 
 // LoadPublicKeyFromFile loads a RSA public key from a key file.
 func LoadPublicKeyFromFile(pubPath string) (*rsa.PublicKey, error) {
-	CBLogger.Debug("Start.........")
-
 	// Load public key from file
 	publicKeyBytes, err := ioutil.ReadFile(pubPath)
 	if err != nil {
@@ -230,39 +153,42 @@ func LoadPublicKeyFromFile(pubPath string) (*rsa.PublicKey, error) {
 		return nil, errors.New("failed to decode PEM block containing public key")
 	}
 
-	CBLogger.Debug("End.........")
 	return PublicKeyFromBytes(publicKeyPem.Bytes)
 }
 
 // PublicKeyToBase64 convert a RSA public key to a base64 string.
 func PublicKeyToBase64(publicKey *rsa.PublicKey) (string, error) {
-	CBLogger.Debug("Start.........")
 	publicKeyBytes, err := PublicKeyToBytes(publicKey)
 	if err != nil {
 		return "", err
 	}
 
-	CBLogger.Debug("End.........")
 	return base64.StdEncoding.EncodeToString(publicKeyBytes), nil
 }
 
 // PublicKeyFromBase64 convert a base64 string to a RSA public key.
 func PublicKeyFromBase64(key string) (*rsa.PublicKey, error) {
-	CBLogger.Debug("Start.........")
-
 	publicKeyBytes, err := base64.StdEncoding.DecodeString(key)
 	if err != nil {
 		return nil, err
 	}
 
-	CBLogger.Debug("End.........")
 	return PublicKeyFromBytes(publicKeyBytes)
 }
 
+// PublicKeyFingerprint returns a SHA-256 fingerprint of a base64 RSA public key (e.g., "SHA256:...").
+func PublicKeyFingerprint(key string) (string, error) {
+	publicKeyBytes, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(publicKeyBytes)
+	return "SHA256:" + base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
 // PublicKeyFromBytes convert a base64 bytes to a RSA public key.
 func PublicKeyFromBytes(publicKeyBytes []byte) (*rsa.PublicKey, error) {
-	CBLogger.Debug("Start.........")
-
 	publicKeyInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
 	if err != nil {
 		return nil, err
@@ -272,14 +198,11 @@ func PublicKeyFromBytes(publicKeyBytes []byte) (*rsa.PublicKey, error) {
 		return nil, errors.New("invalid public key")
 	}
 
-	CBLogger.Debug("End.........")
 	return publicKey, nil
 }
 
 // PrivateKeyFromBytes convert a base64 bytes to a RSA private key.
 func PrivateKeyFromBytes(privateKeyBytes []byte) (*rsa.PrivateKey, error) {
-	CBLogger.Debug("Start.........")
-
 	var privateKeyInterface interface{}
 	var err error
 	if privateKeyInterface, err = x509.ParsePKCS1PrivateKey(privateKeyBytes); err != nil {
@@ -292,6 +215,5 @@ func PrivateKeyFromBytes(privateKeyBytes []byte) (*rsa.PrivateKey, error) {
 		return nil, errors.New("invalid private key")
 	}
 
-	CBLogger.Debug("End.........")
 	return privateKey, nil
 }
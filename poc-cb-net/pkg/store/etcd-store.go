@@ -0,0 +1,213 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// etcdBackend is a backend by an etcd cluster
+type etcdBackend struct {
+	client *clientv3.Client
+}
+
+// NewEtcdStore creates a store on an etcd client (see etcdclient.New).
+// The etcd client is closed when the store is closed.
+func NewEtcdStore(client *clientv3.Client) Store {
+	return &kvStore{backend: &etcdBackend{client: client}}
+}
+
+// ttlSeconds converts a TTL to seconds of an etcd lease (at least 1 second)
+func ttlSeconds(ttl time.Duration) int64 {
+	seconds := int64(ttl / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+func (b *etcdBackend) get(ctx context.Context, key string, prefix bool) ([]keyValue, error) {
+	var opts []clientv3.OpOption
+	if prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+
+	resp, err := b.client.Get(ctx, key, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	kvs := make([]keyValue, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		kvs = append(kvs, keyValue{key: string(kv.Key), value: string(kv.Value), modRevision: kv.ModRevision})
+	}
+	return kvs, nil
+}
+
+func (b *etcdBackend) count(ctx context.Context, key string, prefix bool) (int64, error) {
+	opts := []clientv3.OpOption{clientv3.WithCountOnly()}
+	if prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+
+	resp, err := b.client.Get(ctx, key, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
+
+// opPut creates a put operation, and grants a lease if the TTL is set
+func (b *etcdBackend) opPut(ctx context.Context, key string, value string, ttl time.Duration) (clientv3.Op, error) {
+	if ttl <= 0 {
+		return clientv3.OpPut(key, value), nil
+	}
+
+	grantResp, err := b.client.Grant(ctx, ttlSeconds(ttl))
+	if err != nil {
+		return clientv3.Op{}, err
+	}
+	return clientv3.OpPut(key, value, clientv3.WithLease(grantResp.ID)), nil
+}
+
+func (b *etcdBackend) put(ctx context.Context, key string, value string, ttl time.Duration) error {
+	op, err := b.opPut(ctx, key, value, ttl)
+	if err != nil {
+		return err
+	}
+	_, err = b.client.Do(ctx, op)
+	return err
+}
+
+func (b *etcdBackend) delete(ctx context.Context, key string) (*keyValue, error) {
+	resp, err := b.client.Delete(ctx, key, clientv3.WithPrevKV())
+	if err != nil {
+		return nil, err
+	}
+	if resp.Deleted == 0 || len(resp.PrevKvs) == 0 {
+		return nil, nil
+	}
+
+	prevKV := resp.PrevKvs[0]
+	return &keyValue{key: string(prevKV.Key), value: string(prevKV.Value), modRevision: prevKV.ModRevision}, nil
+}
+
+func (b *etcdBackend) toOps(ctx context.Context, operations []operation) ([]clientv3.Op, error) {
+	ops := make([]clientv3.Op, 0, len(operations))
+	for _, operation := range operations {
+		if operation.isDelete {
+			ops = append(ops, clientv3.OpDelete(operation.key))
+			continue
+		}
+
+		op, err := b.opPut(ctx, operation.key, operation.value, operation.ttl)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func (b *etcdBackend) txn(ctx context.Context, cmp compare, thenOps []operation, elseOps []operation) (bool, error) {
+	var cmpEtcd clientv3.Cmp
+	if cmp.value != nil {
+		cmpEtcd = clientv3.Compare(clientv3.Value(cmp.key), "=", *cmp.value)
+	} else {
+		cmpEtcd = clientv3.Compare(clientv3.ModRevision(cmp.key), "=", cmp.modRevision)
+	}
+
+	opsThen, err := b.toOps(ctx, thenOps)
+	if err != nil {
+		return false, err
+	}
+	opsElse, err := b.toOps(ctx, elseOps)
+	if err != nil {
+		return false, err
+	}
+
+	txnResp, err := b.client.Txn(ctx).If(cmpEtcd).Then(opsThen...).Else(opsElse...).Commit()
+	if err != nil {
+		return false, err
+	}
+	return txnResp.Succeeded, nil
+}
+
+func (b *etcdBackend) watch(ctx context.Context, key string, prefix bool, startRevision int64) WatchChan {
+	opts := []clientv3.OpOption{clientv3.WithCreatedNotify()}
+	if prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+	if startRevision > 0 {
+		opts = append(opts, clientv3.WithRev(startRevision))
+	}
+
+	out := make(chan WatchResponse)
+
+	go func() {
+		defer close(out)
+
+		watchChan := b.client.Watch(clientv3.WithRequireLeader(ctx), key, opts...)
+		for watchResponse := range watchChan {
+			resp := WatchResponse{Created: watchResponse.Created}
+
+			if watchResponse.CompactRevision != 0 {
+				resp = WatchResponse{CompactRevision: watchResponse.CompactRevision, Err: ErrCompacted}
+			} else if err := watchResponse.Err(); err != nil {
+				resp = WatchResponse{Err: err}
+			}
+
+			for _, event := range watchResponse.Events {
+				eventType := EventPut
+				if event.Type == mvccpb.DELETE {
+					eventType = EventDelete
+				}
+				resp.Events = append(resp.Events, Event{
+					Type:     eventType,
+					Key:      string(event.Kv.Key),
+					Value:    event.Kv.Value,
+					Revision: event.Kv.ModRevision,
+				})
+			}
+
+			select {
+			case out <- resp:
+			case <-ctx.Done():
+				return
+			}
+
+			if resp.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func (b *etcdBackend) lock(ctx context.Context, key string) (UnlockFunc, error) {
+	// Create a session to acquire a lock
+	session, err := concurrency.NewSession(b.client)
+	if err != nil {
+		return nil, err
+	}
+
+	mutex := concurrency.NewMutex(session, key)
+	if err := mutex.Lock(ctx); err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	return func() {
+		// The lock is released by the session anyway even if unlocking fails
+		mutex.Unlock(context.TODO())
+		session.Close()
+	}, nil
+}
+
+func (b *etcdBackend) close() error {
+	return b.client.Close()
+}
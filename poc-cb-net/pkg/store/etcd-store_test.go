@@ -0,0 +1,218 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// etcdEndpointsEnv is the environment variable of the etcd endpoints (comma-separated) to test the etcd store.
+// NOTE: An embedded etcd (go.etcd.io/etcd/server/v3/embed) is not a dependency of this module,
+// so the tests are skipped unless an etcd is given, e.g., CBNET_TEST_ETCD_ENDPOINTS=localhost:2379.
+const etcdEndpointsEnv = "CBNET_TEST_ETCD_ENDPOINTS"
+
+// newEtcdTestStore creates an etcd store and a CLADNet ID unique to the test, which is deleted after the test
+func newEtcdTestStore(t *testing.T) (Store, string) {
+	t.Helper()
+
+	endpoints := os.Getenv(etcdEndpointsEnv)
+	if endpoints == "" {
+		t.Skipf("%v is not set", etcdEndpointsEnv)
+	}
+
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(endpoints, ","),
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewEtcdStore(client)
+	cladnetID := fmt.Sprintf("cladnet-test-%d", time.Now().UnixNano())
+	t.Cleanup(func() {
+		if err := s.DeleteCLADNet(context.Background(), cladnetID); err != nil {
+			t.Error(err)
+		}
+		s.Close()
+	})
+	return s, cladnetID
+}
+
+func TestEtcdStoreCompareAndSwap(t *testing.T) {
+	ctx := context.Background()
+	s, cladnetID := newEtcdTestStore(t)
+
+	// Created only if it does not exist
+	spec := model.CLADNetSpecification{CladnetID: cladnetID, Ipv4AddressSpace: "10.0.0.0/24"}
+	if isCreated, err := s.CreateSpec(ctx, spec); err != nil || !isCreated {
+		t.Fatalf("CreateSpec() = %v, %v, want true", isCreated, err)
+	}
+	spec.Ipv4AddressSpace = "10.1.0.0/24"
+	if isCreated, err := s.CreateSpec(ctx, spec); err != nil || isCreated {
+		t.Errorf("CreateSpec() of an existing CLADNet = %v, %v, want false", isCreated, err)
+	}
+	if got, err := s.GetSpec(ctx, cladnetID); err != nil || got.Ipv4AddressSpace != "10.0.0.0/24" {
+		t.Errorf("GetSpec() = %+v, %v, want 10.0.0.0/24 kept", got, err)
+	}
+
+	// The value is put only if it is not equal
+	for i, step := range []struct {
+		publicKey string
+		want      bool
+	}{
+		{"key-1", true},
+		{"key-1", false},
+		{"key-2", true},
+	} {
+		if got, err := s.CompareAndSwapSecret(ctx, cladnetID, "host-01", step.publicKey); err != nil || got != step.want {
+			t.Errorf("#%d CompareAndSwapSecret(%v) = %v, %v, want %v", i, step.publicKey, got, err, step.want)
+		}
+	}
+
+	// The secret is revoked only if it has not been changed since it was read
+	secret, err := s.GetSecret(ctx, cladnetID, "host-01")
+	if err != nil || secret.PublicKey != "key-2" {
+		t.Fatalf("GetSecret() = %+v, %v", secret, err)
+	}
+	revocation := model.SecretRevocation{CladnetID: cladnetID, HostID: "host-01", Fingerprint: "fingerprint"}
+	stale := secret
+	stale.Revision--
+	if isRevoked, err := s.RevokeSecret(ctx, stale, revocation); err != nil || isRevoked {
+		t.Errorf("RevokeSecret() with a stale revision = %v, %v, want false", isRevoked, err)
+	}
+	if isRevoked, err := s.RevokeSecret(ctx, secret, revocation); err != nil || !isRevoked {
+		t.Errorf("RevokeSecret() = %v, %v, want true", isRevoked, err)
+	}
+	if _, err := s.GetSecret(ctx, cladnetID, "host-01"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSecret() after revocation error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestEtcdStoreLease(t *testing.T) {
+	ctx := context.Background()
+	s, cladnetID := newEtcdTestStore(t)
+
+	// The workload is acquired by only one caller until the lease expires
+	event := Event{Key: "/test/" + cladnetID, Revision: 1}
+	if isAcquired, err := s.TryToAcquireWorkload(ctx, event, time.Second); err != nil || !isAcquired {
+		t.Fatalf("TryToAcquireWorkload() = %v, %v, want true", isAcquired, err)
+	}
+	if isAcquired, err := s.TryToAcquireWorkload(ctx, event, time.Second); err != nil || isAcquired {
+		t.Errorf("TryToAcquireWorkload() again = %v, %v, want false", isAcquired, err)
+	}
+
+	// The lease of 1 second expires within a few seconds
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		isAcquired, err := s.TryToAcquireWorkload(ctx, event, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if isAcquired {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("TryToAcquireWorkload() after the lease expires = false, want true")
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+func TestEtcdStoreLock(t *testing.T) {
+	ctx := context.Background()
+	s, cladnetID := newEtcdTestStore(t)
+
+	unlock, err := s.AcquireLock(ctx, PeerLock, cladnetID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The lock is not acquired by another session while it is held
+	timeoutCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	if unlock, err := s.AcquireLock(timeoutCtx, PeerLock, cladnetID); err == nil {
+		unlock()
+		t.Error("AcquireLock() while it is held error = nil, want the timeout")
+	}
+
+	// The other locks are independent
+	unlockRule, err := s.AcquireLock(ctx, NetworkingRuleLock, cladnetID)
+	if err != nil {
+		t.Fatalf("AcquireLock() of another lock error = %v", err)
+	}
+	unlockRule()
+
+	// The waiter acquires the lock after it is released
+	acquired := make(chan error, 1)
+	go func() {
+		unlock, err := s.AcquireLock(ctx, PeerLock, cladnetID)
+		if err == nil {
+			unlock()
+		}
+		acquired <- err
+	}()
+
+	select {
+	case err := <-acquired:
+		t.Fatalf("AcquireLock() returns %v while the lock is held", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	unlock()
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Errorf("AcquireLock() after the release error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("AcquireLock() is not returned after the release")
+	}
+}
+
+func TestEtcdStoreWatchPrefix(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s, cladnetID := newEtcdTestStore(t)
+
+	// A CLADNet ID having the CLADNet ID as a prefix
+	otherCladnetID := cladnetID + "2"
+	t.Cleanup(func() { s.DeleteCLADNet(context.Background(), otherCladnetID) })
+
+	watchChan := s.WatchPeers(ctx, cladnetID, 0)
+	if watchResponse := <-watchChan; !watchResponse.Created || watchResponse.Err != nil {
+		t.Fatalf("the first watch response = %+v, want Created", watchResponse)
+	}
+
+	for _, peer := range []model.Peer{
+		{CladnetID: otherCladnetID, HostID: "host-01"},
+		{CladnetID: cladnetID, HostID: "host-02"},
+	} {
+		if err := s.PutPeer(ctx, peer); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only the peer of the CLADNet is watched, and the IDs are parsed from the key
+	events := receiveEvents(t, watchChan, 1)
+	if events[0].Type != EventPut || events[0].CladnetID != cladnetID || events[0].HostID != "host-02" {
+		t.Errorf("the event = %v %v/%v, want PUT %v/host-02", events[0].Type, events[0].CladnetID, events[0].HostID, cladnetID)
+	}
+
+	// Watch from a revision to receive the changes in the meantime
+	if err := s.DeleteCLADNet(ctx, cladnetID); err != nil {
+		t.Fatal(err)
+	}
+	startRevision := events[0].Revision
+	events = receiveEvents(t, s.WatchPeers(ctx, cladnetID, startRevision), 2)
+	if events[0].Type != EventPut || events[1].Type != EventDelete || events[1].HostID != "host-02" {
+		t.Errorf("the events from revision %v = %+v, want PUT and DELETE of host-02", startRevision, events)
+	}
+}
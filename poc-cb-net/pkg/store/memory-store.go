@@ -0,0 +1,269 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryItem represents an item of the in-memory backend
+type memoryItem struct {
+	value       string
+	modRevision int64
+	leaseID     int64 // 0 if the item does not expire
+}
+
+// memoryWatcher receives events of a key (or a prefix)
+type memoryWatcher struct {
+	key     string
+	prefix  bool
+	mutex   sync.Mutex
+	pending []Event
+	notify  chan struct{}
+}
+
+func (w *memoryWatcher) matches(key string) bool {
+	if w.prefix {
+		return strings.HasPrefix(key, w.key)
+	}
+	return key == w.key
+}
+
+func (w *memoryWatcher) push(events ...Event) {
+	w.mutex.Lock()
+	w.pending = append(w.pending, events...)
+	w.mutex.Unlock()
+
+	// Notify without blocking
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *memoryWatcher) pop() []Event {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	events := w.pending
+	w.pending = nil
+	return events
+}
+
+// memoryBackend is an in-memory backend with the same semantics as etcd,
+// such as revisions, transactions, watches from a revision, TTLs and locks.
+// It is intended to test the cb-network components without an etcd cluster.
+type memoryBackend struct {
+	mutex    sync.Mutex
+	items    map[string]memoryItem
+	revision int64
+	leaseID  int64
+	history  []Event // All events to watch from a past revision
+	watchers map[*memoryWatcher]struct{}
+	locks    map[string]chan struct{}
+}
+
+// NewMemoryStore creates a store in memory.
+func NewMemoryStore() Store {
+	return &kvStore{backend: &memoryBackend{
+		items:    make(map[string]memoryItem),
+		watchers: make(map[*memoryWatcher]struct{}),
+		locks:    make(map[string]chan struct{}),
+	}}
+}
+
+func (b *memoryBackend) get(ctx context.Context, key string, prefix bool) ([]keyValue, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var kvs []keyValue
+	for k, item := range b.items {
+		if k == key || (prefix && strings.HasPrefix(k, key)) {
+			kvs = append(kvs, keyValue{key: k, value: item.value, modRevision: item.modRevision})
+		}
+	}
+
+	// Sort by key as etcd does
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].key < kvs[j].key })
+	return kvs, nil
+}
+
+func (b *memoryBackend) count(ctx context.Context, key string, prefix bool) (int64, error) {
+	kvs, err := b.get(ctx, key, prefix)
+	return int64(len(kvs)), err
+}
+
+// putLocked puts an item and notifies the watchers. The mutex must be held.
+func (b *memoryBackend) putLocked(key string, value string, ttl time.Duration) {
+	b.revision++
+	item := memoryItem{value: value, modRevision: b.revision}
+
+	if ttl > 0 {
+		b.leaseID++
+		item.leaseID = b.leaseID
+		leaseID := item.leaseID
+
+		// Delete the item when the TTL expires, unless it has been put again
+		time.AfterFunc(ttl, func() {
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
+			if current, ok := b.items[key]; ok && current.leaseID == leaseID {
+				b.deleteLocked(key)
+			}
+		})
+	}
+
+	b.items[key] = item
+	b.notifyLocked(Event{Type: EventPut, Key: key, Value: []byte(value), Revision: b.revision})
+}
+
+// deleteLocked deletes an item and notifies the watchers. The mutex must be held.
+func (b *memoryBackend) deleteLocked(key string) *keyValue {
+	item, ok := b.items[key]
+	if !ok {
+		return nil
+	}
+
+	b.revision++
+	delete(b.items, key)
+	b.notifyLocked(Event{Type: EventDelete, Key: key, Revision: b.revision})
+
+	return &keyValue{key: key, value: item.value, modRevision: item.modRevision}
+}
+
+func (b *memoryBackend) notifyLocked(event Event) {
+	b.history = append(b.history, event)
+	for watcher := range b.watchers {
+		if watcher.matches(event.Key) {
+			watcher.push(event)
+		}
+	}
+}
+
+func (b *memoryBackend) put(ctx context.Context, key string, value string, ttl time.Duration) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.putLocked(key, value, ttl)
+	return nil
+}
+
+func (b *memoryBackend) delete(ctx context.Context, key string) (*keyValue, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.deleteLocked(key), nil
+}
+
+func (b *memoryBackend) txn(ctx context.Context, cmp compare, thenOps []operation, elseOps []operation) (bool, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	item, exists := b.items[cmp.key]
+
+	var succeeded bool
+	if cmp.value != nil {
+		// A value of a key not existing never matches as etcd does
+		succeeded = exists && item.value == *cmp.value
+	} else {
+		succeeded = (!exists && cmp.modRevision == 0) || (exists && item.modRevision == cmp.modRevision)
+	}
+
+	ops := elseOps
+	if succeeded {
+		ops = thenOps
+	}
+	for _, op := range ops {
+		if op.isDelete {
+			b.deleteLocked(op.key)
+		} else {
+			b.putLocked(op.key, op.value, op.ttl)
+		}
+	}
+
+	return succeeded, nil
+}
+
+func (b *memoryBackend) watch(ctx context.Context, key string, prefix bool, startRevision int64) WatchChan {
+	watcher := &memoryWatcher{
+		key:    key,
+		prefix: prefix,
+		notify: make(chan struct{}, 1),
+	}
+
+	// Register the watcher, and replay the events from the start revision
+	b.mutex.Lock()
+	if startRevision > 0 {
+		for _, event := range b.history {
+			if event.Revision >= startRevision && watcher.matches(event.Key) {
+				watcher.pending = append(watcher.pending, event)
+			}
+		}
+	}
+	b.watchers[watcher] = struct{}{}
+	b.mutex.Unlock()
+
+	out := make(chan WatchResponse)
+
+	go func() {
+		defer func() {
+			b.mutex.Lock()
+			delete(b.watchers, watcher)
+			b.mutex.Unlock()
+			close(out)
+		}()
+
+		// Notify that the watch is established
+		select {
+		case out <- WatchResponse{Created: true}:
+		case <-ctx.Done():
+			return
+		}
+
+		for {
+			if events := watcher.pop(); len(events) > 0 {
+				select {
+				case out <- WatchResponse{Events: events}:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			select {
+			case <-watcher.notify:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func (b *memoryBackend) lock(ctx context.Context, key string) (UnlockFunc, error) {
+	b.mutex.Lock()
+	lock, ok := b.locks[key]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		b.locks[key] = lock
+	}
+	b.mutex.Unlock()
+
+	// Acquire lock (or wait to have it)
+	select {
+	case lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-lock })
+	}, nil
+}
+
+func (b *memoryBackend) close() error {
+	return nil
+}
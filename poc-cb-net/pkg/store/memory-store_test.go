@@ -0,0 +1,313 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+)
+
+// receiveEvents receives the events from a watch until the number of events is reached
+func receiveEvents(t *testing.T, watchChan WatchChan, n int) []Event {
+	t.Helper()
+
+	var events []Event
+	timeout := time.After(5 * time.Second)
+	for len(events) < n {
+		select {
+		case watchResponse, ok := <-watchChan:
+			if !ok {
+				t.Fatalf("the watch is closed after %d events", len(events))
+			}
+			if watchResponse.Err != nil {
+				t.Fatal(watchResponse.Err)
+			}
+			events = append(events, watchResponse.Events...)
+		case <-timeout:
+			t.Fatalf("received %d events, want %d", len(events), n)
+		}
+	}
+	return events
+}
+
+func TestMemoryStorePrefix(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	for _, peer := range []model.Peer{
+		{CladnetID: "cladnet-01", HostID: "host-02"},
+		{CladnetID: "cladnet-012", HostID: "host-01"},
+		{CladnetID: "cladnet-01", HostID: "host-01"},
+	} {
+		if err := s.PutPeer(ctx, peer); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A CLADNet ID is not a prefix of another CLADNet ID, and the items are sorted by key
+	peers, err := s.ListPeers(ctx, "cladnet-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 2 || peers[0].HostID != "host-01" || peers[1].HostID != "host-02" {
+		t.Errorf("ListPeers() = %+v, want host-01 and host-02 of cladnet-01", peers)
+	}
+
+	if count, err := s.CountPeers(ctx, "cladnet-01"); err != nil || count != 2 {
+		t.Errorf("CountPeers() = %v, %v, want 2", count, err)
+	}
+
+	// All CLADNets if the CLADNet ID is empty
+	if peers, err := s.ListPeers(ctx, ""); err != nil || len(peers) != 3 {
+		t.Errorf("ListPeers(\"\") = %+v, %v, want 3 peers", peers, err)
+	}
+
+	if _, err := s.GetPeer(ctx, "cladnet-01", "host-03"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetPeer() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryStoreCompareAndSwap(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	// The value is put only if it is not equal
+	for i, step := range []struct {
+		publicKey string
+		want      bool
+	}{
+		{"key-1", true},
+		{"key-1", false},
+		{"key-2", true},
+	} {
+		if got, err := s.CompareAndSwapSecret(ctx, "cladnet-01", "host-01", step.publicKey); err != nil || got != step.want {
+			t.Errorf("#%d CompareAndSwapSecret(%v) = %v, %v, want %v", i, step.publicKey, got, err, step.want)
+		}
+	}
+
+	// The secret is revoked only if it has not been changed since it was read
+	secret, err := s.GetSecret(ctx, "cladnet-01", "host-01")
+	if err != nil || secret.PublicKey != "key-2" {
+		t.Fatalf("GetSecret() = %+v, %v", secret, err)
+	}
+	revocation := model.SecretRevocation{CladnetID: "cladnet-01", HostID: "host-01", Fingerprint: "fingerprint"}
+	stale := secret
+	stale.Revision--
+	if isRevoked, err := s.RevokeSecret(ctx, stale, revocation); err != nil || isRevoked {
+		t.Errorf("RevokeSecret() with a stale revision = %v, %v, want false", isRevoked, err)
+	}
+	if isRevoked, err := s.RevokeSecret(ctx, secret, revocation); err != nil || !isRevoked {
+		t.Errorf("RevokeSecret() = %v, %v, want true", isRevoked, err)
+	}
+	if _, err := s.GetSecret(ctx, "cladnet-01", "host-01"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSecret() after revocation error = %v, want ErrNotFound", err)
+	}
+	if isRevoked, err := s.IsSecretRevoked(ctx, "cladnet-01", "host-01", "fingerprint"); err != nil || !isRevoked {
+		t.Errorf("IsSecretRevoked() = %v, %v, want true", isRevoked, err)
+	}
+
+	// Revision 0 means the key does not exist
+	credential := AgentCredential{CladnetID: "cladnet-01", HostID: "host-01"}
+	if isSwapped, err := s.CompareAndSwapAgentCredential(ctx, credential, "hash-1"); err != nil || !isSwapped {
+		t.Errorf("CompareAndSwapAgentCredential() = %v, %v, want true", isSwapped, err)
+	}
+	if isSwapped, err := s.CompareAndSwapAgentCredential(ctx, credential, "hash-2"); err != nil || isSwapped {
+		t.Errorf("CompareAndSwapAgentCredential() on an existing key = %v, %v, want false", isSwapped, err)
+	}
+	current, err := s.GetAgentCredential(ctx, "cladnet-01", "host-01")
+	if err != nil || current.SecretHash != "hash-1" || current.Revision == 0 {
+		t.Fatalf("GetAgentCredential() = %+v, %v", current, err)
+	}
+	if isSwapped, err := s.CompareAndSwapAgentCredential(ctx, current, "hash-2"); err != nil || !isSwapped {
+		t.Errorf("CompareAndSwapAgentCredential() with the current revision = %v, %v, want true", isSwapped, err)
+	}
+}
+
+func TestMemoryStoreDeleteCLADNet(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	// A CLADNet is created only once
+	for i, want := range []bool{true, false} {
+		if isCreated, err := s.CreateSpec(ctx, model.CLADNetSpecification{CladnetID: "cladnet-01"}); err != nil || isCreated != want {
+			t.Errorf("#%d CreateSpec() = %v, %v, want %v", i, isCreated, err, want)
+		}
+	}
+	if err := s.PutSpec(ctx, model.CLADNetSpecification{CladnetID: "cladnet-012"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, cladnetID := range []string{"cladnet-01", "cladnet-012"} {
+		if err := s.PutPeer(ctx, model.Peer{CladnetID: cladnetID, HostID: "host-01"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.CompareAndSwapSecret(ctx, cladnetID, "host-01", "key"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The other CLADNets are kept
+	if err := s.DeleteCLADNet(ctx, "cladnet-01"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetSpec(ctx, "cladnet-01"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSpec() after deletion error = %v, want ErrNotFound", err)
+	}
+	if peers, err := s.ListPeers(ctx, "cladnet-01"); err != nil || len(peers) != 0 {
+		t.Errorf("ListPeers() after deletion = %+v, %v, want none", peers, err)
+	}
+	if _, err := s.GetSecret(ctx, "cladnet-01", "host-01"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSecret() after deletion error = %v, want ErrNotFound", err)
+	}
+	if peers, err := s.ListPeers(ctx, "cladnet-012"); err != nil || len(peers) != 1 {
+		t.Errorf("ListPeers() of another CLADNet = %+v, %v, want 1 peer", peers, err)
+	}
+
+	// It can be created again after deletion
+	if isCreated, err := s.CreateSpec(ctx, model.CLADNetSpecification{CladnetID: "cladnet-01"}); err != nil || !isCreated {
+		t.Errorf("CreateSpec() after deletion = %v, %v, want true", isCreated, err)
+	}
+}
+
+func TestMemoryStoreTestResults(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	for _, result := range []model.NetworkStatus{
+		{RunID: "run-01", HostID: "host-01"},
+		{RunID: "run-01", HostID: "host-02"},
+		{RunID: "run-012", HostID: "host-01"},
+	} {
+		if err := s.PutTestResult(ctx, "cladnet-01", result, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The results of a run only (not of another run having the run ID as a prefix)
+	results, err := s.ListTestResults(ctx, "cladnet-01", "run-01")
+	if err != nil || len(results) != 2 {
+		t.Errorf("ListTestResults() = %+v, %v, want 2 results", results, err)
+	}
+	if results, err := s.ListTestResults(ctx, "cladnet-01", "run-02"); err != nil || len(results) != 0 {
+		t.Errorf("ListTestResults() of no results = %+v, %v, want none", results, err)
+	}
+}
+
+func TestMemoryStoreTTL(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	heartbeat := model.AgentHeartbeat{CladnetID: "cladnet-01", HostID: "host-01"}
+	if err := s.PutHeartbeat(ctx, heartbeat, 100*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	other := model.AgentHeartbeat{CladnetID: "cladnet-01", HostID: "host-02"}
+	if err := s.PutHeartbeat(ctx, other, 100*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	// Putting again renews the TTL (i.e., the previous lease does not delete the item)
+	time.Sleep(50 * time.Millisecond)
+	if err := s.PutHeartbeat(ctx, other, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	heartbeats, err := s.ListHeartbeats(ctx, "cladnet-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(heartbeats) != 1 || heartbeats[0].HostID != "host-02" {
+		t.Errorf("ListHeartbeats() = %+v, want only host-02", heartbeats)
+	}
+}
+
+func TestMemoryStoreWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewMemoryStore()
+
+	watchChan := s.WatchPeers(ctx, "cladnet-01", 0)
+	if watchResponse := <-watchChan; !watchResponse.Created {
+		t.Fatalf("the first response = %+v, want Created", watchResponse)
+	}
+
+	for _, peer := range []model.Peer{
+		{CladnetID: "cladnet-01", HostID: "host-01", State: "configuring"},
+		{CladnetID: "cladnet-012", HostID: "host-01"}, // Not watched
+		{CladnetID: "cladnet-01", HostID: "host-02", State: "configuring"},
+		{CladnetID: "cladnet-01", HostID: "host-01", State: "tunneling"},
+	} {
+		if err := s.PutPeer(ctx, peer); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The events are received in the order of the revisions, and the IDs are parsed from the keys
+	events := receiveEvents(t, watchChan, 3)
+	wantHostIDs := []string{"host-01", "host-02", "host-01"}
+	for i, event := range events {
+		if event.Type != EventPut || event.CladnetID != "cladnet-01" || event.HostID != wantHostIDs[i] {
+			t.Errorf("event #%d = %+v, want a put of cladnet-01/%v", i, event, wantHostIDs[i])
+		}
+		if i > 0 && event.Revision <= events[i-1].Revision {
+			t.Errorf("event #%d has the revision %v after %v", i, event.Revision, events[i-1].Revision)
+		}
+	}
+
+	// Resume watching from the revision after the first event, which replays the rest
+	resumed := s.WatchPeers(ctx, "cladnet-01", events[0].Revision+1)
+	<-resumed
+	replayed := receiveEvents(t, resumed, 2)
+	if replayed[0].Revision != events[1].Revision || replayed[1].Revision != events[2].Revision {
+		t.Errorf("replayed revisions = %v and %v, want %v and %v", replayed[0].Revision, replayed[1].Revision, events[1].Revision, events[2].Revision)
+	}
+
+	// The watch is closed when the context is done
+	cancel()
+	for range watchChan {
+	}
+}
+
+func TestMemoryStoreLock(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	unlock, err := s.AcquireLock(ctx, PeerLock, "cladnet-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another CLADNet has another lock
+	unlockOther, err := s.AcquireLock(ctx, PeerLock, "cladnet-02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlockOther()
+
+	// The lock is exclusive until it is released
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := s.AcquireLock(timeoutCtx, PeerLock, "cladnet-01"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("AcquireLock() while locked error = %v, want DeadlineExceeded", err)
+	}
+
+	acquired := make(chan UnlockFunc)
+	go func() {
+		unlock, err := s.AcquireLock(ctx, PeerLock, "cladnet-01")
+		if err != nil {
+			t.Error(err)
+		}
+		acquired <- unlock
+	}()
+
+	unlock()
+	unlock() // Releasing twice is harmless
+	select {
+	case unlock := <-acquired:
+		unlock()
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lock is not acquired after it is released")
+	}
+}
@@ -0,0 +1,1171 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	cmdtype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/command-type"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/metrics"
+	"github.com/cloud-barista/cb-larva/poc-cb-net/pkg/tracing"
+)
+
+var (
+	// ErrNotFound is returned if an item does not exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrCompacted is returned by a watch if the start revision has been compacted
+	ErrCompacted = errors.New("required revision has been compacted")
+)
+
+// LockName represents a name of distributed locks
+type LockName string
+
+const (
+	// PeerLock is a lock to update peers in a CLADNet
+	PeerLock = LockName(etcdkey.LockPeer)
+
+	// NetworkingRuleLock is a lock to update networking rules in a CLADNet
+	NetworkingRuleLock = LockName(etcdkey.LockNetworkingRule)
+
+	// SecretLock is a lock to update secrets in a CLADNet
+	SecretLock = LockName(etcdkey.LockSecret)
+)
+
+// EventType represents a type of watch events
+type EventType int
+
+const (
+	// EventPut represents that an item is created or updated
+	EventPut EventType = iota
+
+	// EventDelete represents that an item is deleted
+	EventDelete
+)
+
+func (t EventType) String() string {
+	if t == EventDelete {
+		return "DELETE"
+	}
+	return "PUT"
+}
+
+// Event represents a change of a watched item
+type Event struct {
+	Type      EventType
+	Key       string
+	CladnetID string // CLADNet ID parsed from the key
+	HostID    string // Host ID parsed from the key
+	Value     []byte // Empty if deleted
+	Revision  int64  // Revision of the change
+}
+
+// WatchResponse represents a response of a watch.
+// The first response notifies that the watch is established (Created).
+type WatchResponse struct {
+	Created         bool
+	Events          []Event
+	CompactRevision int64 // Set with ErrCompacted
+	Err             error
+}
+
+// WatchChan is a channel of watch responses, which is closed if the context is done or an error occurs.
+type WatchChan <-chan WatchResponse
+
+// UnlockFunc releases an acquired lock
+type UnlockFunc func()
+
+// Secret represents a RSA public key (base64) of a host
+type Secret struct {
+	CladnetID string
+	HostID    string
+	PublicKey string
+	Revision  int64
+}
+
+// AgentCredential represents the hash of a credential issued to an agent, which binds the agent to its host ID
+type AgentCredential struct {
+	CladnetID  string
+	HostID     string
+	SecretHash string
+	Revision   int64 // Modification revision (0 if not exist)
+}
+
+// KeyValue represents a raw item of the backend, which is used by the maintenance tools (e.g., migration)
+type KeyValue struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Revision int64  `json:"revision"` // Modification revision (0 if not exist)
+}
+
+// Store is a repository of the cb-network system.
+// It hides the key layout and transactions of the backend (e.g., etcd) from the cb-network components.
+type Store interface {
+	// CLADNet specification
+	GetSpec(ctx context.Context, cladnetID string) (model.CLADNetSpecification, error)
+	ListSpecs(ctx context.Context) ([]model.CLADNetSpecification, error)
+	PutSpec(ctx context.Context, spec model.CLADNetSpecification) error
+	CreateSpec(ctx context.Context, spec model.CLADNetSpecification) (bool, error)
+	WatchSpecs(ctx context.Context) WatchChan
+	DeleteCLADNet(ctx context.Context, cladnetID string) error
+
+	// Host network information registered by the agents
+	PutHostNetworkInformation(ctx context.Context, cladnetID string, hostID string, info model.HostNetworkInformation) error
+	WatchHostNetworkInformation(ctx context.Context) WatchChan
+	TryToAcquireWorkload(ctx context.Context, event Event, ttl time.Duration) (bool, error)
+
+	// Peer (all CLADNets if the CLADNet ID is empty on list and watch)
+	GetPeer(ctx context.Context, cladnetID string, hostID string) (model.Peer, error)
+	ListPeers(ctx context.Context, cladnetID string) ([]model.Peer, error)
+	CountPeers(ctx context.Context, cladnetID string) (int64, error)
+	PutPeer(ctx context.Context, peer model.Peer) error
+	WatchPeers(ctx context.Context, cladnetID string, startRevision int64) WatchChan
+
+	// Networking rule
+	GetRule(ctx context.Context, cladnetID string, hostID string) (model.NetworkingRule, error)
+	ListRules(ctx context.Context, cladnetID string) (map[string]model.NetworkingRule, error) // By host ID
+	CompareAndSwapRule(ctx context.Context, cladnetID string, hostID string, rule model.NetworkingRule) (bool, error)
+
+	// Distributed lock
+	AcquireLock(ctx context.Context, name LockName, cladnetID string) (UnlockFunc, error)
+
+	// Secret (RSA public key)
+	GetSecret(ctx context.Context, cladnetID string, hostID string) (Secret, error)
+	ListSecrets(ctx context.Context, cladnetID string) ([]Secret, error)
+	CompareAndSwapSecret(ctx context.Context, cladnetID string, hostID string, publicKey string) (bool, error)
+	RevokeSecret(ctx context.Context, secret Secret, revocation model.SecretRevocation) (bool, error)
+	IsSecretRevoked(ctx context.Context, cladnetID string, hostID string, fingerprint string) (bool, error)
+	ListSecretRevocations(ctx context.Context, cladnetID string) ([]model.SecretRevocation, error)
+	PutSecretRevocation(ctx context.Context, revocation model.SecretRevocation) error
+	WatchSecrets(ctx context.Context, cladnetID string, startRevision int64) WatchChan
+	PutSecretAuditRecord(ctx context.Context, record model.SecretAuditRecord) error
+	ListSecretAuditRecords(ctx context.Context, cladnetID string) ([]model.SecretAuditRecord, error)
+
+	// Join token
+	GetJoinToken(ctx context.Context, cladnetID string, tokenID string) (model.JoinToken, error)
+	ListJoinTokens(ctx context.Context, cladnetID string) ([]model.JoinToken, error)
+	PutJoinToken(ctx context.Context, joinToken model.JoinToken, ttl time.Duration) error
+	DeleteJoinToken(ctx context.Context, cladnetID string, tokenID string) (model.JoinToken, error)
+
+	// Agent credential
+	GetAgentCredential(ctx context.Context, cladnetID string, hostID string) (AgentCredential, error)
+	CompareAndSwapAgentCredential(ctx context.Context, credential AgentCredential, secretHash string) (bool, error)
+
+	// Agent heartbeat
+	PutHeartbeat(ctx context.Context, heartbeat model.AgentHeartbeat, ttl time.Duration) error
+	ListHeartbeats(ctx context.Context, cladnetID string) ([]model.AgentHeartbeat, error)
+
+	// Traffic statistics published by the agents
+	GetPeerStatistics(ctx context.Context, cladnetID string, hostID string) (model.PeerStatistics, error)
+	ListPeerStatistics(ctx context.Context, cladnetID string) ([]model.PeerStatistics, error)
+	PutPeerStatistics(ctx context.Context, statistics model.PeerStatistics) error
+	WatchPeerStatistics(ctx context.Context) WatchChan
+
+	// Packet capture of an agent (the metadata and the pcapng files of each layer), which vanishes when the TTL expires
+	GetPacketCapture(ctx context.Context, cladnetID string, hostID string, captureID string) (model.PacketCapture, error)
+	ListPacketCaptures(ctx context.Context, cladnetID string) ([]model.PacketCapture, error)
+	PutPacketCapture(ctx context.Context, capture model.PacketCapture, ttl time.Duration) error
+	GetPacketCaptureFile(ctx context.Context, cladnetID string, hostID string, captureID string, layer string) ([]byte, error)
+	PutPacketCaptureFile(ctx context.Context, cladnetID string, hostID string, captureID string, layer string, file []byte, ttl time.Duration) error
+
+	// Re-addressing (the progress of changing the IPv4 address space of a CLADNet)
+	GetReaddressing(ctx context.Context, cladnetID string) (model.CLADNetReaddressing, error)
+	PutReaddressing(ctx context.Context, readdressing model.CLADNetReaddressing) error
+	DeleteReaddressing(ctx context.Context, cladnetID string) error
+
+	// Control command and test request to an agent
+	PutControlCommand(ctx context.Context, cladnetID string, hostID string, commandType string) error
+	PutControlCommandWithParameters(ctx context.Context, cladnetID string, hostID string, commandType string, parameters map[string]string) error
+	WatchControlCommand(ctx context.Context, cladnetID string, hostID string, startRevision int64) WatchChan
+	PutTestRequest(ctx context.Context, cladnetID string, hostID string, testRequest string) error
+	WatchTestRequest(ctx context.Context, cladnetID string, hostID string, startRevision int64) WatchChan
+
+	// Log level of a component (e.g., the controllers), which is changed at runtime
+	PutLogLevel(ctx context.Context, component string, level string) error
+	WatchLogLevel(ctx context.Context, component string) WatchChan
+
+	// Network status (test results)
+	PutNetworkStatus(ctx context.Context, cladnetID string, hostID string, networkStatus model.NetworkStatus) error
+	WatchNetworkStatus(ctx context.Context) WatchChan
+
+	// Test runs and their results (the network status of each agent), which vanish when the TTL expires
+	GetTestRun(ctx context.Context, cladnetID string, runID string) (model.TestRun, error)
+	ListTestRuns(ctx context.Context, cladnetID string) ([]model.TestRun, error)
+	PutTestRun(ctx context.Context, testRun model.TestRun, ttl time.Duration) error
+	ListTestResults(ctx context.Context, cladnetID string, runID string) ([]model.NetworkStatus, error)
+	PutTestResult(ctx context.Context, cladnetID string, networkStatus model.NetworkStatus, ttl time.Duration) error
+	WatchTestResults(ctx context.Context, cladnetID string, runID string) WatchChan
+
+	// Schema version of the registry (0 if not recorded)
+	GetSchemaVersion(ctx context.Context) (int, error)
+	PutSchemaVersion(ctx context.Context, version int) error
+
+	// Raw key-values for the maintenance tools (e.g., migration)
+	ListKeyValues(ctx context.Context, prefix string) ([]KeyValue, error)
+	CompareAndPutKeyValue(ctx context.Context, kv KeyValue, value string) (bool, error)
+	PutKeyValue(ctx context.Context, key string, value string) error
+
+	// Close releases the resources of the store
+	Close() error
+}
+
+// keyValue represents an item of the backend
+type keyValue struct {
+	key         string
+	value       string
+	modRevision int64
+}
+
+// compare represents a condition of a transaction.
+// It compares a value if the value is not nil, otherwise compares a modification revision (0 if not exist).
+type compare struct {
+	key         string
+	value       *string
+	modRevision int64
+}
+
+// operation represents a put (or delete) operation of a transaction
+type operation struct {
+	key      string
+	value    string
+	ttl      time.Duration
+	isDelete bool
+}
+
+// backend is a key-value storage with transactions, watches and locks, such as etcd.
+type backend interface {
+	get(ctx context.Context, key string, prefix bool) ([]keyValue, error)
+	count(ctx context.Context, key string, prefix bool) (int64, error)
+	put(ctx context.Context, key string, value string, ttl time.Duration) error
+	delete(ctx context.Context, key string) (*keyValue, error)
+	txn(ctx context.Context, cmp compare, thenOps []operation, elseOps []operation) (bool, error)
+	watch(ctx context.Context, key string, prefix bool, startRevision int64) WatchChan
+	lock(ctx context.Context, key string) (UnlockFunc, error)
+	close() error
+}
+
+// kvStore implements the Store by a backend
+type kvStore struct {
+	backend backend
+}
+
+// keyParser parses a CLADNet ID and a host ID from a key of a family (see etcdkey)
+type keyParser func(key string) (cladnetID string, hostID string, err error)
+
+// parseCLADNetKey parses a key which has only a CLADNet ID
+func parseCLADNetKey(parseKey func(key string) (string, error)) keyParser {
+	return func(key string) (string, string, error) {
+		cladnetID, err := parseKey(key)
+		return cladnetID, "", err
+	}
+}
+
+// requestBytes represents the sizes of the values got from, listed from and put to the store
+var requestBytes = metrics.NewHistogramVec("cbnet_store_request_bytes",
+	"Sizes (bytes) of the values got from, listed from and put to the store.", metrics.SizeBuckets, "operation")
+
+func (s *kvStore) getJSON(ctx context.Context, key string, v interface{}) error {
+	kvs, err := s.backend.get(ctx, key, false)
+	if err != nil {
+		return err
+	}
+	if len(kvs) == 0 {
+		return ErrNotFound
+	}
+	requestBytes.WithLabelValues("get").Observe(float64(len(kvs[0].value)))
+	return json.Unmarshal([]byte(kvs[0].value), v)
+}
+
+func (s *kvStore) putJSON(ctx context.Context, key string, v interface{}, ttl time.Duration) error {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	requestBytes.WithLabelValues("put").Observe(float64(len(bytes)))
+	return s.backend.put(ctx, key, string(bytes), ttl)
+}
+
+// listJSON lists items with a prefix, and appends each unmarshaled item by the function
+func (s *kvStore) listJSON(ctx context.Context, prefix string, appendItem func(value []byte) error) error {
+	kvs, err := s.backend.get(ctx, prefix, true)
+	if err != nil {
+		return err
+	}
+
+	size := 0
+	for _, kv := range kvs {
+		size += len(kv.value)
+	}
+	requestBytes.WithLabelValues("list").Observe(float64(size))
+
+	for _, kv := range kvs {
+		if err := appendItem([]byte(kv.value)); err != nil {
+			return fmt.Errorf("invalid item (%v): %v", kv.key, err)
+		}
+	}
+	return nil
+}
+
+// watch watches a key (or a prefix) and sets the IDs parsed from the key to each event.
+// The IDs are left empty if a key does not match the layout.
+func (s *kvStore) watch(ctx context.Context, parseKey keyParser, key string, prefix bool, startRevision int64) WatchChan {
+	in := s.backend.watch(ctx, key, prefix, startRevision)
+	out := make(chan WatchResponse)
+
+	go func() {
+		defer close(out)
+		for resp := range in {
+			for i := range resp.Events {
+				cladnetID, hostID, err := parseKey(resp.Events[i].Key)
+				if err == nil {
+					resp.Events[i].CladnetID, resp.Events[i].HostID = cladnetID, hostID
+				}
+			}
+			select {
+			case out <- resp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// errWatch returns a closed watch channel with an error, e.g., on an invalid ID
+func errWatch(err error) WatchChan {
+	out := make(chan WatchResponse, 1)
+	out <- WatchResponse{Err: err}
+	close(out)
+	return out
+}
+
+func (s *kvStore) GetSpec(ctx context.Context, cladnetID string) (model.CLADNetSpecification, error) {
+	key, err := etcdkey.CLADNetSpecificationKey(cladnetID)
+	if err != nil {
+		return model.CLADNetSpecification{}, err
+	}
+
+	var spec model.CLADNetSpecification
+	err = s.getJSON(ctx, key, &spec)
+	return spec, err
+}
+
+func (s *kvStore) ListSpecs(ctx context.Context) ([]model.CLADNetSpecification, error) {
+	var specs []model.CLADNetSpecification
+	err := s.listJSON(ctx, etcdkey.FamilyPrefix(etcdkey.CLADNetSpecification), func(value []byte) error {
+		var spec model.CLADNetSpecification
+		if err := json.Unmarshal(value, &spec); err != nil {
+			return err
+		}
+		specs = append(specs, spec)
+		return nil
+	})
+	return specs, err
+}
+
+func (s *kvStore) PutSpec(ctx context.Context, spec model.CLADNetSpecification) error {
+	spec.SchemaVersion = etcdkey.CurrentSchemaVersion
+	key, err := etcdkey.CLADNetSpecificationKey(spec.CladnetID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, spec, 0)
+}
+
+// CreateSpec puts a CLADNet specification only if the CLADNet does not exist.
+// It returns false if the CLADNet exists.
+func (s *kvStore) CreateSpec(ctx context.Context, spec model.CLADNetSpecification) (bool, error) {
+	spec.SchemaVersion = etcdkey.CurrentSchemaVersion
+	bytes, err := json.Marshal(spec)
+	if err != nil {
+		return false, err
+	}
+	key, err := etcdkey.CLADNetSpecificationKey(spec.CladnetID)
+	if err != nil {
+		return false, err
+	}
+	return s.backend.txn(ctx,
+		compare{key: key, modRevision: 0},
+		[]operation{{key: key, value: string(bytes)}},
+		nil)
+}
+
+func (s *kvStore) WatchSpecs(ctx context.Context) WatchChan {
+	return s.watch(ctx, parseCLADNetKey(etcdkey.ParseCLADNetSpecificationKey), etcdkey.FamilyPrefix(etcdkey.CLADNetSpecification), true, 0)
+}
+
+// DeleteCLADNet deletes the specification, peers, networking rules, secrets and secret revocations of a CLADNet
+// (e.g., to roll back an import). The agents of the CLADNet are not notified of it.
+func (s *kvStore) DeleteCLADNet(ctx context.Context, cladnetID string) error {
+	var keys []string
+	for _, family := range []string{etcdkey.Peer, etcdkey.NetworkingRule, etcdkey.Secret, etcdkey.SecretRevocation} {
+		prefix, err := etcdkey.Prefix(family, cladnetID)
+		if err != nil {
+			return err
+		}
+		kvs, err := s.backend.get(ctx, prefix, true)
+		if err != nil {
+			return err
+		}
+		for _, kv := range kvs {
+			keys = append(keys, kv.key)
+		}
+	}
+
+	// Delete the specification last, so that the CLADNet is not created again until it is deleted completely
+	key, err := etcdkey.CLADNetSpecificationKey(cladnetID)
+	if err != nil {
+		return err
+	}
+	keys = append(keys, key)
+
+	for _, key := range keys {
+		if _, err := s.backend.delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PutHostNetworkInformation puts the host network information with the trace context of the context,
+// by which the controllers continue the trace.
+func (s *kvStore) PutHostNetworkInformation(ctx context.Context, cladnetID string, hostID string, info model.HostNetworkInformation) error {
+	info.TraceContext = tracing.Inject(ctx)
+	key, err := etcdkey.HostNetworkInformationKey(cladnetID, hostID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, info, 0)
+}
+
+func (s *kvStore) WatchHostNetworkInformation(ctx context.Context) WatchChan {
+	return s.watch(ctx, etcdkey.ParseHostNetworkInformationKey, etcdkey.FamilyPrefix(etcdkey.HostNetworkInformation), true, 0)
+}
+
+// TryToAcquireWorkload self-assigns an event (i.e., a workload) to one of multiple cb-network controllers
+// by compare-and-swap (CAS) and TTL. It returns true if this caller acquires the workload.
+func (s *kvStore) TryToAcquireWorkload(ctx context.Context, event Event, ttl time.Duration) (bool, error) {
+	// Key to lease temporally by which each cb-network controller can distinguish each updated value
+	keyToLease := fmt.Sprintf("lease%s-%d", event.Key, event.Revision)
+	messageToCheck := fmt.Sprintf("Vanished in %v", ttl)
+
+	// Acquired if the key does not exist (i.e., not occupied by the other cb-network controller)
+	isAcquired, err := s.backend.txn(ctx,
+		compare{key: keyToLease, modRevision: 0},
+		[]operation{{key: keyToLease, value: messageToCheck, ttl: ttl}},
+		nil)
+	return isAcquired, err
+}
+
+func (s *kvStore) GetPeer(ctx context.Context, cladnetID string, hostID string) (model.Peer, error) {
+	key, err := etcdkey.PeerKey(cladnetID, hostID)
+	if err != nil {
+		return model.Peer{}, err
+	}
+
+	var peer model.Peer
+	err = s.getJSON(ctx, key, &peer)
+	return peer, err
+}
+
+func (s *kvStore) ListPeers(ctx context.Context, cladnetID string) ([]model.Peer, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.Peer, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var peers []model.Peer
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var peer model.Peer
+		if err := json.Unmarshal(value, &peer); err != nil {
+			return err
+		}
+		peers = append(peers, peer)
+		return nil
+	})
+	return peers, err
+}
+
+func (s *kvStore) CountPeers(ctx context.Context, cladnetID string) (int64, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.Peer, cladnetID)
+	if err != nil {
+		return 0, err
+	}
+	return s.backend.count(ctx, prefix, true)
+}
+
+// PutPeer puts a peer with the trace context of the context, by which the agents continue the trace.
+func (s *kvStore) PutPeer(ctx context.Context, peer model.Peer) error {
+	peer.SchemaVersion = etcdkey.CurrentSchemaVersion
+	peer.TraceContext = tracing.Inject(ctx)
+	key, err := etcdkey.PeerKey(peer.CladnetID, peer.HostID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, peer, 0)
+}
+
+func (s *kvStore) WatchPeers(ctx context.Context, cladnetID string, startRevision int64) WatchChan {
+	prefix, err := etcdkey.Prefix(etcdkey.Peer, cladnetID)
+	if err != nil {
+		return errWatch(err)
+	}
+	return s.watch(ctx, etcdkey.ParsePeerKey, prefix, true, startRevision)
+}
+
+func (s *kvStore) GetRule(ctx context.Context, cladnetID string, hostID string) (model.NetworkingRule, error) {
+	key, err := etcdkey.NetworkingRuleKey(cladnetID, hostID)
+	if err != nil {
+		return model.NetworkingRule{}, err
+	}
+
+	var rule model.NetworkingRule
+	err = s.getJSON(ctx, key, &rule)
+	return rule, err
+}
+
+// CompareAndSwapRule puts a networking rule only if it differs from the stored one.
+// It returns true if the rule is swapped.
+func (s *kvStore) CompareAndSwapRule(ctx context.Context, cladnetID string, hostID string, rule model.NetworkingRule) (bool, error) {
+	rule.SchemaVersion = etcdkey.CurrentSchemaVersion
+	bytes, err := json.Marshal(rule)
+	if err != nil {
+		return false, err
+	}
+	key, err := etcdkey.NetworkingRuleKey(cladnetID, hostID)
+	if err != nil {
+		return false, err
+	}
+	return s.compareAndSwap(ctx, key, string(bytes))
+}
+
+func (s *kvStore) ListRules(ctx context.Context, cladnetID string) (map[string]model.NetworkingRule, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.NetworkingRule, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := s.backend.get(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]model.NetworkingRule)
+	for _, kv := range kvs {
+		_, parsedHostID, err := etcdkey.ParseNetworkingRuleKey(kv.key)
+		if err != nil {
+			return nil, err
+		}
+
+		var rule model.NetworkingRule
+		if err := json.Unmarshal([]byte(kv.value), &rule); err != nil {
+			return nil, fmt.Errorf("invalid item (%v): %v", kv.key, err)
+		}
+		rules[parsedHostID] = rule
+	}
+	return rules, nil
+}
+
+// compareAndSwap puts a value only if it differs from the stored one
+func (s *kvStore) compareAndSwap(ctx context.Context, key string, value string) (bool, error) {
+	// NOTICE: "!=" doesn't work on etcd..... so the value is put if it is not equal.
+	isEqual, err := s.backend.txn(ctx,
+		compare{key: key, value: &value},
+		nil,
+		[]operation{{key: key, value: value}})
+	return !isEqual, err
+}
+
+func (s *kvStore) AcquireLock(ctx context.Context, name LockName, cladnetID string) (UnlockFunc, error) {
+	key, err := etcdkey.LockKey(string(name), cladnetID)
+	if err != nil {
+		return nil, err
+	}
+	return s.backend.lock(ctx, key)
+}
+
+func (s *kvStore) GetSecret(ctx context.Context, cladnetID string, hostID string) (Secret, error) {
+	key, err := etcdkey.SecretKey(cladnetID, hostID)
+	if err != nil {
+		return Secret{}, err
+	}
+	kvs, err := s.backend.get(ctx, key, false)
+	if err != nil {
+		return Secret{}, err
+	}
+	if len(kvs) == 0 {
+		return Secret{}, ErrNotFound
+	}
+	return Secret{CladnetID: cladnetID, HostID: hostID, PublicKey: kvs[0].value, Revision: kvs[0].modRevision}, nil
+}
+
+func (s *kvStore) ListSecrets(ctx context.Context, cladnetID string) ([]Secret, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.Secret, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := s.backend.get(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []Secret
+	for _, kv := range kvs {
+		parsedCLADNetID, parsedHostID, err := etcdkey.ParseSecretKey(kv.key)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, Secret{CladnetID: parsedCLADNetID, HostID: parsedHostID, PublicKey: kv.value, Revision: kv.modRevision})
+	}
+	return secrets, nil
+}
+
+// CompareAndSwapSecret puts a public key only if it differs from the stored one.
+// It returns true if the public key is swapped.
+func (s *kvStore) CompareAndSwapSecret(ctx context.Context, cladnetID string, hostID string, publicKey string) (bool, error) {
+	key, err := etcdkey.SecretKey(cladnetID, hostID)
+	if err != nil {
+		return false, err
+	}
+	return s.compareAndSwap(ctx, key, publicKey)
+}
+
+// RevokeSecret records a revocation and deletes the secret at once, if the secret has not been changed since it was read.
+// It returns false if the secret has been changed.
+func (s *kvStore) RevokeSecret(ctx context.Context, secret Secret, revocation model.SecretRevocation) (bool, error) {
+	revocationBytes, err := json.Marshal(revocation)
+	if err != nil {
+		return false, err
+	}
+
+	keySecret, err := etcdkey.SecretKey(secret.CladnetID, secret.HostID)
+	if err != nil {
+		return false, err
+	}
+	keyRevocation, err := etcdkey.SecretRevocationKey(revocation.CladnetID, revocation.HostID, revocation.Fingerprint)
+	if err != nil {
+		return false, err
+	}
+	return s.backend.txn(ctx,
+		compare{key: keySecret, modRevision: secret.Revision},
+		[]operation{{key: keyRevocation, value: string(revocationBytes)}, {key: keySecret, isDelete: true}},
+		nil)
+}
+
+func (s *kvStore) IsSecretRevoked(ctx context.Context, cladnetID string, hostID string, fingerprint string) (bool, error) {
+	key, err := etcdkey.SecretRevocationKey(cladnetID, hostID, fingerprint)
+	if err != nil {
+		return false, err
+	}
+	count, err := s.backend.count(ctx, key, false)
+	return count > 0, err
+}
+
+func (s *kvStore) ListSecretRevocations(ctx context.Context, cladnetID string) ([]model.SecretRevocation, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.SecretRevocation, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var revocations []model.SecretRevocation
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var revocation model.SecretRevocation
+		if err := json.Unmarshal(value, &revocation); err != nil {
+			return err
+		}
+		revocations = append(revocations, revocation)
+		return nil
+	})
+	return revocations, err
+}
+
+func (s *kvStore) PutSecretRevocation(ctx context.Context, revocation model.SecretRevocation) error {
+	key, err := etcdkey.SecretRevocationKey(revocation.CladnetID, revocation.HostID, revocation.Fingerprint)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, revocation, 0)
+}
+
+func (s *kvStore) WatchSecrets(ctx context.Context, cladnetID string, startRevision int64) WatchChan {
+	prefix, err := etcdkey.Prefix(etcdkey.Secret, cladnetID)
+	if err != nil {
+		return errWatch(err)
+	}
+	return s.watch(ctx, etcdkey.ParseSecretKey, prefix, true, startRevision)
+}
+
+// PutSecretAuditRecord puts an audit record, whose key is sorted by time.
+func (s *kvStore) PutSecretAuditRecord(ctx context.Context, record model.SecretAuditRecord) error {
+	// The records are sorted by time.
+	keyAudit, err := etcdkey.SecretAuditKey(record.CladnetID, record.Timestamp, record.HostID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, keyAudit, record, 0)
+}
+
+func (s *kvStore) ListSecretAuditRecords(ctx context.Context, cladnetID string) ([]model.SecretAuditRecord, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.SecretAudit, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []model.SecretAuditRecord
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var record model.SecretAuditRecord
+		if err := json.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	return records, err
+}
+
+func (s *kvStore) GetJoinToken(ctx context.Context, cladnetID string, tokenID string) (model.JoinToken, error) {
+	key, err := etcdkey.JoinTokenKey(cladnetID, tokenID)
+	if err != nil {
+		return model.JoinToken{}, err
+	}
+
+	var joinToken model.JoinToken
+	err = s.getJSON(ctx, key, &joinToken)
+	return joinToken, err
+}
+
+func (s *kvStore) ListJoinTokens(ctx context.Context, cladnetID string) ([]model.JoinToken, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.JoinToken, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var joinTokens []model.JoinToken
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var joinToken model.JoinToken
+		if err := json.Unmarshal(value, &joinToken); err != nil {
+			return err
+		}
+		joinTokens = append(joinTokens, joinToken)
+		return nil
+	})
+	return joinTokens, err
+}
+
+// PutJoinToken puts a join token, which vanishes when the TTL expires.
+func (s *kvStore) PutJoinToken(ctx context.Context, joinToken model.JoinToken, ttl time.Duration) error {
+	key, err := etcdkey.JoinTokenKey(joinToken.CladnetID, joinToken.TokenID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, joinToken, ttl)
+}
+
+func (s *kvStore) DeleteJoinToken(ctx context.Context, cladnetID string, tokenID string) (model.JoinToken, error) {
+	key, err := etcdkey.JoinTokenKey(cladnetID, tokenID)
+	if err != nil {
+		return model.JoinToken{}, err
+	}
+	prevKV, err := s.backend.delete(ctx, key)
+	if err != nil {
+		return model.JoinToken{}, err
+	}
+	if prevKV == nil {
+		return model.JoinToken{}, ErrNotFound
+	}
+
+	var joinToken model.JoinToken
+	err = json.Unmarshal([]byte(prevKV.value), &joinToken)
+	return joinToken, err
+}
+
+func (s *kvStore) GetAgentCredential(ctx context.Context, cladnetID string, hostID string) (AgentCredential, error) {
+	key, err := etcdkey.AgentCredentialKey(cladnetID, hostID)
+	if err != nil {
+		return AgentCredential{}, err
+	}
+	kvs, err := s.backend.get(ctx, key, false)
+	if err != nil {
+		return AgentCredential{}, err
+	}
+	if len(kvs) == 0 {
+		return AgentCredential{}, ErrNotFound
+	}
+	return AgentCredential{CladnetID: cladnetID, HostID: hostID, SecretHash: kvs[0].value, Revision: kvs[0].modRevision}, nil
+}
+
+// CompareAndSwapAgentCredential puts the hash of a new credential, if the credential has not been changed since it was read.
+// It returns false if the credential has been changed (or issued by another registration).
+func (s *kvStore) CompareAndSwapAgentCredential(ctx context.Context, credential AgentCredential, secretHash string) (bool, error) {
+	key, err := etcdkey.AgentCredentialKey(credential.CladnetID, credential.HostID)
+	if err != nil {
+		return false, err
+	}
+	return s.backend.txn(ctx,
+		compare{key: key, modRevision: credential.Revision},
+		[]operation{{key: key, value: secretHash}},
+		nil)
+}
+
+// PutHeartbeat puts a heartbeat, which vanishes if an agent stops sending it.
+func (s *kvStore) PutHeartbeat(ctx context.Context, heartbeat model.AgentHeartbeat, ttl time.Duration) error {
+	key, err := etcdkey.AgentHeartbeatKey(heartbeat.CladnetID, heartbeat.HostID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, heartbeat, ttl)
+}
+
+func (s *kvStore) ListHeartbeats(ctx context.Context, cladnetID string) ([]model.AgentHeartbeat, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.AgentHeartbeat, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var heartbeats []model.AgentHeartbeat
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var heartbeat model.AgentHeartbeat
+		if err := json.Unmarshal(value, &heartbeat); err != nil {
+			return err
+		}
+		heartbeats = append(heartbeats, heartbeat)
+		return nil
+	})
+	return heartbeats, err
+}
+
+func (s *kvStore) GetPeerStatistics(ctx context.Context, cladnetID string, hostID string) (model.PeerStatistics, error) {
+	key, err := etcdkey.PeerStatisticsKey(cladnetID, hostID)
+	if err != nil {
+		return model.PeerStatistics{}, err
+	}
+
+	var statistics model.PeerStatistics
+	err = s.getJSON(ctx, key, &statistics)
+	return statistics, err
+}
+
+func (s *kvStore) ListPeerStatistics(ctx context.Context, cladnetID string) ([]model.PeerStatistics, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.PeerStatistics, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var statisticsList []model.PeerStatistics
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var statistics model.PeerStatistics
+		if err := json.Unmarshal(value, &statistics); err != nil {
+			return err
+		}
+		statisticsList = append(statisticsList, statistics)
+		return nil
+	})
+	return statisticsList, err
+}
+
+func (s *kvStore) PutPeerStatistics(ctx context.Context, statistics model.PeerStatistics) error {
+	key, err := etcdkey.PeerStatisticsKey(statistics.CladnetID, statistics.HostID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, statistics, 0)
+}
+
+func (s *kvStore) WatchPeerStatistics(ctx context.Context) WatchChan {
+	return s.watch(ctx, etcdkey.ParsePeerStatisticsKey, etcdkey.FamilyPrefix(etcdkey.PeerStatistics), true, 0)
+}
+
+func (s *kvStore) GetPacketCapture(ctx context.Context, cladnetID string, hostID string, captureID string) (model.PacketCapture, error) {
+	key, err := etcdkey.PacketCaptureKey(cladnetID, hostID, captureID)
+	if err != nil {
+		return model.PacketCapture{}, err
+	}
+
+	var capture model.PacketCapture
+	err = s.getJSON(ctx, key, &capture)
+	return capture, err
+}
+
+func (s *kvStore) ListPacketCaptures(ctx context.Context, cladnetID string) ([]model.PacketCapture, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.PacketCapture, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var captures []model.PacketCapture
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var capture model.PacketCapture
+		if err := json.Unmarshal(value, &capture); err != nil {
+			return err
+		}
+		captures = append(captures, capture)
+		return nil
+	})
+	return captures, err
+}
+
+// PutPacketCapture puts the metadata of a packet capture, which vanishes when the TTL expires.
+func (s *kvStore) PutPacketCapture(ctx context.Context, capture model.PacketCapture, ttl time.Duration) error {
+	key, err := etcdkey.PacketCaptureKey(capture.CladnetID, capture.HostID, capture.CaptureID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, capture, ttl)
+}
+
+func (s *kvStore) GetPacketCaptureFile(ctx context.Context, cladnetID string, hostID string, captureID string, layer string) ([]byte, error) {
+	key, err := etcdkey.PacketCaptureFileKey(cladnetID, hostID, captureID, layer)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := s.backend.get(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(kvs) == 0 {
+		return nil, ErrNotFound
+	}
+	requestBytes.WithLabelValues("get").Observe(float64(len(kvs[0].value)))
+	return []byte(kvs[0].value), nil
+}
+
+// PutPacketCaptureFile puts a pcapng file (binary as is) of a packet capture, which vanishes when the TTL expires.
+func (s *kvStore) PutPacketCaptureFile(ctx context.Context, cladnetID string, hostID string, captureID string, layer string, file []byte, ttl time.Duration) error {
+	requestBytes.WithLabelValues("put").Observe(float64(len(file)))
+	key, err := etcdkey.PacketCaptureFileKey(cladnetID, hostID, captureID, layer)
+	if err != nil {
+		return err
+	}
+	return s.backend.put(ctx, key, string(file), ttl)
+}
+
+func (s *kvStore) GetReaddressing(ctx context.Context, cladnetID string) (model.CLADNetReaddressing, error) {
+	key, err := etcdkey.ReaddressingKey(cladnetID)
+	if err != nil {
+		return model.CLADNetReaddressing{}, err
+	}
+
+	var readdressing model.CLADNetReaddressing
+	err = s.getJSON(ctx, key, &readdressing)
+	return readdressing, err
+}
+
+func (s *kvStore) PutReaddressing(ctx context.Context, readdressing model.CLADNetReaddressing) error {
+	key, err := etcdkey.ReaddressingKey(readdressing.CladnetID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, readdressing, 0)
+}
+
+func (s *kvStore) DeleteReaddressing(ctx context.Context, cladnetID string) error {
+	key, err := etcdkey.ReaddressingKey(cladnetID)
+	if err != nil {
+		return err
+	}
+	_, err = s.backend.delete(ctx, key)
+	return err
+}
+
+func (s *kvStore) PutControlCommand(ctx context.Context, cladnetID string, hostID string, commandType string) error {
+	key, err := etcdkey.ControlCommandKey(cladnetID, hostID)
+	if err != nil {
+		return err
+	}
+	return s.backend.put(ctx, key, cmdtype.BuildCommandMessage(commandType), 0)
+}
+
+func (s *kvStore) PutControlCommandWithParameters(ctx context.Context, cladnetID string, hostID string, commandType string, parameters map[string]string) error {
+	key, err := etcdkey.ControlCommandKey(cladnetID, hostID)
+	if err != nil {
+		return err
+	}
+	return s.backend.put(ctx, key, cmdtype.BuildCommandMessageWithParameters(commandType, parameters), 0)
+}
+
+func (s *kvStore) WatchControlCommand(ctx context.Context, cladnetID string, hostID string, startRevision int64) WatchChan {
+	key, err := etcdkey.ControlCommandKey(cladnetID, hostID)
+	if err != nil {
+		return errWatch(err)
+	}
+	return s.watch(ctx, etcdkey.ParseControlCommandKey, key, false, startRevision)
+}
+
+func (s *kvStore) PutLogLevel(ctx context.Context, component string, level string) error {
+	key, err := etcdkey.LogLevelKey(component)
+	if err != nil {
+		return err
+	}
+	return s.backend.put(ctx, key, level, 0)
+}
+
+func (s *kvStore) WatchLogLevel(ctx context.Context, component string) WatchChan {
+	key, err := etcdkey.LogLevelKey(component)
+	if err != nil {
+		return errWatch(err)
+	}
+	return s.watch(ctx, parseCLADNetKey(func(key string) (string, error) {
+		// The key has a component instead of a CLADNet ID
+		_, err := etcdkey.ParseLogLevelKey(key)
+		return "", err
+	}), key, false, 0)
+}
+
+func (s *kvStore) PutTestRequest(ctx context.Context, cladnetID string, hostID string, testRequest string) error {
+	key, err := etcdkey.TestRequestKey(cladnetID, hostID)
+	if err != nil {
+		return err
+	}
+	return s.backend.put(ctx, key, testRequest, 0)
+}
+
+func (s *kvStore) WatchTestRequest(ctx context.Context, cladnetID string, hostID string, startRevision int64) WatchChan {
+	key, err := etcdkey.TestRequestKey(cladnetID, hostID)
+	if err != nil {
+		return errWatch(err)
+	}
+	return s.watch(ctx, etcdkey.ParseTestRequestKey, key, false, startRevision)
+}
+
+func (s *kvStore) PutNetworkStatus(ctx context.Context, cladnetID string, hostID string, networkStatus model.NetworkStatus) error {
+	key, err := etcdkey.StatusInformationKey(cladnetID, hostID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, networkStatus, 0)
+}
+
+func (s *kvStore) WatchNetworkStatus(ctx context.Context) WatchChan {
+	return s.watch(ctx, etcdkey.ParseStatusInformationKey, etcdkey.FamilyPrefix(etcdkey.StatusInformation), true, 0)
+}
+
+func (s *kvStore) GetTestRun(ctx context.Context, cladnetID string, runID string) (model.TestRun, error) {
+	key, err := etcdkey.TestRunKey(cladnetID, runID)
+	if err != nil {
+		return model.TestRun{}, err
+	}
+
+	var testRun model.TestRun
+	err = s.getJSON(ctx, key, &testRun)
+	return testRun, err
+}
+
+func (s *kvStore) ListTestRuns(ctx context.Context, cladnetID string) ([]model.TestRun, error) {
+	prefix, err := etcdkey.Prefix(etcdkey.TestRun, cladnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var testRuns []model.TestRun
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var testRun model.TestRun
+		if err := json.Unmarshal(value, &testRun); err != nil {
+			return err
+		}
+		testRuns = append(testRuns, testRun)
+		return nil
+	})
+	return testRuns, err
+}
+
+// PutTestRun puts a test run, which vanishes when the TTL expires.
+func (s *kvStore) PutTestRun(ctx context.Context, testRun model.TestRun, ttl time.Duration) error {
+	key, err := etcdkey.TestRunKey(testRun.CladnetID, testRun.RunID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, testRun, ttl)
+}
+
+func (s *kvStore) ListTestResults(ctx context.Context, cladnetID string, runID string) ([]model.NetworkStatus, error) {
+	prefix, err := etcdkey.TestResultPrefix(cladnetID, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []model.NetworkStatus
+	err = s.listJSON(ctx, prefix, func(value []byte) error {
+		var result model.NetworkStatus
+		if err := json.Unmarshal(value, &result); err != nil {
+			return err
+		}
+		results = append(results, result)
+		return nil
+	})
+	return results, err
+}
+
+// PutTestResult puts the network status of an agent in a test run (by its RunID and HostID), which vanishes when the TTL expires.
+func (s *kvStore) PutTestResult(ctx context.Context, cladnetID string, networkStatus model.NetworkStatus, ttl time.Duration) error {
+	key, err := etcdkey.TestResultKey(cladnetID, networkStatus.RunID, networkStatus.HostID)
+	if err != nil {
+		return err
+	}
+	return s.putJSON(ctx, key, networkStatus, ttl)
+}
+
+func (s *kvStore) WatchTestResults(ctx context.Context, cladnetID string, runID string) WatchChan {
+	prefix, err := etcdkey.TestResultPrefix(cladnetID, runID)
+	if err != nil {
+		return errWatch(err)
+	}
+	return s.watch(ctx, func(key string) (string, string, error) {
+		cladnetID, _, hostID, err := etcdkey.ParseTestResultKey(key)
+		return cladnetID, hostID, err
+	}, prefix, true, 0)
+}
+
+func (s *kvStore) GetSchemaVersion(ctx context.Context) (int, error) {
+	kvs, err := s.backend.get(ctx, etcdkey.SchemaVersion, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(kvs) == 0 {
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(kvs[0].value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid schema version (%v): %v", kvs[0].value, err)
+	}
+	return version, nil
+}
+
+func (s *kvStore) PutSchemaVersion(ctx context.Context, version int) error {
+	return s.backend.put(ctx, etcdkey.SchemaVersion, strconv.Itoa(version), 0)
+}
+
+func (s *kvStore) ListKeyValues(ctx context.Context, prefix string) ([]KeyValue, error) {
+	kvs, err := s.backend.get(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+
+	keyValues := make([]KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		keyValues = append(keyValues, KeyValue{Key: kv.key, Value: kv.value, Revision: kv.modRevision})
+	}
+	return keyValues, nil
+}
+
+// CompareAndPutKeyValue puts a value only if the item has not been changed since it was read.
+// It returns false if the item has been changed.
+func (s *kvStore) CompareAndPutKeyValue(ctx context.Context, kv KeyValue, value string) (bool, error) {
+	return s.backend.txn(ctx,
+		compare{key: kv.Key, modRevision: kv.Revision},
+		[]operation{{key: kv.Key, value: value}},
+		nil)
+}
+
+func (s *kvStore) PutKeyValue(ctx context.Context, key string, value string) error {
+	return s.backend.put(ctx, key, value, 0)
+}
+
+func (s *kvStore) Close() error {
+	return s.backend.close()
+}
@@ -9,18 +9,39 @@ import (
 const (
 	// Connectivity is a constant variable for test "CONNECTIVITY"
 	Connectivity = "CONNECTIVITY"
+	// Throughput is a constant variable for test "THROUGHPUT"
+	Throughput = "THROUGHPUT"
+	// PathMTU is a constant variable for test "PATH_MTU"
+	PathMTU = "PATH_MTU"
+	// Jitter is a constant variable for test "JITTER"
+	Jitter = "JITTER"
+	// PortReachability is a constant variable for test "PORT_REACHABILITY"
+	PortReachability = "PORT_REACHABILITY"
+	// UnderlayOverlay is a constant variable for test "UNDERLAY_OVERLAY"
+	UnderlayOverlay = "UNDERLAY_OVERLAY"
 )
 
 var placeHolder = `{"testType": "%s", "testSpec": %s}`
+var placeHolderWithRunID = `{"testType": "%s", "runId": "%s", "testSpec": %s}`
 
 // BuildTestMessage represents a function to build a message with a test type and its test specifiction.
 func BuildTestMessage(testType string, testSpec string) string {
 	return fmt.Sprintf(placeHolder, testType, testSpec)
 }
 
+// BuildTestMessageWithRunID represents a function to build a message with a test type, the ID of a test run and its test specifiction.
+func BuildTestMessageWithRunID(testType string, runID string, testSpec string) string {
+	return fmt.Sprintf(placeHolderWithRunID, testType, runID, testSpec)
+}
+
 // ParseTestMessage represents a function to parse a test type and its test specification from a message.
 func ParseTestMessage(message string) (string, string) {
 	testType := gjson.Get(message, "testType").String()
 	testSpec := gjson.Get(message, "testSpec").String()
 	return testType, testSpec
 }
+
+// ParseTestRunID represents a function to parse the ID of a test run from a message ("" if not included).
+func ParseTestRunID(message string) string {
+	return gjson.Get(message, "runId").String()
+}
@@ -0,0 +1,88 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// NewServerConfig creates a TLS config for a server.
+// If clientAuth is true, client certificates are required and verified by the CA (i.e., mTLS).
+func NewServerConfig(certFile string, keyFile string, caFile string, clientAuth bool) (*tls.Config, error) {
+
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("certificate and key files are required")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load a key pair: %v", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientAuth {
+		if caFile == "" {
+			return nil, errors.New("a CA file is required to verify client certificates")
+		}
+
+		certPool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.ClientCAs = certPool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// NewClientConfig creates a TLS config for a client.
+// If caFile is empty, the system root CAs are used to verify the server certificate.
+// If certFile and keyFile are given, the client certificate is presented to the server (i.e., mTLS).
+func NewClientConfig(caFile string, certFile string, keyFile string, serverName string) (*tls.Config, error) {
+
+	tlsConfig := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caFile != "" {
+		certPool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load a key pair: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+
+	caPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read a CA file: %v", err)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("failed to parse a CA file (%v)", caFile)
+	}
+
+	return certPool, nil
+}
@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// fileSpan represents a span written by the file exporter
+type fileSpan struct {
+	TraceID      string                 `json:"traceId"`
+	SpanID       string                 `json:"spanId"`
+	ParentSpanID string                 `json:"parentSpanId,omitempty"`
+	Name         string                 `json:"name"`
+	Kind         string                 `json:"kind"`
+	Service      string                 `json:"service,omitempty"`
+	StartTime    time.Time              `json:"startTime"`
+	EndTime      time.Time              `json:"endTime"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+	Status       string                 `json:"status"`
+	Description  string                 `json:"description,omitempty"`
+}
+
+// FileExporter writes the spans to a local file in JSON lines
+type FileExporter struct {
+	file    *os.File
+	encoder *json.Encoder
+	mutex   sync.Mutex
+}
+
+// NewFileExporter represents a constructor of FileExporter, which appends the spans to the file
+func NewFileExporter(filePath string) (*FileExporter, error) {
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+// ExportSpans writes the spans
+func (exporter *FileExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	for _, span := range spans {
+		record := fileSpan{
+			TraceID:     span.SpanContext().TraceID().String(),
+			SpanID:      span.SpanContext().SpanID().String(),
+			Name:        span.Name(),
+			Kind:        span.SpanKind().String(),
+			StartTime:   span.StartTime(),
+			EndTime:     span.EndTime(),
+			Status:      span.Status().Code.String(),
+			Description: span.Status().Description,
+		}
+		if span.Parent().IsValid() {
+			record.ParentSpanID = span.Parent().SpanID().String()
+		}
+		if value, ok := span.Resource().Set().Value("service.name"); ok {
+			record.Service = value.AsString()
+		}
+		if attributes := span.Attributes(); len(attributes) > 0 {
+			record.Attributes = make(map[string]interface{}, len(attributes))
+			for _, attribute := range attributes {
+				record.Attributes[string(attribute.Key)] = attribute.Value.AsInterface()
+			}
+		}
+
+		if err := exporter.encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown closes the file
+func (exporter *FileExporter) Shutdown(ctx context.Context) error {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	return exporter.file.Close()
+}
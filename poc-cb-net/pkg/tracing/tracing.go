@@ -0,0 +1,117 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters of the spans
+const (
+	// ExporterOTLP exports the spans to a collector by OTLP/gRPC
+	ExporterOTLP = "otlp"
+
+	// ExporterFile writes the spans to a local file (JSON lines)
+	ExporterFile = "file"
+)
+
+// instrumentationName is the name of the tracer of the cb-network system
+const instrumentationName = "github.com/cloud-barista/cb-larva/poc-cb-net"
+
+// ShutdownFunc flushes the remaining spans and releases the exporter
+type ShutdownFunc func(ctx context.Context) error
+
+func init() {
+	// The trace context is propagated even if tracing is disabled on this component,
+	// so that the components exporting the spans can be correlated.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Init represents a function to set the global tracer provider of a component (e.g., service, controller and agent).
+// Tracing is disabled if the exporter is not configured.
+func Init(ctx context.Context, serviceName string, config model.TracingConfig) (ShutdownFunc, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch config.Exporter {
+	case "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+		if config.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, options...)
+	case ExporterFile:
+		filePath := config.FilePath
+		if filePath == "" {
+			filePath = fmt.Sprintf("trace-%s.json", serviceName)
+		}
+		exporter, err = NewFileExporter(filePath)
+	default:
+		return nil, fmt.Errorf("unknown exporter (%v) of tracing", config.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// Start represents a function to start a span by the tracer of the cb-network system
+func Start(ctx context.Context, spanName string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, trace.WithAttributes(attributes...))
+}
+
+// End represents a function to end a span with the error if any
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject represents a function to get the trace context of a context to be embedded in a value (e.g., on the etcd).
+// It returns nil if there is no trace context.
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Extract represents a function to set the trace context embedded in a value to a context
+func Extract(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+// Attributes of the spans
+var (
+	// CLADNetID is the attribute of a CLADNet ID
+	CLADNetID = attribute.Key("cbnet.cladnet_id")
+
+	// HostID is the attribute of a host ID
+	HostID = attribute.Key("cbnet.host_id")
+)
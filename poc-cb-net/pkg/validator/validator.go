@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	etcdkey "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/etcd-key"
+	ruletype "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/rule-type"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxPrefixLength is the maximum prefix length of an IPv4 address space of a CLADNet.
+// A CLADNet needs the network address, the gateway address, the broadcast address and at least one peer.
+const MaxPrefixLength = 30
+
+var privateAddressSpaces []*net.IPNet
+
+func init() {
+	for _, cidr := range []string{
+		"10.0.0.0/8",     // RFC1918
+		"172.16.0.0/12",  // RFC1918
+		"192.168.0.0/16", // RFC1918
+	} {
+		_, ipNet, _ := net.ParseCIDR(cidr)
+		privateAddressSpaces = append(privateAddressSpaces, ipNet)
+	}
+}
+
+// FieldError represents an invalid field of a request
+type FieldError struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// Errors represents the invalid fields of a request.
+// It is converted to a gRPC InvalidArgument status with BadRequest details.
+type Errors []FieldError
+
+func (errs Errors) Error() string {
+	descriptions := make([]string, 0, len(errs))
+	for _, fieldError := range errs {
+		descriptions = append(descriptions, fieldError.Field+": "+fieldError.Description)
+	}
+	return "invalid argument: " + strings.Join(descriptions, "; ")
+}
+
+// GRPCStatus returns an InvalidArgument status with BadRequest details (used by the gRPC status package)
+func (errs Errors) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, errs.Error())
+
+	badRequest := &errdetails.BadRequest{}
+	for _, fieldError := range errs {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldError.Field,
+			Description: fieldError.Description,
+		})
+	}
+
+	stWithDetails, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st
+	}
+	return stWithDetails
+}
+
+// FieldErrors returns the invalid fields in an error, which is Errors or a gRPC status with BadRequest details.
+// It returns nil if there is none.
+func FieldErrors(err error) Errors {
+	var errs Errors
+	if errors.As(err, &errs) {
+		return errs
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				errs = append(errs, FieldError{Field: violation.Field, Description: violation.Description})
+			}
+		}
+	}
+	return errs
+}
+
+// add appends a field error
+func (errs *Errors) add(field string, format string, a ...interface{}) {
+	*errs = append(*errs, FieldError{Field: field, Description: fmt.Sprintf(format, a...)})
+}
+
+// orNil returns nil if there is no field error (not to return a typed nil as an error)
+func (errs Errors) orNil() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// CheckIPv4AddressSpace checks if an IPv4 address space can be of a CLADNet,
+// which must be a private address space (RFC1918) with the prefix length up to MaxPrefixLength.
+func CheckIPv4AddressSpace(field string, ipv4AddressSpace string) error {
+	var errs Errors
+
+	ip, ipNet, err := net.ParseCIDR(ipv4AddressSpace)
+	if err != nil || ip.To4() == nil {
+		errs.add(field, "not an IPv4 CIDR block (%q)", ipv4AddressSpace)
+		return errs
+	}
+
+	prefixLength, _ := ipNet.Mask.Size()
+	if prefixLength > MaxPrefixLength {
+		errs.add(field, "too small (/%d), the prefix length must be up to /%d", prefixLength, MaxPrefixLength)
+	}
+
+	if !isPrivate(ipNet) {
+		errs.add(field, "not a private address space (RFC1918: 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16)")
+	}
+
+	return errs.orNil()
+}
+
+// isPrivate checks if an IPv4 network is entirely in a private address space
+func isPrivate(ipNet *net.IPNet) bool {
+	prefixLength, _ := ipNet.Mask.Size()
+	for _, privateAddressSpace := range privateAddressSpaces {
+		privatePrefixLength, _ := privateAddressSpace.Mask.Size()
+		if privateAddressSpace.Contains(ipNet.IP) && prefixLength >= privatePrefixLength {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckSpecification checks the fields of a CLADNet specification by itself.
+// The CLADNet ID may be empty (i.e., to be assigned), and the rule type may be empty (i.e., the basic rule).
+func CheckSpecification(spec model.CLADNetSpecification) error {
+	var errs Errors
+
+	if spec.CladnetID != "" {
+		if err := etcdkey.ValidateID(spec.CladnetID); err != nil {
+			errs.add("cladnetId", "%v", err)
+		}
+	}
+
+	if err := CheckIPv4AddressSpace("ipv4AddressSpace", spec.Ipv4AddressSpace); err != nil {
+		errs = append(errs, FieldErrors(err)...)
+	}
+
+	if spec.RuleType != "" && !ruletype.Exists(spec.RuleType) {
+		errs.add("ruleType", "unknown rule type (%q), available: %q, %q", spec.RuleType, ruletype.Basic, ruletype.CostPrioritized)
+	}
+
+	return errs.orNil()
+}
+
+// CheckConflicts checks if a CLADNet specification conflicts with the other CLADNets
+// (i.e., the same name or an overlapping IPv4 address space), or with the underlay networks of its peers.
+// The specification of the same CLADNet ID in the others is skipped (e.g., on update).
+func CheckConflicts(spec model.CLADNetSpecification, others []model.CLADNetSpecification, peers []model.Peer) error {
+	var errs Errors
+
+	_, ipNet, err := net.ParseCIDR(spec.Ipv4AddressSpace)
+	if err != nil {
+		errs.add("ipv4AddressSpace", "not an IPv4 CIDR block (%q)", spec.Ipv4AddressSpace)
+		return errs
+	}
+
+	for _, other := range others {
+		if other.CladnetID == spec.CladnetID {
+			continue
+		}
+
+		if spec.Name != "" && other.Name == spec.Name {
+			errs.add("name", "already used by the CLADNet (%v)", other.CladnetID)
+		}
+
+		if _, otherNet, err := net.ParseCIDR(other.Ipv4AddressSpace); err == nil && overlaps(ipNet, otherNet) {
+			errs.add("ipv4AddressSpace", "overlaps with %v of the CLADNet (%v)", other.Ipv4AddressSpace, other.CladnetID)
+		}
+	}
+
+	for _, peer := range peers {
+		if _, underlayNet, err := net.ParseCIDR(peer.HostPrivateIPv4CIDR); err == nil && overlaps(ipNet, underlayNet) {
+			errs.add("ipv4AddressSpace", "overlaps with the underlay network (%v) of the peer (%v)", peer.HostPrivateIPv4CIDR, peer.HostID)
+		}
+	}
+
+	return errs.orNil()
+}
+
+// ValidateSpecification validates a CLADNet specification by itself, and against the other CLADNets and its peers
+func ValidateSpecification(spec model.CLADNetSpecification, others []model.CLADNetSpecification, peers []model.Peer) error {
+	if err := CheckSpecification(spec); err != nil {
+		return err
+	}
+	return CheckConflicts(spec, others, peers)
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
@@ -0,0 +1,192 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	model "github.com/cloud-barista/cb-larva/poc-cb-net/pkg/cb-network/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fields returns the fields of the invalid ones in an error
+func fields(err error) []string {
+	var fields []string
+	for _, fieldError := range FieldErrors(err) {
+		fields = append(fields, fieldError.Field)
+	}
+	return fields
+}
+
+func TestCheckIPv4AddressSpace(t *testing.T) {
+	tests := []struct {
+		ipv4AddressSpace string
+		wantErrors       int
+	}{
+		// RFC1918
+		{"10.0.0.0/8", 0},
+		{"10.77.0.0/16", 0},
+		{"172.16.0.0/12", 0},
+		{"172.31.255.0/24", 0},
+		{"192.168.0.0/16", 0},
+		{"192.168.1.0/24", 0},
+		{"172.32.0.0/24", 1},
+		{"8.8.8.0/24", 1},
+		{"11.0.0.0/8", 1},
+		{"10.0.0.0/7", 1}, // Larger than 10.0.0.0/8
+		{"192.168.0.0/15", 1},
+		// Prefix bounds
+		{"10.0.0.0/30", 0},
+		{"10.0.0.0/31", 1},
+		{"10.0.0.0/32", 1},
+		{"8.8.8.8/32", 2}, // Too small and not private
+		// Malformed
+		{"", 1},
+		{"10.0.0.0", 1},
+		{"10.0.0.0/33", 1},
+		{"10.0.0.256/24", 1},
+		{"fd00::/64", 1},
+		{"xxxx", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ipv4AddressSpace, func(t *testing.T) {
+			err := CheckIPv4AddressSpace("ipv4AddressSpace", tt.ipv4AddressSpace)
+			if got := len(FieldErrors(err)); got != tt.wantErrors {
+				t.Fatalf("CheckIPv4AddressSpace() error = %v, want %d field error(s)", err, tt.wantErrors)
+			}
+			if tt.wantErrors == 0 && err != nil {
+				t.Fatalf("CheckIPv4AddressSpace() error = %#v, want nil (not a typed nil)", err)
+			}
+			for _, field := range fields(err) {
+				if field != "ipv4AddressSpace" {
+					t.Errorf("the field = %v, want ipv4AddressSpace", field)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckSpecification(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       model.CLADNetSpecification
+		wantFields []string
+	}{
+		{name: "valid", spec: model.CLADNetSpecification{CladnetID: "cladnet-01", Ipv4AddressSpace: "10.0.0.0/24", RuleType: "basic"}},
+		{name: "ID to be assigned", spec: model.CLADNetSpecification{Ipv4AddressSpace: "10.0.0.0/24"}},
+		{name: "basic rule by default", spec: model.CLADNetSpecification{CladnetID: "cladnet-01", Ipv4AddressSpace: "10.0.0.0/24", RuleType: ""}},
+		{name: "cost-prioritized rule", spec: model.CLADNetSpecification{CladnetID: "cladnet-01", Ipv4AddressSpace: "10.0.0.0/24", RuleType: "cost-prioritized"}},
+		{name: "unknown rule", spec: model.CLADNetSpecification{CladnetID: "cladnet-01", Ipv4AddressSpace: "10.0.0.0/24", RuleType: "fastest"}, wantFields: []string{"ruleType"}},
+		{name: "ID with a slash", spec: model.CLADNetSpecification{CladnetID: "cladnet/01", Ipv4AddressSpace: "10.0.0.0/24"}, wantFields: []string{"cladnetId"}},
+		{name: "no address space", spec: model.CLADNetSpecification{CladnetID: "cladnet-01"}, wantFields: []string{"ipv4AddressSpace"}},
+		{
+			name:       "all invalid",
+			spec:       model.CLADNetSpecification{CladnetID: "cladnet/01", Ipv4AddressSpace: "8.8.8.8/32", RuleType: "fastest"},
+			wantFields: []string{"cladnetId", "ipv4AddressSpace", "ipv4AddressSpace", "ruleType"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckSpecification(tt.spec)
+			if got := fields(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("CheckSpecification() error = %v, want the fields %v", err, tt.wantFields)
+			}
+			if tt.wantFields == nil && err != nil {
+				t.Errorf("CheckSpecification() error = %#v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestCheckConflicts(t *testing.T) {
+	others := []model.CLADNetSpecification{
+		{CladnetID: "cladnet-01", Name: "dev", Ipv4AddressSpace: "10.0.0.0/24"},
+		{CladnetID: "cladnet-02", Name: "prod", Ipv4AddressSpace: "10.1.0.0/16"},
+	}
+
+	tests := []struct {
+		name       string
+		spec       model.CLADNetSpecification
+		peers      []model.Peer
+		wantFields []string
+	}{
+		{name: "no conflict", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Name: "test", Ipv4AddressSpace: "10.2.0.0/24"}},
+		{name: "no name", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "10.2.0.0/24"}},
+		{name: "duplicate name", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Name: "dev", Ipv4AddressSpace: "10.2.0.0/24"}, wantFields: []string{"name"}},
+		{name: "same address space", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "10.0.0.0/24"}, wantFields: []string{"ipv4AddressSpace"}},
+		{name: "inside another", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "10.1.2.0/24"}, wantFields: []string{"ipv4AddressSpace"}},
+		{name: "containing the others", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "10.0.0.0/8"}, wantFields: []string{"ipv4AddressSpace", "ipv4AddressSpace"}},
+		{name: "adjacent", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "10.0.1.0/24"}},
+		{name: "update of itself", spec: model.CLADNetSpecification{CladnetID: "cladnet-01", Name: "dev", Ipv4AddressSpace: "10.0.0.0/24"}},
+		{
+			name:       "duplicate name and overlap",
+			spec:       model.CLADNetSpecification{CladnetID: "cladnet-03", Name: "prod", Ipv4AddressSpace: "10.1.0.0/24"},
+			wantFields: []string{"name", "ipv4AddressSpace"},
+		},
+		{
+			name:       "underlay network of a peer",
+			spec:       model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "192.168.0.0/24"},
+			peers:      []model.Peer{{HostID: "host-01", HostPrivateIPv4CIDR: "192.168.0.0/20"}, {HostID: "host-02", HostPrivateIPv4CIDR: "172.16.0.0/16"}},
+			wantFields: []string{"ipv4AddressSpace"},
+		},
+		{
+			// A peer out of the address space is not a conflict, but is re-addressed (e.g., by readdressCLADNet)
+			name:  "peer out of the address space",
+			spec:  model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "10.2.0.0/24"},
+			peers: []model.Peer{{HostID: "host-01", IP: "10.3.0.2", IPv4CIDR: "10.3.0.2/24", HostPrivateIPv4CIDR: "172.16.0.0/16"}},
+		},
+		{name: "malformed", spec: model.CLADNetSpecification{CladnetID: "cladnet-03", Ipv4AddressSpace: "xxxx"}, wantFields: []string{"ipv4AddressSpace"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckConflicts(tt.spec, others, tt.peers)
+			if got := fields(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("CheckConflicts() error = %v, want the fields %v", err, tt.wantFields)
+			}
+			if tt.wantFields == nil && err != nil {
+				t.Errorf("CheckConflicts() error = %#v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestGRPCStatus(t *testing.T) {
+	var errs Errors
+	errs.add("ipv4AddressSpace", "not private")
+	errs.add("ruleType", "unknown rule type (%q)", "fastest")
+
+	// The errors are converted to a status by the gRPC status package, e.g., returned by a handler
+	var err error = errs
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("status.FromError() is not ok")
+	}
+	if st.Code() != codes.InvalidArgument || st.Message() != errs.Error() {
+		t.Errorf("status = %v: %v, want InvalidArgument: %v", st.Code(), st.Message(), errs.Error())
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violations = append(violations, badRequest.FieldViolations...)
+		}
+	}
+	if len(violations) != 2 ||
+		violations[0].Field != "ipv4AddressSpace" || violations[0].Description != "not private" ||
+		violations[1].Field != "ruleType" || violations[1].Description != `unknown rule type ("fastest")` {
+		t.Errorf("the field violations = %v", violations)
+	}
+
+	// The field errors are recovered from the status (e.g., by a client)
+	if got := FieldErrors(st.Err()); !reflect.DeepEqual(got, errs) {
+		t.Errorf("FieldErrors(status) = %v, want %v", got, errs)
+	}
+	if got := FieldErrors(errors.New("xxxx")); got != nil {
+		t.Errorf("FieldErrors(another error) = %v, want nil", got)
+	}
+}
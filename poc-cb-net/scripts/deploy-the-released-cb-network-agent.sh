@@ -1,13 +1,13 @@
 #!/bin/bash
 
-ETCD_HOSTS=${1:-no}
+SERVICE_ENDPOINT=${1:-no}
 CLADNET_ID=${2:-no}
 HOST_NAME=${3:-no}
 
-if [ "${ETCD_HOSTS}" == "no" ] || [ "${CLADNET_ID}" == "no" ]; then
-  echo "Please, check parameters: etcd_hosts(${ETCD_HOSTS}) or cladnet_id(${CLADNET_ID})"
-  echo "The execution guide: ./get-and-run-agent.sh etcd_hosts(Required) cladnet_id(Required) host_name(Optional)"
-  echo "An example: ./get-and-run-agent.sh '[\"xxx.xxx.xxx:xxxx\", \"xxx.xxx.xxx:xxxx\", \"xxx.xxx.xxx:xxxx\"]' xxx xxx"
+if [ "${SERVICE_ENDPOINT}" == "no" ] || [ "${CLADNET_ID}" == "no" ]; then
+  echo "Please, check parameters: service_endpoint(${SERVICE_ENDPOINT}) or cladnet_id(${CLADNET_ID})"
+  echo "The execution guide: ./get-and-run-agent.sh service_endpoint(Required) cladnet_id(Required) host_name(Optional)"
+  echo "An example: ./get-and-run-agent.sh xxx.xxx.xxx.xxx:8053 xxx xxx"
 
 else
 
@@ -40,19 +40,11 @@ mkdir ~/cb-network-agent/config
 # Change directory to config
 cd ~/cb-network-agent/config
 
-# Refine ${ETCD_HOSTS} because of parameter passing issue by json array string including ', ", and \.
-REFINED_ETCD_HOSTS=${ETCD_HOSTS//\\/}
-# Meaning: "//": replace every, "\\": backslash, "/": with, "": empty string
-
 # Generate the config for the cb-network agent
 cat <<EOF >./config.yaml
-# A config for the both cb-network controller and agent as follows:
-etcd_cluster:
-  endpoints: ${REFINED_ETCD_HOSTS}
-
-# A config for the cb-network service and cb-network admin-web as follows:
+# A config for the cb-network service, admin-web, and agent as follows:
 service:
-  endpoint: "localhost:8053"
+  endpoint: "${SERVICE_ENDPOINT}"
   port: "8053"
 
 # A config for the cb-network admin-web as follows:
@@ -66,9 +58,30 @@ cb_network:
   host: # for each host
     name: "${HOST_NAME}" # if name is "" (empty string), the cb-network agent will use hostname.
     network_interface_name: "" # if network_interface_name is "" (empty string), the cb-network agent will use "cbnet0".
+    underlay_ipv4_interface_name: "" # if underlay_ipv4_interface_name is "" (empty string), the cb-network agent will use the interface of the IPv4 default route.
+    underlay_ipv6_interface_name: "" # if underlay_ipv6_interface_name is "" (empty string), the cb-network agent will use the interface of the IPv6 default route.
+    public_ip: "" # if public_ip is "" (empty string), the cb-network agent will resolve it by the cloud metadata services, STUN and HTTP echo services.
+    disable_cloud_detection: false # false is default. The cb-network agent detects the cloud information by the instance metadata services of AWS, GCP, Azure and Alibaba Cloud.
+    cloud_information: # for the cost-prioritized rule, each non-empty field overrides the detected one.
+      provider_name: "" # e.g., "aws", "gcp", "azure" or "alibaba"
+      region_id: ""
+      availability_zone_id: ""
+      virtual_network_id: "" # e.g., the VNet ID on Azure, which is not provided by the instance metadata service
+      subnet_id: ""
     tunneling_port: "" # if network_interface_port is "" (empty string), the cb-network agent will use "8055".
     is_encrypted: false  # false is default.
 
+# A config for the metrics of the cb-network agent as follows:
+metrics:
+  listen_address: "" # if listen_address is "" (empty string), the metrics endpoint is disabled. e.g., ":9101"
+
+# A config for the tracing (OpenTelemetry) of the cb-network service, controller and agent as follows:
+tracing:
+  exporter: "" # "otlp", "file" or "" (disabled)
+  endpoint: "localhost:4317" # OTLP/gRPC endpoint of a collector (for "otlp")
+  insecure: true # connect to the collector without TLS (for "otlp")
+  file_path: "" # if file_path is "" (empty string), spans are written to "./trace-{component}.json" (for "file")
+
 # A config for the demo-client as follows:
 service_call_method: "grpc" # i.e., "rest" / "grpc"
 